@@ -265,8 +265,8 @@ func TestCRDSchemaValidationMarkers(t *testing.T) {
 		if prop.Minimum == nil || *prop.Minimum != 0 {
 			t.Errorf("expected minimum=0, got %v", prop.Minimum)
 		}
-		if prop.Maximum == nil || *prop.Maximum != 2 {
-			t.Errorf("expected maximum=2, got %v", prop.Maximum)
+		if prop.Maximum == nil || *prop.Maximum != 3 {
+			t.Errorf("expected maximum=3, got %v", prop.Maximum)
 		}
 	})
 
@@ -2,12 +2,18 @@ package v1alpha1
 
 import (
 	"fmt"
+	"maps"
+	"strconv"
 
 	"sigs.k8s.io/controller-runtime/pkg/conversion"
 
 	v1beta1 "github.com/c5c3/memcached-operator/api/v1beta1"
 )
 
+// annotationRevisionHistoryLimit preserves the v1beta1-only spec.revisionHistoryLimit
+// on v1alpha1 objects, so a v1beta1 -> v1alpha1 -> v1beta1 round trip is lossless.
+const annotationRevisionHistoryLimit = "memcached.c5c3.io/v1beta1-revision-history-limit"
+
 // ConvertTo converts this v1alpha1.Memcached (spoke) to the hub version (v1beta1).
 func (src *Memcached) ConvertTo(dstRaw conversion.Hub) error {
 	dst, ok := dstRaw.(*v1beta1.Memcached)
@@ -19,10 +25,11 @@ func (src *Memcached) ConvertTo(dstRaw conversion.Hub) error {
 	// Spec — field-by-field copy (types are structurally identical).
 	dst.Spec.Replicas = src.Spec.Replicas
 	dst.Spec.Image = src.Spec.Image
+	dst.Spec.ImagePullPolicy = src.Spec.ImagePullPolicy
 	dst.Spec.Resources = src.Spec.Resources
 
 	if src.Spec.Memcached != nil {
-		m := v1beta1.MemcachedConfig(*src.Spec.Memcached)
+		m := convertMemcachedConfigTo(src.Spec.Memcached)
 		dst.Spec.Memcached = &m
 	}
 
@@ -51,11 +58,60 @@ func (src *Memcached) ConvertTo(dstRaw conversion.Hub) error {
 		dst.Spec.Service = &svc
 	}
 
+	if src.Spec.RollingUpdate != nil {
+		ru := v1beta1.RollingUpdateSpec(*src.Spec.RollingUpdate)
+		dst.Spec.RollingUpdate = &ru
+	}
+	if src.Spec.Maintenance != nil {
+		m := v1beta1.MaintenanceSpec(*src.Spec.Maintenance)
+		dst.Spec.Maintenance = &m
+	}
+	if src.Spec.MaintenanceWindow != nil {
+		mw := v1beta1.MaintenanceWindowSpec(*src.Spec.MaintenanceWindow)
+		dst.Spec.MaintenanceWindow = &mw
+	}
+	if src.Spec.Canary != nil {
+		c := v1beta1.CanarySpec(*src.Spec.Canary)
+		dst.Spec.Canary = &c
+	}
+	if src.Spec.StatsSidecar != nil {
+		ss := v1beta1.StatsSidecarSpec(*src.Spec.StatsSidecar)
+		dst.Spec.StatsSidecar = &ss
+	}
+
+	dst.Spec.PropagateLabels = src.Spec.PropagateLabels
+	dst.Spec.PropagateAnnotations = src.Spec.PropagateAnnotations
+	dst.Spec.RetainOrphansOnDisable = src.Spec.RetainOrphansOnDisable
+	dst.Spec.RuntimeClassName = src.Spec.RuntimeClassName
+	dst.Spec.PodOverhead = src.Spec.PodOverhead
+	dst.Spec.SuspendRollout = src.Spec.SuspendRollout
+	dst.Spec.OtelResourceAttributes = src.Spec.OtelResourceAttributes
+	dst.Spec.RestartPolicy = src.Spec.RestartPolicy
+	dst.Spec.SchedulerName = src.Spec.SchedulerName
+	dst.Spec.QoSClass = src.Spec.QoSClass
+	dst.Spec.PreserveWarmPodsOnScaleDown = src.Spec.PreserveWarmPodsOnScaleDown
+	dst.Spec.MinReadySeconds = src.Spec.MinReadySeconds
+
+	// v1beta1-only fields restored from annotations.
+	if v, ok := src.Annotations[annotationRevisionHistoryLimit]; ok {
+		if n, err := strconv.ParseInt(v, 10, 32); err == nil {
+			limit := int32(n)
+			dst.Spec.RevisionHistoryLimit = &limit
+		}
+		dst.Annotations = maps.Clone(src.Annotations)
+		delete(dst.Annotations, annotationRevisionHistoryLimit)
+	}
+
 	// Status
 	dst.Status.Conditions = src.Status.Conditions
 	dst.Status.ReadyReplicas = src.Status.ReadyReplicas
 	dst.Status.ObservedGeneration = src.Status.ObservedGeneration
 	dst.Status.ServerList = src.Status.ServerList
+	dst.Status.Phase = src.Status.Phase
+	dst.Status.ReadyEndpoints = src.Status.ReadyEndpoints
+	dst.Status.CurrentImage = src.Status.CurrentImage
+	dst.Status.RecentActions = convertRecentActionsTo(src.Status.RecentActions)
+	dst.Status.Recommendations = src.Status.Recommendations
 
 	return nil
 }
@@ -71,10 +127,11 @@ func (dst *Memcached) ConvertFrom(srcRaw conversion.Hub) error {
 	// Spec — field-by-field copy (types are structurally identical).
 	dst.Spec.Replicas = src.Spec.Replicas
 	dst.Spec.Image = src.Spec.Image
+	dst.Spec.ImagePullPolicy = src.Spec.ImagePullPolicy
 	dst.Spec.Resources = src.Spec.Resources
 
 	if src.Spec.Memcached != nil {
-		m := MemcachedConfig(*src.Spec.Memcached)
+		m := convertMemcachedConfigFrom(src.Spec.Memcached)
 		dst.Spec.Memcached = &m
 	}
 
@@ -103,20 +160,139 @@ func (dst *Memcached) ConvertFrom(srcRaw conversion.Hub) error {
 		dst.Spec.Service = &svc
 	}
 
+	if src.Spec.RollingUpdate != nil {
+		ru := RollingUpdateSpec(*src.Spec.RollingUpdate)
+		dst.Spec.RollingUpdate = &ru
+	}
+	if src.Spec.Maintenance != nil {
+		m := MaintenanceSpec(*src.Spec.Maintenance)
+		dst.Spec.Maintenance = &m
+	}
+	if src.Spec.MaintenanceWindow != nil {
+		mw := MaintenanceWindowSpec(*src.Spec.MaintenanceWindow)
+		dst.Spec.MaintenanceWindow = &mw
+	}
+	if src.Spec.Canary != nil {
+		c := CanarySpec(*src.Spec.Canary)
+		dst.Spec.Canary = &c
+	}
+	if src.Spec.StatsSidecar != nil {
+		ss := StatsSidecarSpec(*src.Spec.StatsSidecar)
+		dst.Spec.StatsSidecar = &ss
+	}
+
+	dst.Spec.PropagateLabels = src.Spec.PropagateLabels
+	dst.Spec.PropagateAnnotations = src.Spec.PropagateAnnotations
+	dst.Spec.RetainOrphansOnDisable = src.Spec.RetainOrphansOnDisable
+	dst.Spec.RuntimeClassName = src.Spec.RuntimeClassName
+	dst.Spec.PodOverhead = src.Spec.PodOverhead
+	dst.Spec.SuspendRollout = src.Spec.SuspendRollout
+	dst.Spec.OtelResourceAttributes = src.Spec.OtelResourceAttributes
+	dst.Spec.RestartPolicy = src.Spec.RestartPolicy
+	dst.Spec.SchedulerName = src.Spec.SchedulerName
+	dst.Spec.QoSClass = src.Spec.QoSClass
+	dst.Spec.PreserveWarmPodsOnScaleDown = src.Spec.PreserveWarmPodsOnScaleDown
+	dst.Spec.MinReadySeconds = src.Spec.MinReadySeconds
+
+	// v1beta1-only fields preserved as annotations.
+	if src.Spec.RevisionHistoryLimit != nil {
+		dst.Annotations = maps.Clone(src.Annotations)
+		if dst.Annotations == nil {
+			dst.Annotations = map[string]string{}
+		}
+		dst.Annotations[annotationRevisionHistoryLimit] = strconv.Itoa(int(*src.Spec.RevisionHistoryLimit))
+	}
+
 	// Status
 	dst.Status.Conditions = src.Status.Conditions
 	dst.Status.ReadyReplicas = src.Status.ReadyReplicas
 	dst.Status.ObservedGeneration = src.Status.ObservedGeneration
 	dst.Status.ServerList = src.Status.ServerList
+	dst.Status.Phase = src.Status.Phase
+	dst.Status.ReadyEndpoints = src.Status.ReadyEndpoints
+	dst.Status.CurrentImage = src.Status.CurrentImage
+	dst.Status.RecentActions = convertRecentActionsFrom(src.Status.RecentActions)
+	dst.Status.Recommendations = src.Status.Recommendations
 
 	return nil
 }
 
 // --- Helper converters for nested structs with pointer fields ---
 
+func convertRecentActionsTo(src []ActionEntry) []v1beta1.ActionEntry {
+	if src == nil {
+		return nil
+	}
+	dst := make([]v1beta1.ActionEntry, len(src))
+	for i := range src {
+		dst[i] = v1beta1.ActionEntry(src[i])
+	}
+	return dst
+}
+
+func convertRecentActionsFrom(src []v1beta1.ActionEntry) []ActionEntry {
+	if src == nil {
+		return nil
+	}
+	dst := make([]ActionEntry, len(src))
+	for i := range src {
+		dst[i] = ActionEntry(src[i])
+	}
+	return dst
+}
+
+func convertMemcachedConfigTo(src *MemcachedConfig) v1beta1.MemcachedConfig {
+	dst := v1beta1.MemcachedConfig{
+		MaxMemoryMB:            src.MaxMemoryMB,
+		MaxConnections:         src.MaxConnections,
+		Threads:                src.Threads,
+		MaxItemSize:            src.MaxItemSize,
+		Verbosity:              src.Verbosity,
+		IdleTimeoutSeconds:     src.IdleTimeoutSeconds,
+		SlabReassign:           src.SlabReassign,
+		SlabAutomove:           src.SlabAutomove,
+		ListenAddresses:        src.ListenAddresses,
+		ExtraArgs:              src.ExtraArgs,
+		Command:                src.Command,
+		EntrypointConfigMapRef: src.EntrypointConfigMapRef,
+		EntrypointPath:         src.EntrypointPath,
+		TmpfsSizeLimit:         src.TmpfsSizeLimit,
+	}
+	if src.UnixSocket != nil {
+		u := v1beta1.UnixSocketSpec(*src.UnixSocket)
+		dst.UnixSocket = &u
+	}
+	return dst
+}
+
+func convertMemcachedConfigFrom(src *v1beta1.MemcachedConfig) MemcachedConfig {
+	dst := MemcachedConfig{
+		MaxMemoryMB:            src.MaxMemoryMB,
+		MaxConnections:         src.MaxConnections,
+		Threads:                src.Threads,
+		MaxItemSize:            src.MaxItemSize,
+		Verbosity:              src.Verbosity,
+		IdleTimeoutSeconds:     src.IdleTimeoutSeconds,
+		SlabReassign:           src.SlabReassign,
+		SlabAutomove:           src.SlabAutomove,
+		ListenAddresses:        src.ListenAddresses,
+		ExtraArgs:              src.ExtraArgs,
+		Command:                src.Command,
+		EntrypointConfigMapRef: src.EntrypointConfigMapRef,
+		EntrypointPath:         src.EntrypointPath,
+		TmpfsSizeLimit:         src.TmpfsSizeLimit,
+	}
+	if src.UnixSocket != nil {
+		u := UnixSocketSpec(*src.UnixSocket)
+		dst.UnixSocket = &u
+	}
+	return dst
+}
+
 func convertHighAvailabilityTo(src *HighAvailabilitySpec) v1beta1.HighAvailabilitySpec {
 	dst := v1beta1.HighAvailabilitySpec{
 		TopologySpreadConstraints: src.TopologySpreadConstraints,
+		SpreadPerRevision:         src.SpreadPerRevision,
 	}
 	if src.AntiAffinityPreset != nil {
 		v := v1beta1.AntiAffinityPreset(*src.AntiAffinityPreset)
@@ -130,12 +306,17 @@ func convertHighAvailabilityTo(src *HighAvailabilitySpec) v1beta1.HighAvailabili
 		g := v1beta1.GracefulShutdownSpec(*src.GracefulShutdown)
 		dst.GracefulShutdown = &g
 	}
+	if src.ClientAffinity != nil {
+		c := v1beta1.ClientAffinitySpec(*src.ClientAffinity)
+		dst.ClientAffinity = &c
+	}
 	return dst
 }
 
 func convertHighAvailabilityFrom(src *v1beta1.HighAvailabilitySpec) HighAvailabilitySpec {
 	dst := HighAvailabilitySpec{
 		TopologySpreadConstraints: src.TopologySpreadConstraints,
+		SpreadPerRevision:         src.SpreadPerRevision,
 	}
 	if src.AntiAffinityPreset != nil {
 		v := AntiAffinityPreset(*src.AntiAffinityPreset)
@@ -149,32 +330,96 @@ func convertHighAvailabilityFrom(src *v1beta1.HighAvailabilitySpec) HighAvailabi
 		g := GracefulShutdownSpec(*src.GracefulShutdown)
 		dst.GracefulShutdown = &g
 	}
+	if src.ClientAffinity != nil {
+		c := ClientAffinitySpec(*src.ClientAffinity)
+		dst.ClientAffinity = &c
+	}
 	return dst
 }
 
 func convertMonitoringTo(src *MonitoringSpec) v1beta1.MonitoringSpec {
 	dst := v1beta1.MonitoringSpec{
-		Enabled:           src.Enabled,
-		ExporterImage:     src.ExporterImage,
-		ExporterResources: src.ExporterResources,
+		Enabled:                  src.Enabled,
+		ExporterImage:            src.ExporterImage,
+		ExporterResources:        src.ExporterResources,
+		ExporterSecurityContext:  src.ExporterSecurityContext,
+		ExporterMemcachedAddress: src.ExporterMemcachedAddress,
+		ScrapeAnnotations:        src.ScrapeAnnotations,
+		DisabledMetricGroups:     src.DisabledMetricGroups,
+		ExporterReadinessProbe:   src.ExporterReadinessProbe,
 	}
 	if src.ServiceMonitor != nil {
 		sm := v1beta1.ServiceMonitorSpec(*src.ServiceMonitor)
 		dst.ServiceMonitor = &sm
 	}
+	if src.ExporterTLS != nil {
+		et := v1beta1.ExporterTLSSpec(*src.ExporterTLS)
+		dst.ExporterTLS = &et
+	}
+	if src.PushGateway != nil {
+		pg := v1beta1.PushGatewaySpec(*src.PushGateway)
+		dst.PushGateway = &pg
+	}
 	return dst
 }
 
 func convertMonitoringFrom(src *v1beta1.MonitoringSpec) MonitoringSpec {
 	dst := MonitoringSpec{
-		Enabled:           src.Enabled,
-		ExporterImage:     src.ExporterImage,
-		ExporterResources: src.ExporterResources,
+		Enabled:                  src.Enabled,
+		ExporterImage:            src.ExporterImage,
+		ExporterResources:        src.ExporterResources,
+		ExporterSecurityContext:  src.ExporterSecurityContext,
+		ExporterMemcachedAddress: src.ExporterMemcachedAddress,
+		ScrapeAnnotations:        src.ScrapeAnnotations,
+		DisabledMetricGroups:     src.DisabledMetricGroups,
+		ExporterReadinessProbe:   src.ExporterReadinessProbe,
 	}
 	if src.ServiceMonitor != nil {
 		sm := ServiceMonitorSpec(*src.ServiceMonitor)
 		dst.ServiceMonitor = &sm
 	}
+	if src.ExporterTLS != nil {
+		et := ExporterTLSSpec(*src.ExporterTLS)
+		dst.ExporterTLS = &et
+	}
+	if src.PushGateway != nil {
+		pg := PushGatewaySpec(*src.PushGateway)
+		dst.PushGateway = &pg
+	}
+	return dst
+}
+
+func convertTLSTo(src *TLSSpec) v1beta1.TLSSpec {
+	dst := v1beta1.TLSSpec{
+		Enabled:              src.Enabled,
+		CertificateSecretRef: src.CertificateSecretRef,
+		EnableClientCert:     src.EnableClientCert,
+		CopyFromNamespace:    src.CopyFromNamespace,
+		Port:                 src.Port,
+	}
+	if src.GenerateCertificate != nil {
+		dst.GenerateCertificate = &v1beta1.GenerateCertificateSpec{
+			IssuerRef: v1beta1.CertificateIssuerRef(src.GenerateCertificate.IssuerRef),
+			DNSNames:  src.GenerateCertificate.DNSNames,
+		}
+	}
+	return dst
+}
+
+func convertTLSFrom(src *v1beta1.TLSSpec) TLSSpec {
+	dst := TLSSpec{
+		Enabled:              src.Enabled,
+		CertificateSecretRef: src.CertificateSecretRef,
+		EnableClientCert:     src.EnableClientCert,
+		CopyFromNamespace:    src.CopyFromNamespace,
+		Port:                 src.Port,
+	}
+	if src.GenerateCertificate != nil {
+		dst.GenerateCertificate = &GenerateCertificateSpec{
+			IssuerRef: CertificateIssuerRef(src.GenerateCertificate.IssuerRef),
+			DNSNames:  src.GenerateCertificate.DNSNames,
+		}
+	}
 	return dst
 }
 
@@ -182,13 +427,14 @@ func convertSecurityTo(src *SecuritySpec) v1beta1.SecuritySpec {
 	dst := v1beta1.SecuritySpec{
 		PodSecurityContext:       src.PodSecurityContext,
 		ContainerSecurityContext: src.ContainerSecurityContext,
+		Sysctls:                  src.Sysctls,
 	}
 	if src.SASL != nil {
 		s := v1beta1.SASLSpec(*src.SASL)
 		dst.SASL = &s
 	}
 	if src.TLS != nil {
-		t := v1beta1.TLSSpec(*src.TLS)
+		t := convertTLSTo(src.TLS)
 		dst.TLS = &t
 	}
 	if src.NetworkPolicy != nil {
@@ -202,13 +448,14 @@ func convertSecurityFrom(src *v1beta1.SecuritySpec) SecuritySpec {
 	dst := SecuritySpec{
 		PodSecurityContext:       src.PodSecurityContext,
 		ContainerSecurityContext: src.ContainerSecurityContext,
+		Sysctls:                  src.Sysctls,
 	}
 	if src.SASL != nil {
 		s := SASLSpec(*src.SASL)
 		dst.SASL = &s
 	}
 	if src.TLS != nil {
-		t := TLSSpec(*src.TLS)
+		t := convertTLSFrom(src.TLS)
 		dst.TLS = &t
 	}
 	if src.NetworkPolicy != nil {
@@ -1,6 +1,7 @@
 package v1alpha1
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -24,6 +25,7 @@ func TestConvertible_InterfaceSatisfied(t *testing.T) {
 func fullyPopulated() *Memcached {
 	replicas := int32(5)
 	image := "memcached:1.6.28"
+	pullPolicy := corev1.PullIfNotPresent
 	antiAffinity := AntiAffinityPresetHard
 	minAvail := intstr.FromString("50%")
 	maxUnavail := intstr.FromInt32(1)
@@ -44,8 +46,9 @@ func fullyPopulated() *Memcached {
 			ResourceVersion: "12345",
 		},
 		Spec: MemcachedSpec{
-			Replicas: &replicas,
-			Image:    &image,
+			Replicas:        &replicas,
+			Image:           &image,
+			ImagePullPolicy: &pullPolicy,
 			Resources: &corev1.ResourceRequirements{
 				Requests: corev1.ResourceList{
 					corev1.ResourceCPU:    resource.MustParse("100m"),
@@ -57,12 +60,21 @@ func fullyPopulated() *Memcached {
 				},
 			},
 			Memcached: &MemcachedConfig{
-				MaxMemoryMB:    128,
-				MaxConnections: 2048,
-				Threads:        8,
-				MaxItemSize:    "2m",
-				Verbosity:      1,
-				ExtraArgs:      []string{"-o", "modern", "-B", "binary"},
+				MaxMemoryMB:            128,
+				MaxConnections:         2048,
+				Threads:                8,
+				MaxItemSize:            "2m",
+				Verbosity:              1,
+				IdleTimeoutSeconds:     int32Ptr(300),
+				SlabReassign:           boolPtr(true),
+				SlabAutomove:           int32Ptr(2),
+				ListenAddresses:        []string{"127.0.0.1", "$(POD_IP)"},
+				ExtraArgs:              []string{"-o", "modern", "-B", "binary"},
+				Command:                []string{"/entrypoint.sh", "memcached"},
+				EntrypointConfigMapRef: &corev1.LocalObjectReference{Name: "memcached-entrypoint"},
+				EntrypointPath:         stringPtr("entrypoint.sh"),
+				UnixSocket:             &UnixSocketSpec{Enabled: true, Path: stringPtr("/run/memcached/mc.sock")},
+				TmpfsSizeLimit:         quantityPtr("64Mi"),
 			},
 			HighAvailability: &HighAvailabilitySpec{
 				AntiAffinityPreset: &antiAffinity,
@@ -73,6 +85,7 @@ func fullyPopulated() *Memcached {
 						WhenUnsatisfiable: corev1.DoNotSchedule,
 					},
 				},
+				SpreadPerRevision: true,
 				PodDisruptionBudget: &PDBSpec{
 					Enabled:        true,
 					MinAvailable:   &minAvail,
@@ -83,6 +96,10 @@ func fullyPopulated() *Memcached {
 					PreStopDelaySeconds:           15,
 					TerminationGracePeriodSeconds: 60,
 				},
+				ClientAffinity: &ClientAffinitySpec{
+					PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "client"}},
+					Weight:      50,
+				},
 			},
 			Monitoring: &MonitoringSpec{
 				Enabled:       true,
@@ -92,10 +109,31 @@ func fullyPopulated() *Memcached {
 						corev1.ResourceCPU: resource.MustParse("50m"),
 					},
 				},
+				ExporterSecurityContext: &corev1.SecurityContext{RunAsUser: int64Ptr(65534)},
 				ServiceMonitor: &ServiceMonitorSpec{
 					AdditionalLabels: map[string]string{"team": "platform"},
 					Interval:         v1beta1.DefaultServiceMonitorInterval,
 					ScrapeTimeout:    "10s",
+					HonorLabels:      boolPtr(true),
+					HonorTimestamps:  boolPtr(false),
+				},
+				ExporterTLS: &ExporterTLSSpec{
+					Enabled:              true,
+					CertificateSecretRef: corev1.LocalObjectReference{Name: "exporter-tls"},
+					CASecretRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "exporter-tls"},
+						Key:                  "ca.crt",
+					},
+					ServerName: "exporter.default.svc",
+				},
+				ExporterMemcachedAddress: stringPtr("127.0.0.1:11211"),
+				ScrapeAnnotations:        true,
+				DisabledMetricGroups:     []string{"slabs", "items"},
+				ExporterReadinessProbe:   true,
+				PushGateway: &PushGatewaySpec{
+					URL:             "http://pushgateway.monitoring:9091",
+					JobName:         "memcached-batch",
+					IntervalSeconds: int32Ptr(60),
 				},
 			},
 			Security: &SecuritySpec{
@@ -106,14 +144,22 @@ func fullyPopulated() *Memcached {
 					RunAsNonRoot: &runAsNonRoot,
 				},
 				SASL: &SASLSpec{
-					Enabled:              true,
-					CredentialsSecretRef: corev1.LocalObjectReference{Name: "sasl-secret"},
+					Enabled:                       true,
+					CredentialsSecretRef:          corev1.LocalObjectReference{Name: "sasl-secret"},
+					CredentialsSecretNameTemplate: stringPtr("{{ .Name }}-sasl"),
 				},
 				TLS: &TLSSpec{
 					Enabled:              true,
 					CertificateSecretRef: corev1.LocalObjectReference{Name: "tls-secret"},
 					EnableClientCert:     true,
+					CopyFromNamespace:    stringPtr("certs"),
+					Port:                 int32Ptr(12345),
+					GenerateCertificate: &GenerateCertificateSpec{
+						IssuerRef: CertificateIssuerRef{Name: "selfsigned", Kind: "ClusterIssuer"},
+						DNSNames:  []string{"full-mc.prod.svc"},
+					},
 				},
+				Sysctls: []corev1.Sysctl{{Name: "net.ipv4.tcp_keepalive_time", Value: "300"}},
 				NetworkPolicy: &NetworkPolicySpec{
 					Enabled: true,
 					AllowedSources: []networkingv1.NetworkPolicyPeer{
@@ -123,6 +169,8 @@ func fullyPopulated() *Memcached {
 							},
 						},
 					},
+					AllowDebugNamespace: stringPtr("debug"),
+					CreateDefaultDeny:   true,
 				},
 			},
 			Autoscaling: &AutoscalingSpec{
@@ -143,8 +191,51 @@ func fullyPopulated() *Memcached {
 				},
 			},
 			Service: &ServiceSpec{
-				Annotations: map[string]string{"svc-key": "svc-val"},
+				Annotations:         map[string]string{"svc-key": "svc-val"},
+				Type:                stringPtr("ClusterIP"),
+				TrafficDistribution: stringPtr("PreferClose"),
+				TopologyAwareHints:  true,
+				TrackEndpoints:      true,
+				Manage:              boolPtr(false),
+			},
+			RollingUpdate: &RollingUpdateSpec{
+				MaxSurgePercent: int32Ptr(25),
+				MaxUnavailable:  int32Ptr(1),
+			},
+			Maintenance: &MaintenanceSpec{
+				ReadOnly: true,
+				Replicas: int32Ptr(1),
+			},
+			MaintenanceWindow: &MaintenanceWindowSpec{
+				Schedule:            "0 2 * * 6",
+				DurationMinutes:     120,
+				DeferReplicaChanges: true,
+			},
+			Canary: &CanarySpec{
+				Enabled:  true,
+				Image:    stringPtr("memcached:1.6.38"),
+				Replicas: int32Ptr(2),
 			},
+			StatsSidecar: &StatsSidecarSpec{
+				Enabled: true,
+				Image:   stringPtr("example.com/memcached-stats:1.0"),
+				Port:    int32Ptr(8081),
+			},
+			PropagateLabels:        []string{"cost-center"},
+			PropagateAnnotations:   []string{"example.com/owner"},
+			RetainOrphansOnDisable: true,
+			RuntimeClassName:       stringPtr("kata"),
+			PodOverhead: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("250m"),
+				corev1.ResourceMemory: resource.MustParse("120Mi"),
+			},
+			SuspendRollout:              true,
+			OtelResourceAttributes:      true,
+			RestartPolicy:               corev1.RestartPolicyAlways,
+			SchedulerName:               stringPtr("volcano"),
+			QoSClass:                    corev1.PodQOSGuaranteed,
+			PreserveWarmPodsOnScaleDown: true,
+			MinReadySeconds:             int32Ptr(10),
 		},
 		Status: MemcachedStatus{
 			Conditions: []metav1.Condition{
@@ -159,11 +250,26 @@ func fullyPopulated() *Memcached {
 			ReadyReplicas:      5,
 			ObservedGeneration: 42,
 			ServerList:         []string{"10.244.0.5:11211", "10.244.0.6:11211", "10.244.0.7:11211"},
+			Phase:              "Running",
+			ReadyEndpoints:     []string{"10.244.0.5", "10.244.0.6", "10.244.0.7"},
+			CurrentImage:       "memcached:1.6.29",
+			RecentActions: []ActionEntry{
+				{Time: metav1.Now(), Action: "Updated", Message: "Updated Deployment full-mc"},
+			},
+			Recommendations: []string{"consider threads=2 to match the 2 CPU limit"},
 		},
 	}
 }
 
-func int32Ptr(v int32) *int32 { return &v }
+func int32Ptr(v int32) *int32    { return &v }
+func stringPtr(v string) *string { return &v }
+func boolPtr(v bool) *bool       { return &v }
+func int64Ptr(v int64) *int64    { return &v }
+
+func quantityPtr(v string) *resource.Quantity {
+	q := resource.MustParse(v)
+	return &q
+}
 
 func TestConvertTo_FullyPopulatedObject(t *testing.T) {
 	src := fullyPopulated()
@@ -221,6 +327,12 @@ func TestConvertTo_FullyPopulatedObject(t *testing.T) {
 	if !dst.Spec.Security.TLS.EnableClientCert {
 		t.Error("TLS.EnableClientCert should be true")
 	}
+	if dst.Spec.Security.TLS.CopyFromNamespace == nil || *dst.Spec.Security.TLS.CopyFromNamespace != "certs" {
+		t.Error("TLS.CopyFromNamespace mismatch")
+	}
+	if dst.Spec.Security.TLS.Port == nil || *dst.Spec.Security.TLS.Port != 12345 {
+		t.Error("TLS.Port mismatch")
+	}
 	if !dst.Spec.Security.NetworkPolicy.Enabled {
 		t.Error("NetworkPolicy.Enabled should be true")
 	}
@@ -232,6 +344,9 @@ func TestConvertTo_FullyPopulatedObject(t *testing.T) {
 	if dst.Spec.Monitoring.ServiceMonitor.Interval != v1beta1.DefaultServiceMonitorInterval {
 		t.Error("ServiceMonitor.Interval mismatch")
 	}
+	if dst.Spec.Monitoring.ExporterTLS == nil || dst.Spec.Monitoring.ExporterTLS.CertificateSecretRef.Name != "exporter-tls" {
+		t.Error("ExporterTLS mismatch")
+	}
 
 	// Autoscaling
 	if dst.Spec.Autoscaling == nil {
@@ -251,6 +366,19 @@ func TestConvertTo_FullyPopulatedObject(t *testing.T) {
 	if !reflect.DeepEqual(dst.Status.ServerList, src.Status.ServerList) {
 		t.Errorf("ServerList: got %v, want %v", dst.Status.ServerList, src.Status.ServerList)
 	}
+	if !reflect.DeepEqual(dst.Status.ReadyEndpoints, src.Status.ReadyEndpoints) {
+		t.Errorf("ReadyEndpoints: got %v, want %v", dst.Status.ReadyEndpoints, src.Status.ReadyEndpoints)
+	}
+	if dst.Status.CurrentImage != src.Status.CurrentImage {
+		t.Errorf("CurrentImage: got %q, want %q", dst.Status.CurrentImage, src.Status.CurrentImage)
+	}
+	if len(dst.Status.RecentActions) != len(src.Status.RecentActions) ||
+		dst.Status.RecentActions[0].Message != src.Status.RecentActions[0].Message {
+		t.Errorf("RecentActions: got %v, want %v", dst.Status.RecentActions, src.Status.RecentActions)
+	}
+	if !reflect.DeepEqual(dst.Status.Recommendations, src.Status.Recommendations) {
+		t.Errorf("Recommendations: got %v, want %v", dst.Status.Recommendations, src.Status.Recommendations)
+	}
 }
 
 func TestConvertFrom_FullyPopulatedObject(t *testing.T) {
@@ -336,6 +464,56 @@ func TestRoundTrip_FullyPopulated_NoDataLoss(t *testing.T) {
 	}
 }
 
+func TestConvertTo_V1Beta1OnlyFieldsDefaulted(t *testing.T) {
+	hub := &v1beta1.Memcached{}
+	if err := fullyPopulated().ConvertTo(hub); err != nil {
+		t.Fatalf("ConvertTo failed: %v", err)
+	}
+	if hub.Spec.RevisionHistoryLimit != nil {
+		t.Fatalf("RevisionHistoryLimit: got %d before defaulting, want nil", *hub.Spec.RevisionHistoryLimit)
+	}
+
+	if err := (&v1beta1.MemcachedCustomDefaulter{}).Default(context.Background(), hub); err != nil {
+		t.Fatalf("Default failed: %v", err)
+	}
+	if hub.Spec.RevisionHistoryLimit == nil || *hub.Spec.RevisionHistoryLimit != v1beta1.DefaultRevisionHistoryLimit {
+		t.Errorf("RevisionHistoryLimit: got %v, want %d", hub.Spec.RevisionHistoryLimit, v1beta1.DefaultRevisionHistoryLimit)
+	}
+}
+
+func TestRoundTrip_HubOnlyFields_PreservedViaAnnotation(t *testing.T) {
+	hub := &v1beta1.Memcached{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "hub-mc",
+			Namespace:   "default",
+			Annotations: map[string]string{"team": "cache"},
+		},
+		Spec: v1beta1.MemcachedSpec{RevisionHistoryLimit: int32Ptr(3)},
+	}
+
+	spoke := &Memcached{}
+	if err := spoke.ConvertFrom(hub); err != nil {
+		t.Fatalf("ConvertFrom failed: %v", err)
+	}
+	if got := spoke.Annotations[annotationRevisionHistoryLimit]; got != "3" {
+		t.Errorf("annotation %s: got %q, want %q", annotationRevisionHistoryLimit, got, "3")
+	}
+	if _, ok := hub.Annotations[annotationRevisionHistoryLimit]; ok {
+		t.Error("ConvertFrom must not mutate the hub's annotations")
+	}
+
+	back := &v1beta1.Memcached{}
+	if err := spoke.ConvertTo(back); err != nil {
+		t.Fatalf("ConvertTo failed: %v", err)
+	}
+	if back.Spec.RevisionHistoryLimit == nil || *back.Spec.RevisionHistoryLimit != 3 {
+		t.Errorf("RevisionHistoryLimit: got %v, want 3", back.Spec.RevisionHistoryLimit)
+	}
+	if !reflect.DeepEqual(back.Annotations, hub.Annotations) {
+		t.Errorf("Annotations: got %v, want %v", back.Annotations, hub.Annotations)
+	}
+}
+
 func TestRoundTrip_MinimalObject_PreservesNils(t *testing.T) {
 	original := &Memcached{
 		ObjectMeta: metav1.ObjectMeta{
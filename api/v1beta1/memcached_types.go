@@ -2,9 +2,13 @@
 package v1beta1
 
 import (
+	"strings"
+	"text/template"
+
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
@@ -49,16 +53,97 @@ type MemcachedConfig struct {
 	// +optional
 	MaxItemSize string `json:"maxItemSize,omitempty"`
 
-	// Verbosity controls the logging verbosity level (0=none, 1=-v, 2=-vv).
+	// Verbosity controls the logging verbosity level (0=none, 1=-v, 2=-vv, 3=-vvv).
 	// +kubebuilder:validation:Minimum=0
-	// +kubebuilder:validation:Maximum=2
+	// +kubebuilder:validation:Maximum=3
 	// +kubebuilder:default=0
 	// +optional
 	Verbosity int32 `json:"verbosity,omitempty"`
 
+	// IdleTimeoutSeconds closes client connections idle for longer than this many
+	// seconds (-o idle_timeout). Unset keeps memcached's default of never timing out.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=86400
+	// +optional
+	IdleTimeoutSeconds *int32 `json:"idleTimeoutSeconds,omitempty"`
+
+	// SlabReassign toggles moving memory pages between slab classes (-o slab_reassign
+	// when true, -o no_slab_reassign when false). Unset keeps memcached's default,
+	// which enables it.
+	// +optional
+	SlabReassign *bool `json:"slabReassign,omitempty"`
+
+	// SlabAutomove selects the background slab rebalancing mode (-o slab_automove):
+	// 0 disables it, 1 moves pages from slab classes with free memory, and 2
+	// rebalances aggressively on every eviction. Unset keeps memcached's default of 1.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=2
+	// +optional
+	SlabAutomove *int32 `json:"slabAutomove,omitempty"`
+
+	// ListenAddresses are the interfaces memcached binds to, each passed as a -l flag.
+	// The token "$(POD_IP)" is expanded to the Pod IP. Unset listens on all interfaces.
+	// +kubebuilder:validation:MaxItems=8
+	// +kubebuilder:validation:items:MinLength=1
+	// +listType=atomic
+	// +optional
+	ListenAddresses []string `json:"listenAddresses,omitempty"`
+
 	// ExtraArgs are additional command-line arguments passed to the Memcached process.
 	// +optional
 	ExtraArgs []string `json:"extraArgs,omitempty"`
+
+	// Command replaces the memcached container's entrypoint, for images that wrap
+	// the memcached binary in a script. The operator-generated flags are still
+	// passed as the container args. Unset keeps the image's entrypoint.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:items:MinLength=1
+	// +listType=atomic
+	// +optional
+	Command []string `json:"command,omitempty"`
+
+	// EntrypointConfigMapRef references a ConfigMap holding a wrapper entrypoint
+	// script, for example to raise ulimits before exec-ing memcached. The key named
+	// by entrypointPath is mounted read-only and executable, and runs as the
+	// container command with the operator-generated flags as its arguments, so the
+	// script should end with `exec memcached "$@"`. Mutually exclusive with command.
+	// +optional
+	EntrypointConfigMapRef *corev1.LocalObjectReference `json:"entrypointConfigMapRef,omitempty"`
+
+	// EntrypointPath is the key of the entrypoint script in entrypointConfigMapRef.
+	// Required when entrypointConfigMapRef is set.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +optional
+	EntrypointPath *string `json:"entrypointPath,omitempty"`
+
+	// UnixSocket configures memcached to listen on a unix domain socket shared with
+	// in-pod sidecars (-s flag). Note that memcached does not open TCP listeners
+	// while a unix socket is configured.
+	// +optional
+	UnixSocket *UnixSocketSpec `json:"unixSocket,omitempty"`
+
+	// TmpfsSizeLimit caps the memory-backed /tmp emptyDir that the operator mounts
+	// when spec.security.containerSecurityContext.readOnlyRootFilesystem is true.
+	// Writes to it count against the container's memory limit, so bounding it keeps
+	// a runaway /tmp from starving the cache. Unset leaves the emptyDir unbounded.
+	// +optional
+	TmpfsSizeLimit *resource.Quantity `json:"tmpfsSizeLimit,omitempty"`
+}
+
+// UnixSocketSpec defines the unix domain socket memcached listens on.
+type UnixSocketSpec struct {
+	// Enabled controls whether memcached listens on the unix socket.
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Path is the absolute path of the socket file. Its directory is backed by an
+	// emptyDir volume shared with the exporter sidecar.
+	// Defaults to /var/run/memcached/memcached.sock.
+	// +kubebuilder:validation:Pattern=`^/.+[^/]$`
+	// +kubebuilder:validation:MaxLength=100
+	// +optional
+	Path *string `json:"path,omitempty"`
 }
 
 // HighAvailabilitySpec defines high-availability settings for Memcached pods.
@@ -74,6 +159,12 @@ type HighAvailabilitySpec struct {
 	// +optional
 	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty,omitzero"`
 
+	// SpreadPerRevision adds pod-template-hash to the matchLabelKeys of every
+	// topology spread constraint that has a labelSelector, so that spreading is
+	// computed per ReplicaSet revision during rollouts. Requires Kubernetes 1.27+.
+	// +optional
+	SpreadPerRevision bool `json:"spreadPerRevision,omitempty"`
+
 	// PodDisruptionBudget configures the PDB for Memcached pods.
 	// +optional
 	PodDisruptionBudget *PDBSpec `json:"podDisruptionBudget,omitempty,omitzero"`
@@ -82,6 +173,25 @@ type HighAvailabilitySpec struct {
 	// to allow in-flight connections to drain before pod termination.
 	// +optional
 	GracefulShutdown *GracefulShutdownSpec `json:"gracefulShutdown,omitempty,omitzero"`
+
+	// ClientAffinity prefers scheduling Memcached pods onto the nodes of their
+	// primary client pods, alongside the anti-affinity between Memcached pods.
+	// +optional
+	ClientAffinity *ClientAffinitySpec `json:"clientAffinity,omitempty,omitzero"`
+}
+
+// ClientAffinitySpec defines a preferred pod affinity towards client pods.
+type ClientAffinitySpec struct {
+	// PodSelector selects the client pods, in the namespace of the Memcached
+	// resource, to co-locate with. It must not be empty.
+	PodSelector metav1.LabelSelector `json:"podSelector"`
+
+	// Weight is the weight of the preferred pod affinity term, from 1 to 100.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:default=100
+	// +optional
+	Weight int32 `json:"weight,omitempty"`
 }
 
 // GracefulShutdownSpec defines the graceful shutdown configuration for Memcached pods.
@@ -93,7 +203,7 @@ type GracefulShutdownSpec struct {
 	// PreStopDelaySeconds is the number of seconds the preStop hook sleeps to allow connection draining.
 	// +kubebuilder:validation:Minimum=1
 	// +kubebuilder:validation:Maximum=300
-	// +kubebuilder:default=10
+	// +kubebuilder:default=15
 	// +optional
 	PreStopDelaySeconds int32 `json:"preStopDelaySeconds,omitempty"`
 
@@ -114,12 +224,13 @@ type PDBSpec struct {
 
 	// MinAvailable is the minimum number of pods that must be available during disruption.
 	// Can be an absolute number or a percentage (e.g. "50%").
-	// Defaults to 1 when neither minAvailable nor maxUnavailable is set (applied by the controller).
 	// +optional
 	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty,omitzero"`
 
 	// MaxUnavailable is the maximum number of pods that can be unavailable during disruption.
 	// Can be an absolute number or a percentage (e.g. "25%").
+	// When neither minAvailable nor maxUnavailable is set, the controller applies
+	// maxUnavailable 1 under the hard anti-affinity preset and "25%" otherwise.
 	// +optional
 	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty,omitzero"`
 }
@@ -139,9 +250,125 @@ type MonitoringSpec struct {
 	// +optional
 	ExporterResources *corev1.ResourceRequirements `json:"exporterResources,omitempty,omitzero"`
 
+	// ExporterSecurityContext overrides spec.security.containerSecurityContext for
+	// the exporter sidecar only, for exporter images that need different settings
+	// such as another uid. The memcached container keeps the shared context.
+	// +optional
+	ExporterSecurityContext *corev1.SecurityContext `json:"exporterSecurityContext,omitempty,omitzero"`
+
 	// ServiceMonitor configures the Prometheus ServiceMonitor resource.
 	// +optional
 	ServiceMonitor *ServiceMonitorSpec `json:"serviceMonitor,omitempty,omitzero"`
+
+	// ExporterTLS configures TLS for the exporter's own /metrics endpoint.
+	// +optional
+	ExporterTLS *ExporterTLSSpec `json:"exporterTLS,omitempty,omitzero"`
+
+	// ExporterMemcachedAddress is the host:port the exporter scrapes, passed as --memcached.address.
+	// The stats sidecar uses it as MEMCACHED_ADDRESS as well. Defaults to the unix socket path
+	// when spec.memcached.unixSocket is enabled, or localhost on the memcached container port.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	ExporterMemcachedAddress *string `json:"exporterMemcachedAddress,omitempty"`
+
+	// ScrapeAnnotations stamps the prometheus.io/scrape, prometheus.io/port and
+	// prometheus.io/path annotations onto the pod template, for clusters that
+	// discover scrape targets by annotation instead of a ServiceMonitor.
+	// +optional
+	ScrapeAnnotations bool `json:"scrapeAnnotations,omitempty"`
+
+	// DisabledMetricGroups lists exporter metric groups to skip, each passed as a
+	// --no-memcached.<group> flag to reduce the number of scraped series.
+	// Supported groups are "items", "settings" and "slabs".
+	// +kubebuilder:validation:MaxItems=8
+	// +listType=set
+	// +optional
+	DisabledMetricGroups []string `json:"disabledMetricGroups,omitempty"`
+
+	// ExporterReadinessProbe adds a readiness probe to the exporter sidecar that
+	// only succeeds while the exporter reports memcached_up 1, so a pod whose
+	// memcached is unreachable is marked not ready instead of serving zeroed metrics.
+	// The probe runs wget and grep inside the exporter image.
+	// +optional
+	ExporterReadinessProbe bool `json:"exporterReadinessProbe,omitempty"`
+
+	// PushGateway adds a sidecar that pushes the exporter's metrics to a Prometheus
+	// Pushgateway on a timer, for short-lived instances that no Prometheus scrapes.
+	// It requires monitoring to be enabled and works alongside scraping.
+	// +optional
+	PushGateway *PushGatewaySpec `json:"pushGateway,omitempty,omitzero"`
+}
+
+// PushGatewaySpec configures pushing the exporter's metrics to a Prometheus Pushgateway.
+type PushGatewaySpec struct {
+	// URL is the base URL of the Pushgateway, e.g. "http://pushgateway.monitoring:9091".
+	// +kubebuilder:validation:Pattern=`^https?://.+`
+	URL string `json:"url"`
+
+	// JobName is the job label the metrics are grouped under. Each pod pushes under
+	// its own instance label. Defaults to the Memcached name.
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9_.:-]+$`
+	// +optional
+	JobName string `json:"jobName,omitempty"`
+
+	// IntervalSeconds is the time between pushes. Defaults to 30.
+	// +kubebuilder:validation:Minimum=5
+	// +kubebuilder:validation:Maximum=3600
+	// +optional
+	IntervalSeconds *int32 `json:"intervalSeconds,omitempty"`
+}
+
+// ExporterTLSSpec defines TLS serving configuration for the memcached-exporter sidecar.
+type ExporterTLSSpec struct {
+	// Enabled controls whether the exporter serves /metrics over HTTPS.
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+
+	// CertificateSecretRef is a reference to the Secret containing the exporter's serving certificate.
+	// The Secret must contain "tls.crt" and "tls.key" keys.
+	// +optional
+	CertificateSecretRef corev1.LocalObjectReference `json:"certificateSecretRef,omitempty"`
+
+	// CASecretRef selects the Secret key holding the CA bundle the ServiceMonitor uses to
+	// verify the exporter's certificate. Mutually exclusive with caConfigMapRef.
+	// +optional
+	CASecretRef *corev1.SecretKeySelector `json:"caSecretRef,omitempty"`
+
+	// CAConfigMapRef selects the ConfigMap key holding the CA bundle the ServiceMonitor uses
+	// to verify the exporter's certificate. Mutually exclusive with caSecretRef.
+	// +optional
+	CAConfigMapRef *corev1.ConfigMapKeySelector `json:"caConfigMapRef,omitempty"`
+
+	// ServerName is the name the ServiceMonitor verifies the exporter's certificate against.
+	// Defaults to the scraped pod address, which a serving certificate rarely covers.
+	// +optional
+	ServerName string `json:"serverName,omitempty"`
+
+	// InsecureSkipVerify disables verification of the exporter's certificate by the
+	// ServiceMonitor. Intended for self-signed certificates without a distributable CA.
+	// +optional
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+}
+
+// StatsSidecarSpec defines a sidecar that serves memcached "stats" as JSON over HTTP.
+// It is independent of the Prometheus exporter. The sidecar receives the memcached
+// address in MEMCACHED_ADDRESS and the port to listen on in STATS_PORT.
+type StatsSidecarSpec struct {
+	// Enabled controls whether the stats sidecar is added to the Pod.
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Image is the container image of the stats sidecar. Required when enabled.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Image *string `json:"image,omitempty"`
+
+	// Port is the HTTP port the sidecar listens on, exposed as the "stats"
+	// container and Service port. Defaults to 8080.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	Port *int32 `json:"port,omitempty"`
 }
 
 // ServiceMonitorSpec defines the Prometheus ServiceMonitor configuration.
@@ -159,11 +386,22 @@ type ServiceMonitorSpec struct {
 	// +kubebuilder:default="10s"
 	// +optional
 	ScrapeTimeout string `json:"scrapeTimeout,omitempty"`
+
+	// HonorLabels keeps the labels of scraped metrics when they conflict with
+	// target labels. Unset keeps the Prometheus default (false).
+	// +optional
+	HonorLabels *bool `json:"honorLabels,omitempty"`
+
+	// HonorTimestamps makes Prometheus use the timestamps exposed by the exporter.
+	// Unset keeps the Prometheus default (true).
+	// +optional
+	HonorTimestamps *bool `json:"honorTimestamps,omitempty"`
 }
 
 // SecuritySpec defines security settings for Memcached.
 type SecuritySpec struct {
-	// PodSecurityContext defines the security context for the Memcached pod.
+	// PodSecurityContext defines the security context for the Memcached pod. Its
+	// sysctls are subject to the same safe-set check as Sysctls.
 	// +optional
 	PodSecurityContext *corev1.PodSecurityContext `json:"podSecurityContext,omitempty,omitzero"`
 
@@ -182,6 +420,17 @@ type SecuritySpec struct {
 	// NetworkPolicy configures the Kubernetes NetworkPolicy for Memcached pods.
 	// +optional
 	NetworkPolicy *NetworkPolicySpec `json:"networkPolicy,omitempty,omitzero"`
+
+	// Sysctls are namespaced kernel parameters set on the Memcached pod, e.g.
+	// net.core.somaxconn for high-connection caches. They are appended to
+	// podSecurityContext.sysctls, taking precedence on name clashes. Only the
+	// Kubernetes safe sysctls are accepted unless the operator runs with
+	// --allow-unsafe-sysctls (the kubelet must then allow them as well).
+	// +kubebuilder:validation:MaxItems=32
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Sysctls []corev1.Sysctl `json:"sysctls,omitempty"`
 }
 
 // SASLSpec defines SASL authentication configuration.
@@ -194,6 +443,15 @@ type SASLSpec struct {
 	// The Secret must contain a "password-file" key with the SASL password file content.
 	// +optional
 	CredentialsSecretRef corev1.LocalObjectReference `json:"credentialsSecretRef,omitempty"`
+
+	// CredentialsSecretNameTemplate is a Go text/template resolved against the
+	// Memcached resource into the name of the SASL credentials Secret, for
+	// Secrets whose names are generated (e.g. by External Secrets Operator).
+	// The fields .Name and .Namespace are available, e.g. "{{ .Name }}-sasl".
+	// Mutually exclusive with credentialsSecretRef.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	CredentialsSecretNameTemplate *string `json:"credentialsSecretNameTemplate,omitempty"`
 }
 
 // TLSSpec defines TLS encryption configuration.
@@ -212,6 +470,58 @@ type TLSSpec struct {
 	// The CA certificate in the Secret (ca.crt) will be used to verify client certificates.
 	// +optional
 	EnableClientCert bool `json:"enableClientCert,omitempty"`
+
+	// CopyFromNamespace, when set, makes the operator copy the certificate Secret
+	// named by CertificateSecretRef from this namespace into the Memcached
+	// namespace before mounting it. The copy is owned by the Memcached resource
+	// and kept in sync with the source. The operator must allow the namespace via
+	// --tls-copy-source-namespaces, and the source Secret must list the Memcached
+	// namespace in its memcached.c5c3.io/copy-allowed-to annotation.
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +optional
+	CopyFromNamespace *string `json:"copyFromNamespace,omitempty"`
+
+	// Port is the TLS port exposed on the container, Service, and NetworkPolicy.
+	// Defaults to 11212 when unset. Must differ from the plaintext port 11211.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	Port *int32 `json:"port,omitempty"`
+
+	// GenerateCertificate, when set, makes the operator create a cert-manager
+	// Certificate that issues the Secret named by CertificateSecretRef, instead of
+	// expecting the Secret to be created beforehand. Requires cert-manager.
+	// +optional
+	GenerateCertificate *GenerateCertificateSpec `json:"generateCertificate,omitempty"`
+}
+
+// GenerateCertificateSpec configures the cert-manager Certificate generated for TLS.
+type GenerateCertificateSpec struct {
+	// IssuerRef references the cert-manager Issuer or ClusterIssuer that signs the certificate.
+	IssuerRef CertificateIssuerRef `json:"issuerRef"`
+
+	// DNSNames are the DNS subject alternative names of the certificate. When empty,
+	// the operator uses the stable names of the Service, including the wildcard
+	// forms that cover per-pod DNS names of a headless Service.
+	// +kubebuilder:validation:MaxItems=32
+	// +kubebuilder:validation:items:MinLength=1
+	// +listType=atomic
+	// +optional
+	DNSNames []string `json:"dnsNames,omitempty"`
+}
+
+// CertificateIssuerRef references a cert-manager issuer.
+type CertificateIssuerRef struct {
+	// Name is the name of the issuer.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Kind is the kind of the issuer.
+	// +kubebuilder:validation:Enum=Issuer;ClusterIssuer
+	// +kubebuilder:default="Issuer"
+	// +optional
+	Kind string `json:"kind,omitempty"`
 }
 
 // NetworkPolicySpec defines the NetworkPolicy configuration for Memcached.
@@ -224,6 +534,21 @@ type NetworkPolicySpec struct {
 	// When empty or nil, all sources are allowed.
 	// +optional
 	AllowedSources []networkingv1.NetworkPolicyPeer `json:"allowedSources,omitempty,omitzero"`
+
+	// AllowDebugNamespace is the name of a troubleshooting namespace whose pods are
+	// allowed to reach the Memcached port in an additional ingress rule, independent
+	// of AllowedSources. The namespace is matched by its kubernetes.io/metadata.name label.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +optional
+	AllowDebugNamespace *string `json:"allowDebugNamespace,omitempty,omitzero"`
+
+	// CreateDefaultDeny creates a companion NetworkPolicy named <name>-default-deny
+	// that selects the Memcached pods and allows no ingress, so only the allow
+	// policy opens ports. It is removed together with the allow policy.
+	// +optional
+	CreateDefaultDeny bool `json:"createDefaultDeny,omitempty"`
 }
 
 // AutoscalingSpec defines horizontal pod autoscaling configuration for Memcached.
@@ -255,11 +580,146 @@ type AutoscalingSpec struct {
 	Behavior *autoscalingv2.HorizontalPodAutoscalerBehavior `json:"behavior,omitempty,omitzero"`
 }
 
-// ServiceSpec defines configuration for the headless Service.
+// RollingUpdateSpec defines the rolling update parameters of the Deployment.
+// For each parameter, the absolute and the percentage form are mutually exclusive.
+// When neither is set, maxSurge defaults to 1 and maxUnavailable to 0.
+type RollingUpdateSpec struct {
+	// MaxUnavailable is the maximum number of pods that can be unavailable during the update.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxUnavailable *int32 `json:"maxUnavailable,omitempty"`
+
+	// MaxUnavailablePercent is the maximum percentage of desired pods that can be
+	// unavailable during the update, rendered as a percentage of the replica count.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	MaxUnavailablePercent *int32 `json:"maxUnavailablePercent,omitempty"`
+
+	// MaxSurge is the maximum number of pods that can be created above the desired count.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxSurge *int32 `json:"maxSurge,omitempty"`
+
+	// MaxSurgePercent is the maximum percentage of desired pods that can be created
+	// above the desired count, rendered as a percentage of the replica count.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	MaxSurgePercent *int32 `json:"maxSurgePercent,omitempty"`
+}
+
+// MaintenanceSpec defines a maintenance mode for the Memcached instance.
+// memcached has no native read-only switch, so read-only mode is signaled through
+// annotations and status for clients and service meshes to act on.
+type MaintenanceSpec struct {
+	// ReadOnly marks the instance as read-only. The operator annotates the Service
+	// and Deployment with memcached.c5c3.io/read-only=true and sets the Maintenance
+	// condition to True; Pods are not annotated so that toggling does not restart them.
+	// +optional
+	ReadOnly bool `json:"readOnly,omitempty"`
+
+	// Replicas is the replica count applied while readOnly is true. It is ignored
+	// when autoscaling is enabled. When nil, spec.replicas is kept.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=64
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+}
+
+// MaintenanceWindowSpec defines recurring windows in which Pod template changes
+// are rolled out.
+type MaintenanceWindowSpec struct {
+	// Schedule is a five-field cron expression ("minute hour day-of-month month
+	// day-of-week", numeric values only, evaluated in UTC) marking the start of
+	// each window, e.g. "0 2 * * 6" for Saturdays at 02:00.
+	// +kubebuilder:validation:MinLength=1
+	Schedule string `json:"schedule"`
+
+	// DurationMinutes is the length of each window.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10080
+	DurationMinutes int32 `json:"durationMinutes"`
+
+	// DeferReplicaChanges also holds replica count changes until the next window.
+	// Defaults to false: replica changes apply immediately.
+	// +optional
+	DeferReplicaChanges bool `json:"deferReplicaChanges,omitempty"`
+}
+
+// CanarySpec defines a canary Deployment that runs a different Memcached image on
+// a subset of pods behind the same Service.
+type CanarySpec struct {
+	// Enabled controls whether the canary Deployment is created.
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Image is the Memcached container image of the canary pods. Required when enabled.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Image *string `json:"image,omitempty"`
+
+	// Replicas is the number of canary pods, on top of the regular replicas.
+	// Defaults to 1.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=64
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+}
+
+// Values of spec.service.type.
+const (
+	// ServiceTypeHeadless selects a headless Service (clusterIP: None).
+	ServiceTypeHeadless = "Headless"
+	// ServiceTypeClusterIP selects a ClusterIP Service with a virtual IP.
+	ServiceTypeClusterIP = "ClusterIP"
+)
+
+// ServiceSpec defines configuration for the Service.
 type ServiceSpec struct {
 	// Annotations are custom annotations added to the Service metadata.
 	// +optional
 	Annotations map[string]string `json:"annotations,omitempty,omitzero"`
+
+	// Type selects a headless Service (clusterIP: None), whose DNS name resolves
+	// to the pod addresses, or a ClusterIP Service with a virtual IP. The clusterIP
+	// of a Service is immutable, so changing the type recreates the Service.
+	// Defaults to Headless.
+	// +kubebuilder:validation:Enum=Headless;ClusterIP
+	// +kubebuilder:default=Headless
+	// +optional
+	Type *string `json:"type,omitempty"`
+
+	// TrafficDistribution is the traffic distribution preference of the Service.
+	// It only takes effect for ClusterIP Services; for a headless Service the
+	// value is ignored.
+	// +kubebuilder:validation:Enum=PreferClose;PreferSameZone;PreferSameNode
+	// +optional
+	TrafficDistribution *string `json:"trafficDistribution,omitempty"`
+
+	// TopologyAwareHints annotates the Service with
+	// service.kubernetes.io/topology-aware-hints (and its successor
+	// service.kubernetes.io/topology-mode) set to auto, so the EndpointSlice
+	// controller adds zone hints to the endpoints for topology-aware clients. Hints
+	// are only populated when the pods are spread across zones.
+	// +optional
+	TopologyAwareHints bool `json:"topologyAwareHints,omitempty"`
+
+	// TrackEndpoints publishes the ready pod addresses from the EndpointSlices of
+	// the Service in status.readyEndpoints. The EndpointSlices are watched and
+	// listed on every reconcile regardless, for the ready-endpoints Service
+	// annotation; this flag only controls the status field. Defaults to false.
+	// +optional
+	TrackEndpoints bool `json:"trackEndpoints,omitempty"`
+
+	// Manage controls whether the operator creates and updates the Service. When
+	// false, a Service the operator created earlier is deleted (or orphaned with
+	// retainOrphansOnDisable), and an externally managed Service whose selector
+	// matches the instance labels must exist in the namespace; otherwise the
+	// instance is reported Degraded with reason ServiceMissing. Defaults to true.
+	// +kubebuilder:default=true
+	// +optional
+	Manage *bool `json:"manage,omitempty"`
 }
 
 // MemcachedSpec defines the desired state of Memcached.
@@ -277,10 +737,28 @@ type MemcachedSpec struct {
 	// +optional
 	Image *string `json:"image,omitempty,omitzero"`
 
+	// ImagePullPolicy overrides the image pull policy of the Memcached and exporter
+	// containers. When nil, the operator sets Always for untagged and ":latest"
+	// images and IfNotPresent for versioned tags and digests.
+	// +kubebuilder:validation:Enum=Always;Never;IfNotPresent
+	// +optional
+	ImagePullPolicy *corev1.PullPolicy `json:"imagePullPolicy,omitempty"`
+
 	// Resources defines resource requests and limits for the Memcached container.
 	// +optional
 	Resources *corev1.ResourceRequirements `json:"resources,omitempty,omitzero"`
 
+	// QoSClass is the intended Kubernetes QoS class of the Memcached pods.
+	// Guaranteed sets the CPU and memory limits equal to the requests on the
+	// memcached container and, with monitoring enabled, the exporter container,
+	// using whichever of the two is provided; missing or mismatched values are
+	// rejected by the validating webhook. The pushgateway and stats sidecars have
+	// no resources, so a pod running either is Burstable and the webhook warns.
+	// BestEffort and Burstable leave resources as configured.
+	// +kubebuilder:validation:Enum=BestEffort;Burstable;Guaranteed
+	// +optional
+	QoSClass corev1.PodQOSClass `json:"qosClass,omitempty"`
+
 	// Memcached contains the Memcached server configuration.
 	// +optional
 	Memcached *MemcachedConfig `json:"memcached,omitempty,omitzero"`
@@ -293,6 +771,10 @@ type MemcachedSpec struct {
 	// +optional
 	Monitoring *MonitoringSpec `json:"monitoring,omitempty,omitzero"`
 
+	// StatsSidecar configures a sidecar serving memcached stats as JSON over HTTP.
+	// +optional
+	StatsSidecar *StatsSidecarSpec `json:"statsSidecar,omitempty,omitzero"`
+
 	// Security contains security settings.
 	// +optional
 	Security *SecuritySpec `json:"security,omitempty,omitzero"`
@@ -301,9 +783,114 @@ type MemcachedSpec struct {
 	// +optional
 	Autoscaling *AutoscalingSpec `json:"autoscaling,omitempty,omitzero"`
 
-	// Service contains configuration for the headless Service.
+	// Service contains configuration for the Service.
 	// +optional
 	Service *ServiceSpec `json:"service,omitempty,omitzero"`
+
+	// RollingUpdate configures the Deployment rolling update strategy.
+	// +optional
+	RollingUpdate *RollingUpdateSpec `json:"rollingUpdate,omitempty,omitzero"`
+
+	// Maintenance configures the maintenance (read-only) mode.
+	// +optional
+	Maintenance *MaintenanceSpec `json:"maintenance,omitempty,omitzero"`
+
+	// MaintenanceWindow restricts rollouts of Pod template changes, such as image
+	// or configuration changes, to recurring windows. Outside a window the changes
+	// are staged on the paused Deployment and rolled out when the next window opens.
+	// +optional
+	MaintenanceWindow *MaintenanceWindowSpec `json:"maintenanceWindow,omitempty,omitzero"`
+
+	// Canary configures a canary Deployment named <name>-canary whose pods run
+	// canary.image and are selected by the same Service as the regular pods.
+	// +optional
+	Canary *CanarySpec `json:"canary,omitempty,omitzero"`
+
+	// PropagateLabels lists label keys on the Memcached resource that are copied onto
+	// every owned resource. Operator-managed labels take precedence on conflict.
+	// +optional
+	// +listType=set
+	PropagateLabels []string `json:"propagateLabels,omitempty"`
+
+	// PropagateAnnotations lists annotation keys on the Memcached resource that are copied
+	// onto every owned resource. Operator-managed annotations take precedence on conflict.
+	// +optional
+	// +listType=set
+	PropagateAnnotations []string `json:"propagateAnnotations,omitempty"`
+
+	// RetainOrphansOnDisable keeps the optional PodDisruptionBudget, ServiceMonitor
+	// and NetworkPolicy in place when their feature is disabled, and the Service when
+	// spec.service.manage is set to false. The operator removes
+	// its owner reference and stops managing the resource instead of deleting it.
+	// The HorizontalPodAutoscaler is always deleted when autoscaling is disabled, since
+	// it would fight the operator over the Deployment's replicas. Defaults to false (delete).
+	// +optional
+	RetainOrphansOnDisable bool `json:"retainOrphansOnDisable,omitempty"`
+
+	// RuntimeClassName selects the RuntimeClass the Memcached pods run with, such as
+	// a sandboxed kata or gVisor runtime.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	RuntimeClassName *string `json:"runtimeClassName,omitempty"`
+
+	// PodOverhead is the resource overhead of the pod sandbox, set as the pod's
+	// overhead so the scheduler accounts for it on top of the container requests.
+	// It must match the overhead of the RuntimeClass named by runtimeClassName.
+	// +optional
+	PodOverhead corev1.ResourceList `json:"podOverhead,omitempty"`
+
+	// SuspendRollout pauses the Deployment so that Pod template changes are staged
+	// without rolling out. Setting it back to false resumes the rollout with all
+	// staged changes. Defaults to false.
+	// +optional
+	SuspendRollout bool `json:"suspendRollout,omitempty"`
+
+	// OtelResourceAttributes stamps the OpenTelemetry resource attributes
+	// service.name, service.namespace and service.version onto the Memcached pods
+	// as resource.opentelemetry.io/* annotations, derived from the CR name,
+	// namespace and image tag. Defaults to false.
+	// +optional
+	OtelResourceAttributes bool `json:"otelResourceAttributes,omitempty"`
+
+	// RestartPolicy is the restart policy of the Memcached pods. Deployments only
+	// support Always, which is also the default and the only value the schema accepts.
+	// +kubebuilder:validation:Enum=Always
+	// +optional
+	RestartPolicy corev1.RestartPolicy `json:"restartPolicy,omitempty"`
+
+	// SchedulerName selects the scheduler that places the Memcached pods, such as
+	// a custom batch scheduler like Volcano or YuniKorn. When unset, pods use the
+	// cluster's default scheduler.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +optional
+	SchedulerName *string `json:"schedulerName,omitempty"`
+
+	// PreserveWarmPodsOnScaleDown annotates the Memcached pods with a
+	// controller.kubernetes.io/pod-deletion-cost that grows with pod age, so the
+	// ReplicaSet controller removes the newest (coldest) pods first on scale-down.
+	// Defaults to false.
+	// +optional
+	PreserveWarmPodsOnScaleDown bool `json:"preserveWarmPodsOnScaleDown,omitempty"`
+
+	// MinReadySeconds is the Deployment's spec.minReadySeconds: how long a new pod
+	// must be ready before it counts as available during a rollout. When unset and
+	// graceful shutdown or multi-replica high availability is enabled, it defaults
+	// to the readiness probe period; otherwise it defaults to 0.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=3600
+	// +optional
+	MinReadySeconds *int32 `json:"minReadySeconds,omitempty"`
+
+	// RevisionHistoryLimit is the number of old ReplicaSets of the Deployment kept
+	// for rollback. This field only exists in v1beta1; objects written through
+	// v1alpha1 receive the default from the defaulting webhook.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:default=10
+	// +optional
+	RevisionHistoryLimit *int32 `json:"revisionHistoryLimit,omitempty"`
 }
 
 // MemcachedStatus defines the observed state of Memcached.
@@ -326,11 +913,57 @@ type MemcachedStatus struct {
 
 	// ServerList contains the Memcached service DNS entries in host:port format
 	// (e.g. "my-cache.default:11211"). The controller populates this list from
-	// the headless service DNS when the cluster is Ready, so clients can consume
+	// the Service DNS name when the cluster is Ready, so clients can consume
 	// it directly for cache-ring construction. (REQ-005, MO-0056)
 	// +optional
 	// +listType=atomic
 	ServerList []string `json:"serverList,omitempty"`
+
+	// Phase is a single-word summary of the status conditions for dashboards.
+	// +kubebuilder:validation:Enum=Pending;Running;Degraded;Paused;Terminating
+	// +optional
+	Phase string `json:"phase,omitempty"`
+
+	// ReadyEndpoints lists the addresses of the ready endpoints published in the
+	// EndpointSlices of the Service, sorted. It is only populated when
+	// spec.service.trackEndpoints is true.
+	// +optional
+	// +listType=atomic
+	ReadyEndpoints []string `json:"readyEndpoints,omitempty"`
+
+	// CurrentImage is the image of the memcached container in the generated
+	// Deployment, i.e. spec.image or the operator default when unset.
+	// +optional
+	CurrentImage string `json:"currentImage,omitempty"`
+
+	// RecentActions lists the most recent changes the operator made to owned
+	// resources, oldest first, capped at the last 5 entries.
+	// +kubebuilder:validation:MaxItems=5
+	// +optional
+	// +listType=atomic
+	RecentActions []ActionEntry `json:"recentActions,omitempty"`
+
+	// Recommendations are advisory hints computed by the operator when
+	// spec.memcached looks mis-sized for spec.resources, e.g. fewer threads than
+	// the CPU limit allows. They are informational only and never change the
+	// generated Deployment.
+	// +optional
+	// +listType=atomic
+	Recommendations []string `json:"recommendations,omitempty"`
+}
+
+// ActionEntry records one change the operator made to an owned resource.
+type ActionEntry struct {
+	// Time is when the change was made.
+	Time metav1.Time `json:"time"`
+
+	// Action is the operation performed: Created, Updated or Deleted.
+	// +kubebuilder:validation:Enum=Created;Updated;Deleted
+	Action string `json:"action"`
+
+	// Message describes the change, e.g. "Updated Deployment my-cache".
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -338,6 +971,7 @@ type MemcachedStatus struct {
 // +kubebuilder:storageversion
 // +kubebuilder:printcolumn:name="Replicas",type="integer",JSONPath=".spec.replicas",description="Number of desired Memcached pods"
 // +kubebuilder:printcolumn:name="Ready",type="integer",JSONPath=".status.readyReplicas",description="Number of ready Memcached pods"
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase",description="Summary of the status conditions"
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // Memcached is the Schema for the memcacheds API.
@@ -365,6 +999,20 @@ func (mc *Memcached) IsTLSEnabled() bool {
 		mc.Spec.Security.TLS.Enabled
 }
 
+// IsCertificateGenerated returns true when TLS is enabled and its Secret is issued
+// by an operator-managed cert-manager Certificate.
+func (mc *Memcached) IsCertificateGenerated() bool {
+	return mc.IsTLSEnabled() && mc.Spec.Security.TLS.GenerateCertificate != nil
+}
+
+// TLSPort returns the configured TLS port, or DefaultTLSPort when unset.
+func (mc *Memcached) TLSPort() int32 {
+	if mc.Spec.Security != nil && mc.Spec.Security.TLS != nil && mc.Spec.Security.TLS.Port != nil {
+		return *mc.Spec.Security.TLS.Port
+	}
+	return DefaultTLSPort
+}
+
 // IsSASLEnabled returns true when SASL authentication is explicitly enabled.
 func (mc *Memcached) IsSASLEnabled() bool {
 	return mc.Spec.Security != nil &&
@@ -372,6 +1020,94 @@ func (mc *Memcached) IsSASLEnabled() bool {
 		mc.Spec.Security.SASL.Enabled
 }
 
+// SASLCredentialsSecretName returns the name of the SASL credentials Secret:
+// spec.security.sasl.credentialsSecretNameTemplate resolved against mc when set,
+// otherwise spec.security.sasl.credentialsSecretRef.name. It returns an empty
+// string when SASL is not configured or the template cannot be resolved.
+func (mc *Memcached) SASLCredentialsSecretName() string {
+	if mc.Spec.Security == nil || mc.Spec.Security.SASL == nil {
+		return ""
+	}
+	sasl := mc.Spec.Security.SASL
+	if sasl.CredentialsSecretNameTemplate == nil {
+		return sasl.CredentialsSecretRef.Name
+	}
+	name, err := ResolveSecretNameTemplate(*sasl.CredentialsSecretNameTemplate, mc)
+	if err != nil {
+		return ""
+	}
+	return name
+}
+
+// ResolveSecretNameTemplate executes the Go text/template tmpl with the name and
+// namespace of mc (available as .Name and .Namespace) and returns the result with
+// surrounding whitespace trimmed.
+func ResolveSecretNameTemplate(tmpl string, mc *Memcached) (string, error) {
+	t, err := template.New("secretName").Option("missingkey=error").Parse(tmpl)
+	if err != nil {
+		return "", err
+	}
+	data := struct {
+		Name      string
+		Namespace string
+	}{Name: mc.Name, Namespace: mc.Namespace}
+	var b strings.Builder
+	if err := t.Execute(&b, data); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(b.String()), nil
+}
+
+// RevisionHistoryLimit returns the configured Deployment revision history limit,
+// or DefaultRevisionHistoryLimit when unset.
+func (mc *Memcached) RevisionHistoryLimit() int32 {
+	if mc.Spec.RevisionHistoryLimit != nil {
+		return *mc.Spec.RevisionHistoryLimit
+	}
+	return DefaultRevisionHistoryLimit
+}
+
+// IsReadOnly returns true when the maintenance read-only mode is enabled.
+func (mc *Memcached) IsReadOnly() bool {
+	return mc.Spec.Maintenance != nil && mc.Spec.Maintenance.ReadOnly
+}
+
+// DesiredReplicas returns the replica count the Deployment should run when
+// autoscaling is disabled: spec.maintenance.replicas while read-only, otherwise
+// spec.replicas, falling back to DefaultReplicas.
+func (mc *Memcached) DesiredReplicas() int32 {
+	if mc.IsReadOnly() && mc.Spec.Maintenance.Replicas != nil {
+		return *mc.Spec.Maintenance.Replicas
+	}
+	if mc.Spec.Replicas != nil {
+		return *mc.Spec.Replicas
+	}
+	return DefaultReplicas
+}
+
+// IsCanaryEnabled returns true when the canary Deployment is explicitly enabled.
+func (mc *Memcached) IsCanaryEnabled() bool {
+	return mc.Spec.Canary != nil && mc.Spec.Canary.Enabled
+}
+
+// CanaryReplicas returns the configured canary replica count, or DefaultCanaryReplicas when unset.
+func (mc *Memcached) CanaryReplicas() int32 {
+	if mc.Spec.Canary != nil && mc.Spec.Canary.Replicas != nil {
+		return *mc.Spec.Canary.Replicas
+	}
+	return DefaultCanaryReplicas
+}
+
+// IsServiceHeadless returns true unless spec.service.type is ClusterIP.
+func (mc *Memcached) IsServiceHeadless() bool {
+	return mc.Spec.Service == nil || mc.Spec.Service.Type == nil || *mc.Spec.Service.Type != ServiceTypeClusterIP
+}
+
+// IsServiceManaged returns true unless spec.service.manage is explicitly false.
+func (mc *Memcached) IsServiceManaged() bool {
+	return mc.Spec.Service == nil || mc.Spec.Service.Manage == nil || *mc.Spec.Service.Manage
+}
+
 // IsMonitoringEnabled returns true when the monitoring exporter sidecar is enabled.
 func (mc *Memcached) IsMonitoringEnabled() bool {
 	return mc.Spec.Monitoring != nil && mc.Spec.Monitoring.Enabled
@@ -385,6 +1121,71 @@ func (mc *Memcached) IsServiceMonitorEnabled() bool {
 		mc.Spec.Monitoring.ServiceMonitor != nil
 }
 
+// IsExporterTLSEnabled returns true when monitoring is enabled and the exporter
+// serves its /metrics endpoint over TLS.
+func (mc *Memcached) IsExporterTLSEnabled() bool {
+	return mc.IsMonitoringEnabled() &&
+		mc.Spec.Monitoring.ExporterTLS != nil &&
+		mc.Spec.Monitoring.ExporterTLS.Enabled
+}
+
+// IsStatsSidecarEnabled returns true when the stats sidecar is explicitly enabled.
+func (mc *Memcached) IsStatsSidecarEnabled() bool {
+	return mc.Spec.StatsSidecar != nil && mc.Spec.StatsSidecar.Enabled
+}
+
+// StatsSidecarPort returns the configured stats sidecar port, or DefaultStatsSidecarPort when unset.
+func (mc *Memcached) StatsSidecarPort() int32 {
+	if mc.Spec.StatsSidecar != nil && mc.Spec.StatsSidecar.Port != nil {
+		return *mc.Spec.StatsSidecar.Port
+	}
+	return DefaultStatsSidecarPort
+}
+
+// IsUnixSocketEnabled returns true when the memcached unix socket is explicitly enabled.
+func (mc *Memcached) IsUnixSocketEnabled() bool {
+	return mc.Spec.Memcached != nil &&
+		mc.Spec.Memcached.UnixSocket != nil &&
+		mc.Spec.Memcached.UnixSocket.Enabled
+}
+
+// HasReadOnlyRootFilesystem returns true when the memcached container runs with
+// a read-only root filesystem.
+func (mc *Memcached) HasReadOnlyRootFilesystem() bool {
+	return mc.Spec.Security != nil &&
+		mc.Spec.Security.ContainerSecurityContext != nil &&
+		mc.Spec.Security.ContainerSecurityContext.ReadOnlyRootFilesystem != nil &&
+		*mc.Spec.Security.ContainerSecurityContext.ReadOnlyRootFilesystem
+}
+
+// IsPlaintextLoopbackOnly returns true when spec.memcached.listenAddresses binds
+// memcached to loopback addresses only, so the plaintext port cannot be reached
+// through the Pod IP.
+func (mc *Memcached) IsPlaintextLoopbackOnly() bool {
+	if mc.Spec.Memcached == nil || len(mc.Spec.Memcached.ListenAddresses) == 0 {
+		return false
+	}
+	for _, addr := range mc.Spec.Memcached.ListenAddresses {
+		if !IsLoopbackAddress(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsLoopbackAddress reports whether a listen address names a loopback interface.
+func IsLoopbackAddress(addr string) bool {
+	return addr == "localhost" || addr == "::1" || strings.HasPrefix(addr, "127.")
+}
+
+// UnixSocketPath returns the configured unix socket path, or DefaultUnixSocketPath when unset.
+func (mc *Memcached) UnixSocketPath() string {
+	if mc.Spec.Memcached != nil && mc.Spec.Memcached.UnixSocket != nil && mc.Spec.Memcached.UnixSocket.Path != nil {
+		return *mc.Spec.Memcached.UnixSocket.Path
+	}
+	return DefaultUnixSocketPath
+}
+
 // IsAutoscalingEnabled returns true when horizontal pod autoscaling is explicitly enabled.
 func (mc *Memcached) IsAutoscalingEnabled() bool {
 	return mc.Spec.Autoscaling != nil && mc.Spec.Autoscaling.Enabled
@@ -411,6 +1212,12 @@ func (mc *Memcached) IsNetworkPolicyEnabled() bool {
 		mc.Spec.Security.NetworkPolicy.Enabled
 }
 
+// IsDefaultDenyNetworkPolicyEnabled returns true when the NetworkPolicy is enabled
+// together with its companion default-deny NetworkPolicy.
+func (mc *Memcached) IsDefaultDenyNetworkPolicyEnabled() bool {
+	return mc.IsNetworkPolicyEnabled() && mc.Spec.Security.NetworkPolicy.CreateDefaultDeny
+}
+
 func init() {
 	SchemeBuilder.Register(&Memcached{}, &MemcachedList{})
 }
@@ -113,6 +113,29 @@ func TestMemcached_IsTLSEnabled(t *testing.T) {
 	}
 }
 
+func TestMemcached_TLSPort(t *testing.T) {
+	port := int32(12345)
+	withPort := newTestMemcached(withTLS(true))
+	withPort.Spec.Security.TLS.Port = &port
+
+	tests := []struct {
+		name string
+		mc   *Memcached
+		want int32
+	}{
+		{"nil Security", newTestMemcached(), DefaultTLSPort},
+		{"TLS without port", newTestMemcached(withTLS(true)), DefaultTLSPort},
+		{"TLS with custom port", withPort, 12345},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.mc.TLSPort(); got != tt.want {
+				t.Errorf("TLSPort() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestMemcached_IsSASLEnabled(t *testing.T) {
 	tests := []struct {
 		name string
@@ -133,6 +156,69 @@ func TestMemcached_IsSASLEnabled(t *testing.T) {
 	}
 }
 
+func TestMemcached_SASLCredentialsSecretName(t *testing.T) {
+	withTemplate := func(tmpl string) func(*Memcached) {
+		return func(mc *Memcached) {
+			mc.Spec.Security.SASL.CredentialsSecretNameTemplate = &tmpl
+		}
+	}
+	withRef := func(mc *Memcached) {
+		mc.Spec.Security.SASL.CredentialsSecretRef.Name = "sasl-secret"
+	}
+
+	tests := []struct {
+		name string
+		mc   *Memcached
+		want string
+	}{
+		{"nil Security", newTestMemcached(), ""},
+		{"secret ref", newTestMemcached(withSASL(true), withRef), "sasl-secret"},
+		{"name template", newTestMemcached(withSASL(true), withTemplate("{{ .Name }}-sasl")), "test-sasl"},
+		{"namespace template", newTestMemcached(withSASL(true), withTemplate("{{ .Namespace }}-{{ .Name }}")), "default-test"},
+		{"whitespace trimmed", newTestMemcached(withSASL(true), withTemplate(" {{ .Name }} ")), "test"},
+		{"unparseable template", newTestMemcached(withSASL(true), withTemplate("{{ .Name")), ""},
+		{"unknown field", newTestMemcached(withSASL(true), withTemplate("{{ .Labels }}")), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.mc.SASLCredentialsSecretName(); got != tt.want {
+				t.Errorf("SASLCredentialsSecretName() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMemcached_DesiredReplicas(t *testing.T) {
+	replicas := func(n int32) func(*Memcached) {
+		return func(mc *Memcached) { mc.Spec.Replicas = &n }
+	}
+	maintenance := func(readOnly bool, n *int32) func(*Memcached) {
+		return func(mc *Memcached) {
+			mc.Spec.Maintenance = &MaintenanceSpec{ReadOnly: readOnly, Replicas: n}
+		}
+	}
+	one := int32(1)
+
+	tests := []struct {
+		name string
+		mc   *Memcached
+		want int32
+	}{
+		{"default", newTestMemcached(), DefaultReplicas},
+		{"spec replicas", newTestMemcached(replicas(3)), 3},
+		{"read-only with replicas", newTestMemcached(replicas(3), maintenance(true, &one)), 1},
+		{"read-only without replicas", newTestMemcached(replicas(3), maintenance(true, nil)), 3},
+		{"read-write ignores maintenance replicas", newTestMemcached(replicas(3), maintenance(false, &one)), 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.mc.DesiredReplicas(); got != tt.want {
+				t.Errorf("DesiredReplicas() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestMemcached_IsMonitoringEnabled(t *testing.T) {
 	tests := []struct {
 		name string
@@ -4,16 +4,26 @@ package v1beta1
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/resource"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/c5c3/memcached-operator/internal/cron"
 )
 
 // memoryOverhead is the operational overhead (32Mi) added to maxMemoryMB when
@@ -21,8 +31,36 @@ import (
 // and internal data structures.
 var memoryOverhead = resource.MustParse("32Mi")
 
+// Fixed ports of the memcached and exporter containers, which a custom TLS port
+// must not collide with.
+const (
+	memcachedPort = int32(11211)
+	metricsPort   = int32(9150)
+)
+
+// maxVerbosity is the highest spec.memcached.verbosity level, emitted as -vvv.
+const maxVerbosity = int32(3)
+
+// safeSysctls are the sysctls Kubernetes considers safe and allows by default.
+// See https://kubernetes.io/docs/tasks/administer-cluster/sysctl-cluster/.
+var safeSysctls = map[string]bool{
+	"kernel.shm_rmid_forced":              true,
+	"net.ipv4.ip_local_port_range":        true,
+	"net.ipv4.ip_local_reserved_ports":    true,
+	"net.ipv4.ip_unprivileged_port_start": true,
+	"net.ipv4.ping_group_range":           true,
+	"net.ipv4.tcp_fin_timeout":            true,
+	"net.ipv4.tcp_keepalive_intvl":        true,
+	"net.ipv4.tcp_keepalive_probes":       true,
+	"net.ipv4.tcp_keepalive_time":         true,
+	"net.ipv4.tcp_syncookies":             true,
+}
+
 // MemcachedCustomValidator validates Memcached resources.
-type MemcachedCustomValidator struct{}
+type MemcachedCustomValidator struct {
+	// Options is the operator configuration the validation depends on.
+	Options WebhookOptions
+}
 
 // Compile-time interface check.
 var _ admission.Validator[*Memcached] = &MemcachedCustomValidator{}
@@ -32,13 +70,19 @@ var _ admission.Validator[*Memcached] = &MemcachedCustomValidator{}
 // ValidateCreate validates a Memcached resource on creation.
 func (v *MemcachedCustomValidator) ValidateCreate(_ context.Context, obj *Memcached) (admission.Warnings, error) {
 	memcachedlog.Info("validating create", "name", obj.GetName())
-	return nil, validateMemcached(obj)
+	return warnMemcached(obj), validateMemcached(obj, v.Options)
 }
 
-// ValidateUpdate validates a Memcached resource on update.
-func (v *MemcachedCustomValidator) ValidateUpdate(_ context.Context, _ *Memcached, newObj *Memcached) (admission.Warnings, error) {
+// ValidateUpdate validates a Memcached resource on update. Updates that leave the
+// spec unchanged, such as the operator's finalizer patches, and updates to a CR
+// being deleted are admitted without validation, so a stored CR that violates a
+// rule added later can still be reconciled and deleted.
+func (v *MemcachedCustomValidator) ValidateUpdate(_ context.Context, oldObj *Memcached, newObj *Memcached) (admission.Warnings, error) {
 	memcachedlog.Info("validating update", "name", newObj.GetName())
-	return nil, validateMemcached(newObj)
+	if newObj.DeletionTimestamp != nil || equality.Semantic.DeepEqual(oldObj.Spec, newObj.Spec) {
+		return nil, nil
+	}
+	return warnMemcached(newObj), validateMemcached(newObj, v.Options)
 }
 
 // ValidateDelete validates a Memcached resource on deletion (no-op).
@@ -47,14 +91,38 @@ func (v *MemcachedCustomValidator) ValidateDelete(_ context.Context, _ *Memcache
 }
 
 // validateMemcached runs all validation rules and aggregates field errors.
-func validateMemcached(mc *Memcached) error {
+func validateMemcached(mc *Memcached, opts WebhookOptions) error {
 	var allErrs field.ErrorList
 
 	allErrs = append(allErrs, validateMemoryLimit(mc)...)
+	allErrs = append(allErrs, validateQoSClass(mc)...)
+	allErrs = append(allErrs, validatePodOverhead(mc)...)
+	allErrs = append(allErrs, validateRestartPolicy(mc)...)
 	allErrs = append(allErrs, validatePDB(mc)...)
 	allErrs = append(allErrs, validateGracefulShutdown(mc)...)
+	allErrs = append(allErrs, validateClientAffinity(mc)...)
 	allErrs = append(allErrs, validateSecuritySecretRefs(mc)...)
+	allErrs = append(allErrs, validateGenerateCertificate(mc)...)
+	allErrs = append(allErrs, validateTLSCopySource(mc, opts.TLSCopySourceNamespaces)...)
+	allErrs = append(allErrs, validateSysctls(mc, opts.AllowUnsafeSysctls)...)
+	allErrs = append(allErrs, validateExporterTLS(mc)...)
+	allErrs = append(allErrs, validateDisabledMetricGroups(mc)...)
+	allErrs = append(allErrs, validateTLSPort(mc)...)
+	allErrs = append(allErrs, validateStatsSidecar(mc)...)
+	allErrs = append(allErrs, validateCanary(mc)...)
+	allErrs = append(allErrs, validateExtraArgs(mc)...)
+	allErrs = append(allErrs, validateVerbosity(mc)...)
+	allErrs = append(allErrs, validateSlabRebalancing(mc)...)
+	allErrs = append(allErrs, validateEphemeralStorage(mc)...)
+	allErrs = append(allErrs, validateCommand(mc)...)
+	allErrs = append(allErrs, validateEntrypoint(mc)...)
+	allErrs = append(allErrs, validateUnixSocket(mc)...)
+	allErrs = append(allErrs, validateTmpfsSizeLimit(mc)...)
+	allErrs = append(allErrs, validateRollingUpdate(mc)...)
+	allErrs = append(allErrs, validateMaintenanceWindow(mc)...)
 	allErrs = append(allErrs, validateAutoscaling(mc)...)
+	allErrs = append(allErrs, validateMaxReplicas(mc, opts.MaxReplicas)...)
+	allErrs = append(allErrs, validateMinReplicas(mc, opts.MinReplicas, opts.AllowZeroReplicas)...)
 
 	if len(allErrs) == 0 {
 		return nil
@@ -66,9 +134,352 @@ func validateMemcached(mc *Memcached) error {
 	)
 }
 
+// warnMemcached runs all non-blocking checks and aggregates admission warnings.
+func warnMemcached(mc *Memcached) admission.Warnings {
+	var warnings admission.Warnings
+
+	warnings = append(warnings, warnImageVersion(mc)...)
+	warnings = append(warnings, warnTrafficDistribution(mc)...)
+	warnings = append(warnings, warnTopologyAwareHints(mc)...)
+	warnings = append(warnings, warnListenAddresses(mc)...)
+	warnings = append(warnings, warnReplicaSpreading(mc)...)
+	warnings = append(warnings, warnThreadsPerCPU(mc)...)
+	warnings = append(warnings, warnPreStopDelay(mc)...)
+	warnings = append(warnings, warnTopologyKeys(mc)...)
+	warnings = append(warnings, warnSASLWithClientCert(mc)...)
+	warnings = append(warnings, warnExporterImage(mc)...)
+	warnings = append(warnings, warnPushGateway(mc)...)
+	warnings = append(warnings, warnTLSConnections(mc)...)
+	warnings = append(warnings, warnQoSClass(mc)...)
+
+	return warnings
+}
+
+// minTLSVersion is the first memcached release with TLS support. Images must
+// additionally be built with --enable-tls, which cannot be detected from the tag.
+var minTLSVersion = [3]int{1, 5, 13}
+
+// warnImageVersion warns when TLS is enabled on an image whose tag identifies a
+// memcached release older than minTLSVersion. The check is best-effort: digest
+// pinned images and tags that do not parse as a version are skipped.
+func warnImageVersion(mc *Memcached) admission.Warnings {
+	if !mc.IsTLSEnabled() {
+		return nil
+	}
+	image := DefaultImage
+	if mc.Spec.Image != nil {
+		image = *mc.Spec.Image
+	}
+	version, ok := parseImageVersion(image)
+	if !ok || !versionLess(version, minTLSVersion) {
+		return nil
+	}
+	return admission.Warnings{fmt.Sprintf(
+		"spec.image %q appears to be older than memcached %d.%d.%d, which is required for spec.security.tls",
+		image, minTLSVersion[0], minTLSVersion[1], minTLSVersion[2],
+	)}
+}
+
+// warnTrafficDistribution warns that spec.service.trafficDistribution has no effect
+// on a headless Service, because kube-proxy does not route its traffic.
+func warnTrafficDistribution(mc *Memcached) admission.Warnings {
+	if mc.Spec.Service == nil || mc.Spec.Service.TrafficDistribution == nil || !mc.IsServiceHeadless() {
+		return nil
+	}
+	return admission.Warnings{
+		"spec.service.trafficDistribution is ignored because the Service is headless; it only applies to ClusterIP Services",
+	}
+}
+
+// warnTopologyAwareHints warns when spec.service.topologyAwareHints is set but no
+// topology spread constraint spreads the pods across zones, since the EndpointSlice
+// controller only populates zone hints when endpoints are balanced across zones.
+func warnTopologyAwareHints(mc *Memcached) admission.Warnings {
+	if mc.Spec.Service == nil || !mc.Spec.Service.TopologyAwareHints {
+		return nil
+	}
+	if ha := mc.Spec.HighAvailability; ha != nil &&
+		slices.ContainsFunc(ha.TopologySpreadConstraints, func(c corev1.TopologySpreadConstraint) bool {
+			return c.TopologyKey == corev1.LabelTopologyZone
+		}) {
+		return nil
+	}
+	return admission.Warnings{fmt.Sprintf(
+		"spec.service.topologyAwareHints is set but no spec.highAvailability.topologySpreadConstraints entry "+
+			"uses topologyKey %q; without zone spreading the hints are likely not populated",
+		corev1.LabelTopologyZone)}
+}
+
+// warnReplicaSpreading warns when more than one replica can run but neither an
+// anti-affinity preset nor topology spread constraints are configured, so all
+// replicas may be scheduled onto a single node. When autoscaling is enabled,
+// autoscaling.maxReplicas is used as the replica count.
+func warnReplicaSpreading(mc *Memcached) admission.Warnings {
+	replicas := int32(0)
+	switch {
+	case mc.IsAutoscalingEnabled():
+		replicas = mc.Spec.Autoscaling.MaxReplicas
+	case mc.Spec.Replicas != nil:
+		replicas = *mc.Spec.Replicas
+	}
+	if replicas <= 1 {
+		return nil
+	}
+
+	if ha := mc.Spec.HighAvailability; ha != nil &&
+		(ha.AntiAffinityPreset != nil || len(ha.TopologySpreadConstraints) > 0) {
+		return nil
+	}
+	return admission.Warnings{fmt.Sprintf(
+		"%d replicas are configured without spec.highAvailability.antiAffinityPreset or "+
+			"topologySpreadConstraints; all replicas may be scheduled onto the same node",
+		replicas)}
+}
+
+// threadsPerCPUCore is the number of memcached worker threads per CPU core
+// above which warnThreadsPerCPU reports oversubscription.
+const threadsPerCPUCore = 4
+
+// warnThreadsPerCPU warns when spec.memcached.threads exceeds threadsPerCPUCore
+// times the container CPU limit rounded up to whole cores, since the surplus
+// threads only add context-switching overhead. It is skipped without a CPU limit.
+func warnThreadsPerCPU(mc *Memcached) admission.Warnings {
+	if mc.Spec.Memcached == nil || mc.Spec.Resources == nil {
+		return nil
+	}
+	cpuLimit, ok := mc.Spec.Resources.Limits[corev1.ResourceCPU]
+	if !ok || cpuLimit.IsZero() {
+		return nil
+	}
+
+	// Value rounds up to the next whole core.
+	maxThreads := cpuLimit.Value() * threadsPerCPUCore
+	threads := int64(mc.Spec.Memcached.Threads)
+	if threads <= maxThreads {
+		return nil
+	}
+	return admission.Warnings{fmt.Sprintf(
+		"spec.memcached.threads (%d) exceeds %d threads per core of the %s CPU limit; "+
+			"consider lowering threads to %d or raising the CPU limit",
+		threads, threadsPerCPUCore, cpuLimit.String(), maxThreads)}
+}
+
+// readinessRemovalWindowSeconds approximates how long it takes for a terminating
+// pod to fail its readiness probe and be removed from the Service endpoints: the
+// periodSeconds (5) times the default failureThreshold (3) of the readiness probe
+// the operator sets on the memcached container.
+const readinessRemovalWindowSeconds = 5 * 3
+
+// warnPreStopDelay warns when graceful shutdown is enabled with a preStop delay
+// shorter than readinessRemovalWindowSeconds, since the pod may still receive
+// traffic after the preStop hook returns and memcached is stopped.
+func warnPreStopDelay(mc *Memcached) admission.Warnings {
+	if !mc.IsGracefulShutdownEnabled() {
+		return nil
+	}
+	delay := mc.Spec.HighAvailability.GracefulShutdown.PreStopDelaySeconds
+	if delay == 0 {
+		delay = readinessRemovalWindowSeconds
+	}
+	if delay >= readinessRemovalWindowSeconds {
+		return nil
+	}
+	return admission.Warnings{fmt.Sprintf(
+		"spec.highAvailability.gracefulShutdown.preStopDelaySeconds (%d) is shorter than the %ds the readiness probe "+
+			"needs to remove the pod from the Service endpoints; clients may be cut off during drain",
+		delay, readinessRemovalWindowSeconds)}
+}
+
+// wellKnownTopologyKeys are the node labels set by the kubelet or cloud providers
+// on virtually every node, so spread constraints using them need no confirmation.
+var wellKnownTopologyKeys = []string{
+	corev1.LabelHostname,
+	corev1.LabelTopologyZone,
+	corev1.LabelTopologyRegion,
+}
+
+// warnTopologyKeys warns for each topology spread constraint whose topologyKey is
+// not well known, since a key that no node carries makes the constraint a no-op.
+// Nodes are not listed at admission time, so the user is asked to confirm instead.
+func warnTopologyKeys(mc *Memcached) admission.Warnings {
+	if mc.Spec.HighAvailability == nil {
+		return nil
+	}
+
+	var warnings admission.Warnings
+	for i, c := range mc.Spec.HighAvailability.TopologySpreadConstraints {
+		if slices.Contains(wellKnownTopologyKeys, c.TopologyKey) {
+			continue
+		}
+		warnings = append(warnings, fmt.Sprintf(
+			"spec.highAvailability.topologySpreadConstraints[%d].topologyKey %q is not a well-known node label; "+
+				"confirm that your nodes carry it, otherwise the constraint has no effect",
+			i, c.TopologyKey))
+	}
+	return warnings
+}
+
+// warnSASLWithClientCert warns when SASL is combined with mandatory TLS client
+// certificates, since clients must then both present a certificate and
+// authenticate via SASL, which some client libraries cannot do.
+func warnSASLWithClientCert(mc *Memcached) admission.Warnings {
+	if !mc.IsSASLEnabled() || !mc.IsTLSEnabled() || !mc.Spec.Security.TLS.EnableClientCert {
+		return nil
+	}
+	return admission.Warnings{
+		"spec.security.sasl.enabled and spec.security.tls.enableClientCert are both set; clients must present a " +
+			"TLS client certificate and authenticate via SASL, which some client libraries do not support. " +
+			"Confirm that your clients support both",
+	}
+}
+
+// warnExporterImage warns when spec.monitoring.exporterImage is the Memcached
+// image, a common copy-paste error that leaves the exporter sidecar running
+// memcached instead of memcached-exporter.
+func warnExporterImage(mc *Memcached) admission.Warnings {
+	if mc.Spec.Monitoring == nil || mc.Spec.Monitoring.ExporterImage == nil {
+		return nil
+	}
+	image := DefaultImage
+	if mc.Spec.Image != nil {
+		image = *mc.Spec.Image
+	}
+	if *mc.Spec.Monitoring.ExporterImage != image {
+		return nil
+	}
+	return admission.Warnings{
+		fmt.Sprintf("spec.monitoring.exporterImage %q is the same as the Memcached image; the exporter sidecar "+
+			"needs a memcached-exporter image such as %q", image, DefaultExporterImage),
+	}
+}
+
+// warnPushGateway warns when spec.monitoring.pushGateway is set while monitoring is
+// disabled, since the push sidecar forwards the exporter's metrics and is only added
+// alongside it.
+func warnPushGateway(mc *Memcached) admission.Warnings {
+	if mc.Spec.Monitoring == nil || mc.Spec.Monitoring.PushGateway == nil || mc.Spec.Monitoring.Enabled {
+		return nil
+	}
+	return admission.Warnings{
+		"spec.monitoring.pushGateway is set but spec.monitoring.enabled is false; no metrics are pushed until monitoring is enabled",
+	}
+}
+
+// tlsHighConnections is the spec.memcached.maxConnections value above which
+// warnTLSConnections compares the TLS buffer memory against the memory limit.
+const tlsHighConnections = 10000
+
+// tlsConnectionBufferBytes approximates the memory OpenSSL holds per TLS
+// connection for its read and write record buffers (2 × 16KiB).
+const tlsConnectionBufferBytes = 32 * 1024
+
+// warnTLSConnections warns when TLS is enabled with more than tlsHighConnections
+// connections and the memory limit cannot hold maxMemoryMB, the 32Mi overhead and
+// tlsConnectionBufferBytes per connection, since a connection surge could then
+// exhaust the memory limit. It is skipped without a memory limit.
+func warnTLSConnections(mc *Memcached) admission.Warnings {
+	if !mc.IsTLSEnabled() || mc.Spec.Memcached == nil || mc.Spec.Memcached.MaxConnections <= tlsHighConnections {
+		return nil
+	}
+	memLimit, ok := memoryLimit(mc)
+	if !ok {
+		return nil
+	}
+
+	required := resource.NewQuantity(
+		int64(mc.Spec.Memcached.MaxMemoryMB)*1024*1024+int64(mc.Spec.Memcached.MaxConnections)*tlsConnectionBufferBytes,
+		resource.BinarySI)
+	required.Add(memoryOverhead)
+	if memLimit.Cmp(*required) >= 0 {
+		return nil
+	}
+	return admission.Warnings{fmt.Sprintf(
+		"spec.memcached.maxConnections (%d) with spec.security.tls enabled may need up to %dMi of TLS buffers; "+
+			"the %s memory limit is below the estimated %dMi including maxMemoryMB, so a connection surge could "+
+			"exhaust it. Consider lowering maxConnections or raising the memory limit",
+		mc.Spec.Memcached.MaxConnections,
+		int64(mc.Spec.Memcached.MaxConnections)*tlsConnectionBufferBytes/(1024*1024),
+		memLimit.String(), required.Value()/(1024*1024))}
+}
+
+// warnListenAddresses warns when spec.memcached.listenAddresses leaves memcached
+// unreachable for the TCP probes, which connect via the Pod IP, or for the exporter
+// and stats sidecars, which connect via localhost unless exporterMemcachedAddress is set.
+func warnListenAddresses(mc *Memcached) admission.Warnings {
+	if mc.Spec.Memcached == nil || len(mc.Spec.Memcached.ListenAddresses) == 0 {
+		return nil
+	}
+
+	var podIP, loopback bool
+	for _, addr := range mc.Spec.Memcached.ListenAddresses {
+		switch {
+		case addr == "0.0.0.0" || addr == "::":
+			podIP, loopback = true, true
+		case strings.Contains(addr, PodIPToken):
+			podIP = true
+		case IsLoopbackAddress(addr):
+			loopback = true
+		}
+	}
+
+	var warnings admission.Warnings
+	// With TLS enabled and plaintext bound to loopback only, the probes target the
+	// TLS port instead.
+	if !podIP && !(mc.IsTLSEnabled() && mc.IsPlaintextLoopbackOnly()) {
+		warnings = append(warnings, fmt.Sprintf(
+			"spec.memcached.listenAddresses does not include %s; liveness and readiness probes connect via the Pod IP and will fail",
+			PodIPToken))
+	}
+	if (mc.IsMonitoringEnabled() || mc.IsStatsSidecarEnabled()) && !loopback &&
+		(mc.Spec.Monitoring == nil || mc.Spec.Monitoring.ExporterMemcachedAddress == nil) {
+		warnings = append(warnings,
+			"spec.memcached.listenAddresses does not include a loopback address; set spec.monitoring.exporterMemcachedAddress so the exporter and stats sidecars can reach memcached")
+	}
+	return warnings
+}
+
+// parseImageVersion extracts a major.minor.patch version from the tag of an
+// image reference. Missing components default to 0 and any suffix after the
+// first "-" (e.g. "-alpine") is ignored. It returns false for digest references,
+// untagged images and non-numeric tags.
+func parseImageVersion(image string) ([3]int, bool) {
+	var version [3]int
+	if strings.Contains(image, "@") {
+		return version, false
+	}
+	name := image[strings.LastIndex(image, "/")+1:]
+	i := strings.LastIndex(name, ":")
+	if i < 0 {
+		return version, false
+	}
+	tag, _, _ := strings.Cut(name[i+1:], "-")
+	parts := strings.Split(tag, ".")
+	if len(parts) > len(version) {
+		return version, false
+	}
+	for j, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil || n < 0 {
+			return version, false
+		}
+		version[j] = n
+	}
+	return version, true
+}
+
+// versionLess reports whether version a sorts before version b.
+func versionLess(a, b [3]int) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return a[i] < b[i]
+		}
+	}
+	return false
+}
+
 // validatePDB validates PodDisruptionBudget rules:
 // - minAvailable and maxUnavailable are mutually exclusive.
-// - At least one of minAvailable or maxUnavailable must be set when PDB is enabled.
+// When neither is set, the controller derives a default maxUnavailable.
 func validatePDB(mc *Memcached) field.ErrorList {
 	var errs field.ErrorList
 
@@ -94,13 +505,6 @@ func validatePDB(mc *Memcached) field.ErrorList {
 		))
 	}
 
-	if !hasMin && !hasMax {
-		errs = append(errs, field.Required(
-			pdbPath,
-			"one of minAvailable or maxUnavailable must be set when PDB is enabled",
-		))
-	}
-
 	// REQ-002: minAvailable (integer) must be strictly less than replicas.
 	if hasMin && !hasMax && pdb.MinAvailable.Type == intstr.Int && mc.Spec.Replicas != nil {
 		if pdb.MinAvailable.IntVal >= *mc.Spec.Replicas {
@@ -117,7 +521,9 @@ func validatePDB(mc *Memcached) field.ErrorList {
 
 // validateSecuritySecretRefs validates that secret references are provided when
 // security features are enabled:
-// - SASL enabled requires credentialsSecretRef.name.
+// - SASL enabled requires credentialsSecretRef.name or credentialsSecretNameTemplate.
+// - credentialsSecretRef.name and credentialsSecretNameTemplate are mutually exclusive.
+// - credentialsSecretNameTemplate must parse and resolve to a valid Secret name.
 // - TLS enabled requires certificateSecretRef.name.
 func validateSecuritySecretRefs(mc *Memcached) field.ErrorList {
 	var errs field.ErrorList
@@ -129,11 +535,23 @@ func validateSecuritySecretRefs(mc *Memcached) field.ErrorList {
 	sec := mc.Spec.Security
 	secPath := field.NewPath("spec", "security")
 
-	if sec.SASL != nil && sec.SASL.Enabled && sec.SASL.CredentialsSecretRef.Name == "" {
-		errs = append(errs, field.Required(
-			secPath.Child("sasl", "credentialsSecretRef", "name"),
-			"credentialsSecretRef.name is required when SASL is enabled",
-		))
+	if sec.SASL != nil {
+		saslPath := secPath.Child("sasl")
+		tmpl := sec.SASL.CredentialsSecretNameTemplate
+		switch {
+		case tmpl != nil && sec.SASL.CredentialsSecretRef.Name != "":
+			errs = append(errs, field.Forbidden(
+				saslPath.Child("credentialsSecretNameTemplate"),
+				"credentialsSecretNameTemplate and credentialsSecretRef.name are mutually exclusive",
+			))
+		case tmpl != nil:
+			errs = append(errs, validateSecretNameTemplate(mc, *tmpl, saslPath.Child("credentialsSecretNameTemplate"))...)
+		case sec.SASL.Enabled && sec.SASL.CredentialsSecretRef.Name == "":
+			errs = append(errs, field.Required(
+				saslPath.Child("credentialsSecretRef", "name"),
+				"credentialsSecretRef.name or credentialsSecretNameTemplate is required when SASL is enabled",
+			))
+		}
 	}
 
 	if sec.TLS != nil && sec.TLS.Enabled && sec.TLS.CertificateSecretRef.Name == "" {
@@ -146,6 +564,572 @@ func validateSecuritySecretRefs(mc *Memcached) field.ErrorList {
 	return errs
 }
 
+// validateSecretNameTemplate validates that tmpl parses and, resolved against mc,
+// yields a valid Secret name.
+func validateSecretNameTemplate(mc *Memcached, tmpl string, fldPath *field.Path) field.ErrorList {
+	name, err := ResolveSecretNameTemplate(tmpl, mc)
+	if err != nil {
+		return field.ErrorList{field.Invalid(fldPath, tmpl, fmt.Sprintf("invalid template: %v", err))}
+	}
+	var errs field.ErrorList
+	for _, msg := range validation.IsDNS1123Subdomain(name) {
+		errs = append(errs, field.Invalid(fldPath, tmpl, fmt.Sprintf("resolves to invalid Secret name %q: %s", name, msg)))
+	}
+	return errs
+}
+
+// validateGenerateCertificate validates the cert-manager Certificate generated for TLS:
+// the generated Secret cannot also be copied from another namespace.
+func validateGenerateCertificate(mc *Memcached) field.ErrorList {
+	var errs field.ErrorList
+
+	if !mc.IsCertificateGenerated() {
+		return errs
+	}
+
+	tls := mc.Spec.Security.TLS
+	genPath := field.NewPath("spec", "security", "tls", "generateCertificate")
+
+	if tls.CopyFromNamespace != nil {
+		errs = append(errs, field.Forbidden(
+			genPath,
+			"generateCertificate and copyFromNamespace are mutually exclusive",
+		))
+	}
+
+	return errs
+}
+
+// validateTLSCopySource rejects spec.security.tls.copyFromNamespace naming another
+// namespace unless the operator allows copying from it, so that creating a Memcached
+// cannot be used to read Secrets of arbitrary namespaces through the operator.
+func validateTLSCopySource(mc *Memcached, allowed []string) field.ErrorList {
+	if !mc.IsTLSEnabled() {
+		return nil
+	}
+	source := mc.Spec.Security.TLS.CopyFromNamespace
+	if source == nil || *source == "" || *source == mc.Namespace {
+		return nil
+	}
+
+	fldPath := field.NewPath("spec", "security", "tls", "copyFromNamespace")
+	if len(allowed) == 0 {
+		return field.ErrorList{field.Forbidden(fldPath,
+			"copying TLS Secrets across namespaces is disabled; the operator must run with --tls-copy-source-namespaces")}
+	}
+	if !slices.Contains(allowed, *source) {
+		return field.ErrorList{field.NotSupported(fldPath, *source, allowed)}
+	}
+	return nil
+}
+
+// validateSysctls rejects sysctls outside the Kubernetes safe set unless
+// allowUnsafe is set by the operator. Both spec.security.sysctls and
+// spec.security.podSecurityContext.sysctls end up on the pod and are checked.
+func validateSysctls(mc *Memcached, allowUnsafe bool) field.ErrorList {
+	var errs field.ErrorList
+
+	if mc.Spec.Security == nil || allowUnsafe {
+		return errs
+	}
+
+	errs = append(errs, validateSafeSysctls(mc.Spec.Security.Sysctls,
+		field.NewPath("spec", "security", "sysctls"))...)
+	if psc := mc.Spec.Security.PodSecurityContext; psc != nil {
+		errs = append(errs, validateSafeSysctls(psc.Sysctls,
+			field.NewPath("spec", "security", "podSecurityContext", "sysctls"))...)
+	}
+
+	return errs
+}
+
+// validateSafeSysctls rejects each sysctl in sysctls outside the safe set.
+func validateSafeSysctls(sysctls []corev1.Sysctl, sysctlsPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	for i, s := range sysctls {
+		if !safeSysctls[s.Name] {
+			errs = append(errs, field.Forbidden(sysctlsPath.Index(i).Child("name"),
+				fmt.Sprintf("sysctl %q is not in the safe set; the operator must run with --allow-unsafe-sysctls", s.Name)))
+		}
+	}
+	return errs
+}
+
+// validateExporterTLS validates that the exporter serving certificate Secret is
+// referenced when exporter TLS is enabled, and that at most one CA source is set.
+func validateExporterTLS(mc *Memcached) field.ErrorList {
+	var errs field.ErrorList
+
+	if !mc.IsExporterTLSEnabled() {
+		return errs
+	}
+
+	exporterTLS := mc.Spec.Monitoring.ExporterTLS
+	exporterTLSPath := field.NewPath("spec", "monitoring", "exporterTLS")
+	if exporterTLS.CertificateSecretRef.Name == "" {
+		errs = append(errs, field.Required(
+			exporterTLSPath.Child("certificateSecretRef", "name"),
+			"certificateSecretRef.name is required when exporter TLS is enabled",
+		))
+	}
+
+	if exporterTLS.CASecretRef != nil && exporterTLS.CAConfigMapRef != nil {
+		errs = append(errs, field.Forbidden(
+			exporterTLSPath.Child("caConfigMapRef"),
+			"caSecretRef and caConfigMapRef are mutually exclusive",
+		))
+	}
+
+	return errs
+}
+
+// exporterMetricGroups are the memcached-exporter metric groups that can be
+// switched off through spec.monitoring.disabledMetricGroups.
+var exporterMetricGroups = []string{"items", "settings", "slabs"}
+
+// validateDisabledMetricGroups rejects spec.monitoring.disabledMetricGroups entries
+// that do not name a known exporter metric group.
+func validateDisabledMetricGroups(mc *Memcached) field.ErrorList {
+	var errs field.ErrorList
+
+	if mc.Spec.Monitoring == nil {
+		return errs
+	}
+
+	groupsPath := field.NewPath("spec", "monitoring", "disabledMetricGroups")
+	for i, group := range mc.Spec.Monitoring.DisabledMetricGroups {
+		if !slices.Contains(exporterMetricGroups, group) {
+			errs = append(errs, field.NotSupported(groupsPath.Index(i), group, exporterMetricGroups))
+		}
+	}
+
+	return errs
+}
+
+// validateTLSPort validates that a custom TLS port does not collide with the
+// plaintext memcached port or, when monitoring is enabled, the exporter port.
+func validateTLSPort(mc *Memcached) field.ErrorList {
+	var errs field.ErrorList
+
+	if !mc.IsTLSEnabled() || mc.Spec.Security.TLS.Port == nil {
+		return errs
+	}
+
+	port := *mc.Spec.Security.TLS.Port
+	portPath := field.NewPath("spec", "security", "tls", "port")
+
+	if port == memcachedPort {
+		errs = append(errs, field.Invalid(portPath, port,
+			fmt.Sprintf("TLS port must differ from the plaintext memcached port %d", memcachedPort)))
+	}
+	if mc.IsMonitoringEnabled() && port == metricsPort {
+		errs = append(errs, field.Invalid(portPath, port,
+			fmt.Sprintf("TLS port must differ from the exporter metrics port %d", metricsPort)))
+	}
+
+	return errs
+}
+
+// validateStatsSidecar validates that an enabled stats sidecar has an image and
+// listens on a port not used by memcached or the exporter.
+func validateStatsSidecar(mc *Memcached) field.ErrorList {
+	var errs field.ErrorList
+
+	if !mc.IsStatsSidecarEnabled() {
+		return errs
+	}
+
+	sidecarPath := field.NewPath("spec", "statsSidecar")
+	if mc.Spec.StatsSidecar.Image == nil {
+		errs = append(errs, field.Required(sidecarPath.Child("image"),
+			"image is required when the stats sidecar is enabled"))
+	}
+
+	port := mc.StatsSidecarPort()
+	portPath := sidecarPath.Child("port")
+	if port == memcachedPort {
+		errs = append(errs, field.Invalid(portPath, port,
+			fmt.Sprintf("stats sidecar port must differ from the plaintext memcached port %d", memcachedPort)))
+	}
+	if mc.IsTLSEnabled() && port == mc.TLSPort() {
+		errs = append(errs, field.Invalid(portPath, port,
+			fmt.Sprintf("stats sidecar port must differ from the TLS port %d", mc.TLSPort())))
+	}
+	if mc.IsMonitoringEnabled() && port == metricsPort {
+		errs = append(errs, field.Invalid(portPath, port,
+			fmt.Sprintf("stats sidecar port must differ from the exporter metrics port %d", metricsPort)))
+	}
+
+	return errs
+}
+
+// validateCanary validates that an enabled canary Deployment has an image.
+func validateCanary(mc *Memcached) field.ErrorList {
+	var errs field.ErrorList
+
+	if !mc.IsCanaryEnabled() {
+		return errs
+	}
+
+	if mc.Spec.Canary.Image == nil {
+		errs = append(errs, field.Required(field.NewPath("spec", "canary", "image"),
+			"image is required when the canary is enabled"))
+	}
+
+	return errs
+}
+
+// validateMaintenanceWindow validates that spec.maintenanceWindow.schedule is a
+// cron expression the operator can evaluate and that it fires at all, since a
+// window that never opens would defer rollouts forever.
+func validateMaintenanceWindow(mc *Memcached) field.ErrorList {
+	var errs field.ErrorList
+
+	mw := mc.Spec.MaintenanceWindow
+	if mw == nil {
+		return errs
+	}
+
+	schedulePath := field.NewPath("spec", "maintenanceWindow", "schedule")
+	schedule, err := cron.Parse(mw.Schedule)
+	if err != nil {
+		return append(errs, field.Invalid(schedulePath, mw.Schedule, err.Error()))
+	}
+	if schedule.Next(time.Now().UTC()).IsZero() {
+		errs = append(errs, field.Invalid(schedulePath, mw.Schedule, "schedule never fires"))
+	}
+	return errs
+}
+
+// validateRollingUpdate validates rolling update rules:
+// - The absolute and percentage forms of maxSurge and maxUnavailable are mutually exclusive.
+// - maxSurge and maxUnavailable cannot both be zero, which would block every rollout.
+func validateRollingUpdate(mc *Memcached) field.ErrorList {
+	var errs field.ErrorList
+
+	ru := mc.Spec.RollingUpdate
+	if ru == nil {
+		return errs
+	}
+
+	ruPath := field.NewPath("spec", "rollingUpdate")
+
+	if ru.MaxSurge != nil && ru.MaxSurgePercent != nil {
+		errs = append(errs, field.Forbidden(
+			ruPath.Child("maxSurgePercent"),
+			"maxSurge and maxSurgePercent are mutually exclusive",
+		))
+	}
+	if ru.MaxUnavailable != nil && ru.MaxUnavailablePercent != nil {
+		errs = append(errs, field.Forbidden(
+			ruPath.Child("maxUnavailablePercent"),
+			"maxUnavailable and maxUnavailablePercent are mutually exclusive",
+		))
+	}
+
+	// Unset maxSurge defaults to 1, unset maxUnavailable to 0.
+	surgeZero := (ru.MaxSurge != nil && *ru.MaxSurge == 0) || (ru.MaxSurgePercent != nil && *ru.MaxSurgePercent == 0)
+	unavailableZero := (ru.MaxUnavailable == nil && ru.MaxUnavailablePercent == nil) ||
+		(ru.MaxUnavailable != nil && *ru.MaxUnavailable == 0) ||
+		(ru.MaxUnavailablePercent != nil && *ru.MaxUnavailablePercent == 0)
+	if surgeZero && unavailableZero {
+		errs = append(errs, field.Invalid(
+			ruPath,
+			"maxSurge=0, maxUnavailable=0",
+			"maxSurge and maxUnavailable cannot both be zero",
+		))
+	}
+
+	return errs
+}
+
+// managedFlags maps memcached flags generated by the operator to the typed field
+// that controls them. Both short and long forms are listed.
+var managedFlags = []struct {
+	short, long string
+	field       string
+}{
+	{"-m", "--memory-limit", "spec.memcached.maxMemoryMB"},
+	{"-c", "--conn-limit", "spec.memcached.maxConnections"},
+	{"-t", "--threads", "spec.memcached.threads"},
+	{"-I", "--max-item-size", "spec.memcached.maxItemSize"},
+	{"-l", "--listen", "spec.memcached.listenAddresses"},
+	{"-s", "--unix-socket", "spec.memcached.unixSocket"},
+	{"-Y", "--auth-file", "spec.security.sasl"},
+	{"-Z", "--enable-ssl", "spec.security.tls"},
+}
+
+// managedExtendedOptions maps the "-o" extended options generated by the operator
+// to the typed field that controls them.
+var managedExtendedOptions = map[string]string{
+	"ssl_chain_cert":   "spec.security.tls",
+	"ssl_key":          "spec.security.tls",
+	"ssl_ca_cert":      "spec.security.tls",
+	"ssl_verify_mode":  "spec.security.tls.enableClientCert",
+	"idle_timeout":     "spec.memcached.idleTimeoutSeconds",
+	"slab_reassign":    "spec.memcached.slabReassign",
+	"no_slab_reassign": "spec.memcached.slabReassign",
+	"slab_automove":    "spec.memcached.slabAutomove",
+}
+
+// validateVerbosity rejects spec.memcached.verbosity values outside 0-3, the
+// range that maps to the -v, -vv and -vvv flags.
+func validateVerbosity(mc *Memcached) field.ErrorList {
+	var errs field.ErrorList
+
+	if mc.Spec.Memcached == nil {
+		return errs
+	}
+
+	verbosity := mc.Spec.Memcached.Verbosity
+	if verbosity < 0 || verbosity > maxVerbosity {
+		errs = append(errs, field.Invalid(field.NewPath("spec", "memcached", "verbosity"), verbosity,
+			fmt.Sprintf("must be between 0 and %d", maxVerbosity)))
+	}
+	return errs
+}
+
+// validateSlabRebalancing validates that spec.memcached.slabAutomove is one of the
+// modes memcached supports and is not combined with slab reassignment disabled,
+// since automove works by reassigning pages.
+func validateSlabRebalancing(mc *Memcached) field.ErrorList {
+	var errs field.ErrorList
+
+	if mc.Spec.Memcached == nil || mc.Spec.Memcached.SlabAutomove == nil {
+		return errs
+	}
+
+	automove := *mc.Spec.Memcached.SlabAutomove
+	automovePath := field.NewPath("spec", "memcached", "slabAutomove")
+	if automove < 0 || automove > 2 {
+		return append(errs, field.NotSupported(automovePath, automove, []string{"0", "1", "2"}))
+	}
+	if automove > 0 && mc.Spec.Memcached.SlabReassign != nil && !*mc.Spec.Memcached.SlabReassign {
+		errs = append(errs, field.Invalid(automovePath, automove,
+			"slabAutomove requires slab reassignment; set slabAutomove to 0 or leave slabReassign unset"))
+	}
+	return errs
+}
+
+// validateCommand validates that a set spec.memcached.command is non-empty and
+// has no empty entries.
+func validateCommand(mc *Memcached) field.ErrorList {
+	var errs field.ErrorList
+
+	if mc.Spec.Memcached == nil || mc.Spec.Memcached.Command == nil {
+		return errs
+	}
+
+	cmdPath := field.NewPath("spec", "memcached", "command")
+	if len(mc.Spec.Memcached.Command) == 0 {
+		return append(errs, field.Required(cmdPath, "command must not be empty when set"))
+	}
+	for i, arg := range mc.Spec.Memcached.Command {
+		if strings.TrimSpace(arg) == "" {
+			errs = append(errs, field.Invalid(cmdPath.Index(i), arg, "command entries must not be empty"))
+		}
+	}
+
+	return errs
+}
+
+// validateEntrypoint requires entrypointConfigMapRef and entrypointPath to be set
+// together, checks that the path is a valid ConfigMap key, and rejects combining
+// the entrypoint script with spec.memcached.command.
+func validateEntrypoint(mc *Memcached) field.ErrorList {
+	var errs field.ErrorList
+
+	if mc.Spec.Memcached == nil {
+		return errs
+	}
+
+	cfg := mc.Spec.Memcached
+	basePath := field.NewPath("spec", "memcached")
+	refPath := basePath.Child("entrypointConfigMapRef")
+	pathPath := basePath.Child("entrypointPath")
+
+	if cfg.EntrypointPath != nil {
+		if cfg.EntrypointConfigMapRef == nil || cfg.EntrypointConfigMapRef.Name == "" {
+			errs = append(errs, field.Required(refPath.Child("name"),
+				"entrypointConfigMapRef.name is required when entrypointPath is set"))
+		}
+		for _, msg := range validation.IsConfigMapKey(*cfg.EntrypointPath) {
+			errs = append(errs, field.Invalid(pathPath, *cfg.EntrypointPath, msg))
+		}
+	} else if cfg.EntrypointConfigMapRef != nil {
+		errs = append(errs, field.Required(pathPath,
+			"entrypointPath is required when entrypointConfigMapRef is set"))
+	}
+
+	if (cfg.EntrypointPath != nil || cfg.EntrypointConfigMapRef != nil) && len(cfg.Command) > 0 {
+		errs = append(errs, field.Forbidden(basePath.Child("command"),
+			"command is mutually exclusive with entrypointConfigMapRef"))
+	}
+
+	return errs
+}
+
+// validateUnixSocket rejects settings that require a TCP listener when the unix
+// socket is enabled. memcached disables all network listeners once -s is set, so
+// listen addresses would have no effect and TLS clients could not connect.
+func validateUnixSocket(mc *Memcached) field.ErrorList {
+	var errs field.ErrorList
+
+	if !mc.IsUnixSocketEnabled() {
+		return errs
+	}
+
+	if len(mc.Spec.Memcached.ListenAddresses) > 0 {
+		errs = append(errs, field.Forbidden(
+			field.NewPath("spec", "memcached", "listenAddresses"),
+			"must be empty when spec.memcached.unixSocket is enabled; memcached does not open TCP listeners while a unix socket is configured"))
+	}
+	if mc.IsTLSEnabled() {
+		errs = append(errs, field.Forbidden(
+			field.NewPath("spec", "security", "tls", "enabled"),
+			"TLS requires a TCP listener and cannot be combined with spec.memcached.unixSocket"))
+	}
+
+	return errs
+}
+
+// validateExtraArgs rejects spec.memcached.extraArgs entries that duplicate flags
+// the operator already generates, which memcached may reject or silently override.
+func validateExtraArgs(mc *Memcached) field.ErrorList {
+	var errs field.ErrorList
+
+	if mc.Spec.Memcached == nil {
+		return errs
+	}
+
+	return extraArgsErrors(field.NewPath("spec", "memcached", "extraArgs"), mc.Spec.Memcached.ExtraArgs)
+}
+
+// ValidateExtraArgs applies the extraArgs admission checks to args outside of a CR.
+// The operator runs it at startup on --default-extra-args, which the mutating webhook
+// would otherwise inject into CRs that validation then denies.
+func ValidateExtraArgs(args []string) error {
+	return extraArgsErrors(field.NewPath("extraArgs"), args).ToAggregate()
+}
+
+// extraArgsErrors rejects args that set a flag or extended option managed by the operator.
+func extraArgsErrors(argsPath *field.Path, args []string) field.ErrorList {
+	var errs field.ErrorList
+
+	for i, arg := range args {
+		if msg := managedFlagMessage(arg); msg != "" {
+			errs = append(errs, field.Invalid(argsPath.Index(i), arg, msg))
+			continue
+		}
+
+		for _, key := range extendedOptionKeys(args, i) {
+			if typed, ok := managedExtendedOptions[key]; ok {
+				errs = append(errs, field.Invalid(argsPath.Index(i), arg,
+					fmt.Sprintf("option %s is managed by the operator; use %s instead", key, typed)))
+			}
+		}
+	}
+
+	return errs
+}
+
+// extendedOptionKeys returns the keys of the extended options set by args[i].
+// Extended options are passed either as "-o <opts>", "-o<opts>" or "--extended=<opts>",
+// where opts is a comma-separated list of key or key=value entries.
+func extendedOptionKeys(args []string, i int) []string {
+	arg := args[i]
+	var opts string
+	switch {
+	case arg == "-o" || arg == "--extended":
+		if i+1 < len(args) {
+			opts = args[i+1]
+		}
+	case strings.HasPrefix(arg, "--extended="):
+		opts = strings.TrimPrefix(arg, "--extended=")
+	case strings.HasPrefix(arg, "-o"):
+		opts = strings.TrimPrefix(arg, "-o")
+	default:
+		return nil
+	}
+
+	var keys []string
+	for _, opt := range strings.Split(opts, ",") {
+		key, _, _ := strings.Cut(strings.TrimSpace(opt), "=")
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// isExtstoreEnabled reports whether spec.memcached.extraArgs configures an
+// extstore file with the ext_path extended option.
+func isExtstoreEnabled(mc *Memcached) bool {
+	if mc.Spec.Memcached == nil {
+		return false
+	}
+	args := mc.Spec.Memcached.ExtraArgs
+	for i := range args {
+		if slices.Contains(extendedOptionKeys(args, i), "ext_path") {
+			return true
+		}
+	}
+	return false
+}
+
+// validateTmpfsSizeLimit validates that spec.memcached.tmpfsSizeLimit is positive.
+func validateTmpfsSizeLimit(mc *Memcached) field.ErrorList {
+	var errs field.ErrorList
+
+	if mc.Spec.Memcached == nil || mc.Spec.Memcached.TmpfsSizeLimit == nil {
+		return errs
+	}
+
+	if mc.Spec.Memcached.TmpfsSizeLimit.Sign() <= 0 {
+		errs = append(errs, field.Invalid(
+			field.NewPath("spec", "memcached", "tmpfsSizeLimit"),
+			mc.Spec.Memcached.TmpfsSizeLimit.String(),
+			"must be greater than zero",
+		))
+	}
+	return errs
+}
+
+// validateEphemeralStorage requires an ephemeral-storage request when extstore is
+// enabled, so the scheduler places the pod on a node with room for the extstore file.
+func validateEphemeralStorage(mc *Memcached) field.ErrorList {
+	var errs field.ErrorList
+
+	if !isExtstoreEnabled(mc) {
+		return errs
+	}
+
+	if mc.Spec.Resources != nil {
+		if _, ok := mc.Spec.Resources.Requests[corev1.ResourceEphemeralStorage]; ok {
+			return errs
+		}
+	}
+	errs = append(errs, field.Required(
+		field.NewPath("spec", "resources", "requests", string(corev1.ResourceEphemeralStorage)),
+		"an ephemeral-storage request is required when extstore is enabled via -o ext_path",
+	))
+
+	return errs
+}
+
+// managedFlagMessage returns an error message when arg sets a flag managed by the
+// operator, or an empty string otherwise. Short flags match with an attached value
+// (e.g. "-m64") and long flags with an "=" value (e.g. "--memory-limit=64").
+func managedFlagMessage(arg string) string {
+	if strings.HasPrefix(arg, "-v") && strings.Trim(arg[1:], "v") == "" || arg == "--verbose" {
+		return fmt.Sprintf("flag %s is managed by the operator; use spec.memcached.verbosity instead", arg)
+	}
+	for _, f := range managedFlags {
+		if strings.HasPrefix(arg, f.short) || arg == f.long || strings.HasPrefix(arg, f.long+"=") {
+			return fmt.Sprintf("flag %s is managed by the operator; use %s instead", arg, f.field)
+		}
+	}
+	return ""
+}
+
 // validateMemoryLimit validates that spec.resources.limits.memory is sufficient
 // to accommodate spec.memcached.maxMemoryMB plus operational overhead (32Mi).
 func validateMemoryLimit(mc *Memcached) field.ErrorList {
@@ -155,7 +1139,7 @@ func validateMemoryLimit(mc *Memcached) field.ErrorList {
 		return errs
 	}
 
-	memLimit, hasMemLimit := mc.Spec.Resources.Limits[corev1.ResourceMemory]
+	memLimit, hasMemLimit := memoryLimit(mc)
 	if !hasMemLimit {
 		return errs
 	}
@@ -175,6 +1159,130 @@ func validateMemoryLimit(mc *Memcached) field.ErrorList {
 	return errs
 }
 
+// memoryLimit returns the memory limit of the memcached container, which for the
+// Guaranteed QoS class defaults to the memory request, and whether one is set.
+func memoryLimit(mc *Memcached) (resource.Quantity, bool) {
+	if mc.Spec.Resources == nil {
+		return resource.Quantity{}, false
+	}
+	memLimit, ok := mc.Spec.Resources.Limits[corev1.ResourceMemory]
+	if !ok && mc.Spec.QoSClass == corev1.PodQOSGuaranteed {
+		// The reconciler copies the request to the limit for Guaranteed QoS.
+		memLimit, ok = mc.Spec.Resources.Requests[corev1.ResourceMemory]
+	}
+	return memLimit, ok
+}
+
+// validateQoSClass checks that the resources of every container the operator
+// sets resources on can satisfy the Guaranteed QoS class: spec.resources for the
+// memcached container and, with monitoring enabled, spec.monitoring.exporterResources
+// for the exporter. CPU and memory must each be set as a request or a limit, and
+// where both are set they must be equal.
+func validateQoSClass(mc *Memcached) field.ErrorList {
+	if mc.Spec.QoSClass != corev1.PodQOSGuaranteed {
+		return nil
+	}
+
+	errs := validateGuaranteedResources(mc.Spec.Resources, field.NewPath("spec", "resources"))
+	if mc.IsMonitoringEnabled() {
+		errs = append(errs, validateGuaranteedResources(mc.Spec.Monitoring.ExporterResources,
+			field.NewPath("spec", "monitoring", "exporterResources"))...)
+	}
+	return errs
+}
+
+// validateGuaranteedResources checks that resources sets CPU and memory each as a
+// request or a limit, and that they are equal where both are set.
+func validateGuaranteedResources(resources *corev1.ResourceRequirements, resourcesPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	var requests, limits corev1.ResourceList
+	if resources != nil {
+		requests, limits = resources.Requests, resources.Limits
+	}
+	for _, name := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory} {
+		request, hasRequest := requests[name]
+		limit, hasLimit := limits[name]
+		switch {
+		case !hasRequest && !hasLimit:
+			errs = append(errs, field.Required(resourcesPath.Child("limits").Key(string(name)),
+				fmt.Sprintf("a %s request or limit is required when qosClass is Guaranteed", name)))
+		case hasRequest && hasLimit && request.Cmp(limit) != 0:
+			errs = append(errs, field.Invalid(resourcesPath.Child("limits").Key(string(name)), limit.String(),
+				fmt.Sprintf("must equal the %s request (%s) when qosClass is Guaranteed", name, request.String())))
+		}
+	}
+
+	return errs
+}
+
+// warnQoSClass warns when qosClass is Guaranteed but the pod runs a sidecar
+// without resources, the pushgateway or stats sidecar, which makes the pod Burstable.
+func warnQoSClass(mc *Memcached) admission.Warnings {
+	if mc.Spec.QoSClass != corev1.PodQOSGuaranteed {
+		return nil
+	}
+	var sidecars []string
+	if mc.IsMonitoringEnabled() && mc.Spec.Monitoring.PushGateway != nil {
+		sidecars = append(sidecars, "pushgateway")
+	}
+	if mc.IsStatsSidecarEnabled() {
+		sidecars = append(sidecars, "stats")
+	}
+	if len(sidecars) == 0 {
+		return nil
+	}
+	subject := "sidecar has"
+	if len(sidecars) > 1 {
+		subject = "sidecars have"
+	}
+	return admission.Warnings{fmt.Sprintf(
+		"spec.qosClass is Guaranteed, but the %s %s no resource requests or limits, "+
+			"so the pod gets the Burstable QoS class", strings.Join(sidecars, " and "), subject)}
+}
+
+// validatePodOverhead validates that spec.podOverhead quantities are non-negative
+// and that a RuntimeClass is named. The RuntimeClass admission controller rejects
+// pods that carry an overhead without a RuntimeClass defining the same overhead.
+func validatePodOverhead(mc *Memcached) field.ErrorList {
+	var errs field.ErrorList
+
+	if len(mc.Spec.PodOverhead) == 0 {
+		return errs
+	}
+
+	overheadPath := field.NewPath("spec", "podOverhead")
+	for _, name := range slices.Sorted(maps.Keys(mc.Spec.PodOverhead)) {
+		if q := mc.Spec.PodOverhead[name]; q.Sign() < 0 {
+			errs = append(errs, field.Invalid(overheadPath.Key(string(name)), q.String(), "must be non-negative"))
+		}
+	}
+	if mc.Spec.RuntimeClassName == nil {
+		errs = append(errs, field.Required(field.NewPath("spec", "runtimeClassName"),
+			"runtimeClassName is required when podOverhead is set"))
+	}
+
+	return errs
+}
+
+// validateRestartPolicy rejects restart policies other than Always, the only
+// policy a Deployment Pod template accepts.
+func validateRestartPolicy(mc *Memcached) field.ErrorList {
+	var errs field.ErrorList
+
+	if mc.Spec.RestartPolicy == "" || mc.Spec.RestartPolicy == corev1.RestartPolicyAlways {
+		return errs
+	}
+
+	errs = append(errs, field.NotSupported(
+		field.NewPath("spec", "restartPolicy"),
+		mc.Spec.RestartPolicy,
+		[]string{string(corev1.RestartPolicyAlways)},
+	))
+
+	return errs
+}
+
 // validateGracefulShutdown validates that terminationGracePeriodSeconds exceeds
 // preStopDelaySeconds when graceful shutdown is enabled.
 func validateGracefulShutdown(mc *Memcached) field.ErrorList {
@@ -200,6 +1308,70 @@ func validateGracefulShutdown(mc *Memcached) field.ErrorList {
 	return errs
 }
 
+// validateClientAffinity rejects an empty or malformed client affinity pod selector.
+// An empty selector would match every pod in the namespace.
+func validateClientAffinity(mc *Memcached) field.ErrorList {
+	if mc.Spec.HighAvailability == nil || mc.Spec.HighAvailability.ClientAffinity == nil {
+		return nil
+	}
+
+	selector := &mc.Spec.HighAvailability.ClientAffinity.PodSelector
+	fldPath := field.NewPath("spec", "highAvailability", "clientAffinity", "podSelector")
+	if len(selector.MatchLabels) == 0 && len(selector.MatchExpressions) == 0 {
+		return field.ErrorList{field.Required(fldPath, "podSelector must select the client pods")}
+	}
+	return metav1validation.ValidateLabelSelector(selector, metav1validation.LabelSelectorValidationOptions{}, fldPath)
+}
+
+// validateMaxReplicas rejects replica counts above the operator-configured cap
+// (--max-replicas). A maxReplicas of zero disables the check.
+func validateMaxReplicas(mc *Memcached, maxReplicas int32) field.ErrorList {
+	var errs field.ErrorList
+
+	if maxReplicas <= 0 {
+		return errs
+	}
+
+	msg := fmt.Sprintf("must not exceed the operator's maximum of %d replicas (--max-replicas)", maxReplicas)
+	if mc.Spec.Replicas != nil && *mc.Spec.Replicas > maxReplicas {
+		errs = append(errs, field.Invalid(field.NewPath("spec", "replicas"), *mc.Spec.Replicas, msg))
+	}
+	if mc.IsAutoscalingEnabled() && mc.Spec.Autoscaling.MaxReplicas > maxReplicas {
+		errs = append(errs, field.Invalid(
+			field.NewPath("spec", "autoscaling", "maxReplicas"), mc.Spec.Autoscaling.MaxReplicas, msg))
+	}
+
+	return errs
+}
+
+// validateMinReplicas rejects replica counts below the operator-configured floor
+// (--min-replicas). With allowZero, spec.replicas=0 is accepted as a deliberate
+// scale to zero. An autoscaler without minReplicas counts as 1, the HPA default.
+// A minReplicas of zero disables the check.
+func validateMinReplicas(mc *Memcached, minReplicas int32, allowZero bool) field.ErrorList {
+	var errs field.ErrorList
+
+	if minReplicas <= 0 {
+		return errs
+	}
+
+	msg := fmt.Sprintf("must not be below the operator's minimum of %d replicas (--min-replicas)", minReplicas)
+	if r := mc.Spec.Replicas; r != nil && *r < minReplicas && (*r != 0 || !allowZero) {
+		errs = append(errs, field.Invalid(field.NewPath("spec", "replicas"), *r, msg))
+	}
+	if mc.IsAutoscalingEnabled() {
+		hpaMin := int32(1)
+		if mc.Spec.Autoscaling.MinReplicas != nil {
+			hpaMin = *mc.Spec.Autoscaling.MinReplicas
+		}
+		if hpaMin < minReplicas {
+			errs = append(errs, field.Invalid(field.NewPath("spec", "autoscaling", "minReplicas"), hpaMin, msg))
+		}
+	}
+
+	return errs
+}
+
 // validateAutoscaling validates autoscaling configuration:
 // - spec.replicas and autoscaling.enabled are mutually exclusive.
 // - minReplicas must not exceed maxReplicas.
@@ -232,6 +1404,8 @@ func validateAutoscaling(mc *Memcached) field.ErrorList {
 		))
 	}
 
+	errs = append(errs, validateAutoscalingMetrics(as.Metrics, asPath.Child("metrics"))...)
+
 	// REQ-007: CPU utilization metrics require resources.requests.cpu.
 	if hasCPUUtilizationMetric(as.Metrics) {
 		hasCPURequest := mc.Spec.Resources != nil && mc.Spec.Resources.Requests != nil
@@ -249,6 +1423,54 @@ func validateAutoscaling(mc *Memcached) field.ErrorList {
 	return errs
 }
 
+// validateAutoscalingMetrics requires at least one metric, such as a CPU or memory
+// target or a Pods metric on the exporter's memcached_current_connections, and
+// requires each metric to set the source matching its type. The defaulting webhook
+// injects a CPU target when none is given, so the first check only fires when
+// defaulting is bypassed.
+func validateAutoscalingMetrics(metrics []autoscalingv2.MetricSpec, fldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	if len(metrics) == 0 {
+		return append(errs, field.Required(fldPath,
+			"at least one metric (a CPU or memory target, or a Pods, Object, External or ContainerResource metric) "+
+				"is required when autoscaling is enabled"))
+	}
+
+	for i := range metrics {
+		m := &metrics[i]
+		var source string
+		var set bool
+		switch m.Type {
+		case autoscalingv2.ResourceMetricSourceType:
+			source, set = "resource", m.Resource != nil
+		case autoscalingv2.PodsMetricSourceType:
+			source, set = "pods", m.Pods != nil
+		case autoscalingv2.ObjectMetricSourceType:
+			source, set = "object", m.Object != nil
+		case autoscalingv2.ExternalMetricSourceType:
+			source, set = "external", m.External != nil
+		case autoscalingv2.ContainerResourceMetricSourceType:
+			source, set = "containerResource", m.ContainerResource != nil
+		default:
+			errs = append(errs, field.NotSupported(fldPath.Index(i).Child("type"), m.Type, []string{
+				string(autoscalingv2.ResourceMetricSourceType),
+				string(autoscalingv2.PodsMetricSourceType),
+				string(autoscalingv2.ObjectMetricSourceType),
+				string(autoscalingv2.ExternalMetricSourceType),
+				string(autoscalingv2.ContainerResourceMetricSourceType),
+			}))
+			continue
+		}
+		if !set {
+			errs = append(errs, field.Required(fldPath.Index(i).Child(source),
+				fmt.Sprintf("must be set for a metric of type %s", m.Type)))
+		}
+	}
+
+	return errs
+}
+
 // hasCPUUtilizationMetric returns true if any metric in the slice is a CPU Resource
 // metric with a Utilization target type.
 func hasCPUUtilizationMetric(metrics []autoscalingv2.MetricSpec) bool {
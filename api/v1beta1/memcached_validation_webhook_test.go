@@ -349,7 +349,7 @@ func TestValidatePDB(t *testing.T) {
 			wantError: true,
 		},
 		{
-			name: "neither minAvailable nor maxUnavailable set",
+			name: "neither minAvailable nor maxUnavailable set (controller default)",
 			mc: &Memcached{
 				Spec: MemcachedSpec{
 					HighAvailability: &HighAvailabilitySpec{
@@ -359,7 +359,7 @@ func TestValidatePDB(t *testing.T) {
 					},
 				},
 			},
-			wantError: true,
+			wantError: false,
 		},
 		{
 			name: "disabled bypasses validation",
@@ -676,6 +676,307 @@ func TestValidateSecuritySecretRefs(t *testing.T) {
 	}
 }
 
+func TestValidateTLSCopySource(t *testing.T) {
+	withCopy := func(namespace string) *Memcached {
+		return &Memcached{
+			ObjectMeta: metav1.ObjectMeta{Name: "mc", Namespace: "default"},
+			Spec: MemcachedSpec{
+				Security: &SecuritySpec{
+					TLS: &TLSSpec{
+						Enabled:              true,
+						CertificateSecretRef: corev1.LocalObjectReference{Name: "mc-tls"},
+						CopyFromNamespace:    &namespace,
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		mc        *Memcached
+		allowed   []string
+		wantError bool
+	}{
+		{name: "allowlisted namespace", mc: withCopy("certs"), allowed: []string{"certs"}, wantError: false},
+		{name: "non-allowlisted namespace", mc: withCopy("kube-system"), allowed: []string{"certs"}, wantError: true},
+		{name: "copying disabled", mc: withCopy("certs"), wantError: true},
+		{name: "own namespace", mc: withCopy("default"), wantError: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := &MemcachedCustomValidator{Options: WebhookOptions{TLSCopySourceNamespaces: tt.allowed}}
+			_, err := v.ValidateCreate(context.Background(), tt.mc)
+			if (err != nil) != tt.wantError {
+				t.Errorf("wantError=%v, got err=%v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestValidateGenerateCertificate(t *testing.T) {
+	tlsWith := func(gen *GenerateCertificateSpec, copyFrom *string) *Memcached {
+		return &Memcached{
+			Spec: MemcachedSpec{
+				Security: &SecuritySpec{
+					TLS: &TLSSpec{
+						Enabled:              true,
+						CertificateSecretRef: corev1.LocalObjectReference{Name: "mc-tls"},
+						CopyFromNamespace:    copyFrom,
+						GenerateCertificate:  gen,
+					},
+				},
+			},
+		}
+	}
+	issuer := CertificateIssuerRef{Name: "selfsigned", Kind: "ClusterIssuer"}
+	sourceNS := "certs"
+
+	tests := []struct {
+		name      string
+		mc        *Memcached
+		wantError bool
+	}{
+		{
+			name:      "generated certificate with DNS names",
+			mc:        tlsWith(&GenerateCertificateSpec{IssuerRef: issuer, DNSNames: []string{"mc.default.svc"}}, nil),
+			wantError: false,
+		},
+		{
+			name:      "generated certificate without DNS names uses defaults",
+			mc:        tlsWith(&GenerateCertificateSpec{IssuerRef: issuer}, nil),
+			wantError: false,
+		},
+		{
+			name:      "generated certificate with copyFromNamespace",
+			mc:        tlsWith(&GenerateCertificateSpec{IssuerRef: issuer, DNSNames: []string{"mc.default.svc"}}, &sourceNS),
+			wantError: true,
+		},
+		{
+			name:      "copyFromNamespace without generated certificate",
+			mc:        tlsWith(nil, &sourceNS),
+			wantError: false,
+		},
+	}
+
+	v := &MemcachedCustomValidator{Options: WebhookOptions{TLSCopySourceNamespaces: []string{sourceNS}}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := v.ValidateCreate(context.Background(), tt.mc)
+			if (err != nil) != tt.wantError {
+				t.Errorf("wantError=%v, got err=%v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestValidateSysctls(t *testing.T) {
+	withSysctl := func(name string) *Memcached {
+		return &Memcached{
+			Spec: MemcachedSpec{
+				Security: &SecuritySpec{
+					Sysctls: []corev1.Sysctl{{Name: name, Value: "1024"}},
+				},
+			},
+		}
+	}
+	withPodSysctl := func(name string) *Memcached {
+		return &Memcached{
+			Spec: MemcachedSpec{
+				Security: &SecuritySpec{
+					PodSecurityContext: &corev1.PodSecurityContext{
+						Sysctls: []corev1.Sysctl{{Name: name, Value: "1024"}},
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		mc          *Memcached
+		allowUnsafe bool
+		wantError   bool
+	}{
+		{name: "safe sysctl accepted", mc: withSysctl("net.ipv4.tcp_keepalive_time"), wantError: false},
+		{name: "unsafe sysctl rejected", mc: withSysctl("net.core.somaxconn"), wantError: true},
+		{name: "unsafe sysctl accepted with flag", mc: withSysctl("net.core.somaxconn"), allowUnsafe: true, wantError: false},
+		{name: "safe pod security context sysctl accepted", mc: withPodSysctl("net.ipv4.tcp_keepalive_time"), wantError: false},
+		{name: "unsafe pod security context sysctl rejected", mc: withPodSysctl("net.core.somaxconn"), wantError: true},
+		{name: "unsafe pod security context sysctl accepted with flag", mc: withPodSysctl("net.core.somaxconn"), allowUnsafe: true, wantError: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := &MemcachedCustomValidator{Options: WebhookOptions{AllowUnsafeSysctls: tt.allowUnsafe}}
+			_, err := v.ValidateCreate(context.Background(), tt.mc)
+			if (err != nil) != tt.wantError {
+				t.Errorf("wantError=%v, got err=%v", tt.wantError, err)
+			}
+			if err != nil && !strings.Contains(err.Error(), "--allow-unsafe-sysctls") {
+				t.Errorf("error %q should mention --allow-unsafe-sysctls", err.Error())
+			}
+			if err != nil && tt.mc.Spec.Security.PodSecurityContext != nil &&
+				!strings.Contains(err.Error(), "spec.security.podSecurityContext.sysctls[0].name") {
+				t.Errorf("error %q should name the podSecurityContext sysctl", err.Error())
+			}
+		})
+	}
+}
+
+func TestValidateExporterTLS(t *testing.T) {
+	tests := []struct {
+		name      string
+		mc        *Memcached
+		wantError bool
+	}{
+		{
+			name: "exporter TLS enabled with secret",
+			mc: &Memcached{
+				Spec: MemcachedSpec{
+					Monitoring: &MonitoringSpec{
+						Enabled: true,
+						ExporterTLS: &ExporterTLSSpec{
+							Enabled:              true,
+							CertificateSecretRef: corev1.LocalObjectReference{Name: "exporter-tls"},
+						},
+					},
+				},
+			},
+			wantError: false,
+		},
+		{
+			name: "exporter TLS enabled without secret",
+			mc: &Memcached{
+				Spec: MemcachedSpec{
+					Monitoring: &MonitoringSpec{
+						Enabled:     true,
+						ExporterTLS: &ExporterTLSSpec{Enabled: true},
+					},
+				},
+			},
+			wantError: true,
+		},
+		{
+			name: "exporter TLS with CA from a Secret",
+			mc: &Memcached{
+				Spec: MemcachedSpec{
+					Monitoring: &MonitoringSpec{
+						Enabled: true,
+						ExporterTLS: &ExporterTLSSpec{
+							Enabled:              true,
+							CertificateSecretRef: corev1.LocalObjectReference{Name: "exporter-tls"},
+							CASecretRef: &corev1.SecretKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{Name: "exporter-tls"},
+								Key:                  "ca.crt",
+							},
+						},
+					},
+				},
+			},
+			wantError: false,
+		},
+		{
+			name: "exporter TLS with CA from both a Secret and a ConfigMap",
+			mc: &Memcached{
+				Spec: MemcachedSpec{
+					Monitoring: &MonitoringSpec{
+						Enabled: true,
+						ExporterTLS: &ExporterTLSSpec{
+							Enabled:              true,
+							CertificateSecretRef: corev1.LocalObjectReference{Name: "exporter-tls"},
+							CASecretRef: &corev1.SecretKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{Name: "exporter-tls"},
+								Key:                  "ca.crt",
+							},
+							CAConfigMapRef: &corev1.ConfigMapKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{Name: "exporter-ca"},
+								Key:                  "ca.crt",
+							},
+						},
+					},
+				},
+			},
+			wantError: true,
+		},
+		{
+			name: "exporter TLS disabled without secret",
+			mc: &Memcached{
+				Spec: MemcachedSpec{
+					Monitoring: &MonitoringSpec{
+						Enabled:     true,
+						ExporterTLS: &ExporterTLSSpec{Enabled: false},
+					},
+				},
+			},
+			wantError: false,
+		},
+		{
+			name: "monitoring disabled ignores exporter TLS",
+			mc: &Memcached{
+				Spec: MemcachedSpec{
+					Monitoring: &MonitoringSpec{
+						Enabled:     false,
+						ExporterTLS: &ExporterTLSSpec{Enabled: true},
+					},
+				},
+			},
+			wantError: false,
+		},
+	}
+
+	v := &MemcachedCustomValidator{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := v.ValidateCreate(context.Background(), tt.mc)
+			if (err != nil) != tt.wantError {
+				t.Errorf("wantError=%v, got err=%v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestValidateTLSPort(t *testing.T) {
+	newMC := func(port int32, tlsEnabled, monitoring bool) *Memcached {
+		return &Memcached{
+			Spec: MemcachedSpec{
+				Monitoring: &MonitoringSpec{Enabled: monitoring},
+				Security: &SecuritySpec{
+					TLS: &TLSSpec{
+						Enabled:              tlsEnabled,
+						CertificateSecretRef: corev1.LocalObjectReference{Name: "tls-secret"},
+						Port:                 &port,
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		mc        *Memcached
+		wantError bool
+	}{
+		{name: "custom port", mc: newMC(12345, true, false), wantError: false},
+		{name: "default port explicitly set", mc: newMC(DefaultTLSPort, true, false), wantError: false},
+		{name: "same as plaintext port", mc: newMC(11211, true, false), wantError: true},
+		{name: "same as metrics port with monitoring", mc: newMC(9150, true, true), wantError: true},
+		{name: "metrics port without monitoring", mc: newMC(9150, true, false), wantError: false},
+		{name: "TLS disabled ignores port", mc: newMC(11211, false, false), wantError: false},
+	}
+
+	v := &MemcachedCustomValidator{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := v.ValidateCreate(context.Background(), tt.mc)
+			if (err != nil) != tt.wantError {
+				t.Errorf("wantError=%v, got err=%v", tt.wantError, err)
+			}
+		})
+	}
+}
+
 // --- REQ-006: Graceful shutdown timing validation ---
 
 func TestValidateGracefulShutdown(t *testing.T) {
@@ -812,6 +1113,53 @@ func TestValidateGracefulShutdown_ErrorMessage(t *testing.T) {
 	}
 }
 
+func TestValidateSecuritySecretRefs_NameTemplate(t *testing.T) {
+	tests := []struct {
+		name        string
+		tmpl        string
+		ref         string
+		wantErrText string
+	}{
+		{name: "valid template", tmpl: "{{ .Name }}-sasl"},
+		{name: "unparseable template", tmpl: "{{ .Name", wantErrText: "invalid template"},
+		{name: "unknown field", tmpl: "{{ .Labels }}", wantErrText: "invalid template"},
+		{name: "invalid resolved name", tmpl: "{{ .Name }}_SASL", wantErrText: "invalid Secret name"},
+		{name: "template with secret ref", tmpl: "{{ .Name }}-sasl", ref: "sasl-secret", wantErrText: "mutually exclusive"},
+	}
+
+	v := &MemcachedCustomValidator{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl := tt.tmpl
+			mc := &Memcached{
+				ObjectMeta: metav1.ObjectMeta{Name: "cache", Namespace: "default"},
+				Spec: MemcachedSpec{
+					Security: &SecuritySpec{
+						SASL: &SASLSpec{
+							Enabled:                       true,
+							CredentialsSecretRef:          corev1.LocalObjectReference{Name: tt.ref},
+							CredentialsSecretNameTemplate: &tmpl,
+						},
+					},
+				},
+			}
+			_, err := v.ValidateCreate(context.Background(), mc)
+			if tt.wantErrText == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			if !strings.Contains(err.Error(), "credentialsSecretNameTemplate") || !strings.Contains(err.Error(), tt.wantErrText) {
+				t.Errorf("error = %q, want it to mention credentialsSecretNameTemplate and %q", err.Error(), tt.wantErrText)
+			}
+		})
+	}
+}
+
 func TestValidateSecuritySecretRefs_ErrorMessages(t *testing.T) {
 	t.Run("SASL error includes field path", func(t *testing.T) {
 		mc := &Memcached{
@@ -916,6 +1264,35 @@ func TestValidateUpdate_PropagatesErrors(t *testing.T) {
 	}
 }
 
+func TestValidateUpdate_SkipsUnchangedSpecAndDeletion(t *testing.T) {
+	// A stored CR that violates a rule: SASL enabled without a credentials Secret.
+	invalid := &Memcached{
+		Spec: MemcachedSpec{
+			Security: &SecuritySpec{
+				SASL: &SASLSpec{Enabled: true},
+			},
+		},
+	}
+
+	withFinalizer := invalid.DeepCopy()
+	withFinalizer.Finalizers = []string{"memcached.c5c3.io/final-stats"}
+
+	now := metav1.Now()
+	replicas := int32(2)
+	deleting := invalid.DeepCopy()
+	deleting.DeletionTimestamp = &now
+	deleting.Spec.Replicas = &replicas
+
+	v := &MemcachedCustomValidator{}
+	for name, newObj := range map[string]*Memcached{"metadata-only update": withFinalizer, "deleting": deleting} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := v.ValidateUpdate(context.Background(), invalid, newObj); err != nil {
+				t.Errorf("expected update to be admitted, got: %v", err)
+			}
+		})
+	}
+}
+
 // --- Task 1.6: Error aggregation, delete bypass, and update propagation (REQ-010) ---
 
 func TestValidation_FourSimultaneousViolations(t *testing.T) {
@@ -1098,35 +1475,114 @@ func TestValidateDelete_InvalidCRStillDeletes(t *testing.T) {
 
 // --- REQ-005: Replicas/autoscaling mutual exclusivity ---
 
-func TestValidateAutoscalingReplicasMutualExclusivity(t *testing.T) {
-	replicas := int32(3)
-	zeroReplicas := int32(0)
+// connectionsMetrics returns a Pods metric targeting the exporter's
+// memcached_current_connections, which needs no resource requests.
+func connectionsMetrics() []autoscalingv2.MetricSpec {
+	return []autoscalingv2.MetricSpec{{
+		Type: autoscalingv2.PodsMetricSourceType,
+		Pods: &autoscalingv2.PodsMetricSource{
+			Metric: autoscalingv2.MetricIdentifier{Name: "memcached_current_connections"},
+			Target: autoscalingv2.MetricTarget{
+				Type:         autoscalingv2.AverageValueMetricType,
+				AverageValue: resource.NewQuantity(500, resource.DecimalSI),
+			},
+		},
+	}}
+}
 
+func TestValidateAutoscalingMetrics(t *testing.T) {
 	tests := []struct {
 		name      string
-		mc        *Memcached
-		wantError bool
+		metrics   []autoscalingv2.MetricSpec
+		wantError string
 	}{
+		{name: "no metrics (rejected)", wantError: "spec.autoscaling.metrics"},
+		{name: "Pods metric on current connections (accepted)", metrics: connectionsMetrics()},
 		{
-			name: "replicas set + autoscaling enabled (rejected)",
-			mc: &Memcached{
-				Spec: MemcachedSpec{
-					Replicas: &replicas,
-					Autoscaling: &AutoscalingSpec{
-						Enabled:     true,
-						MaxReplicas: 10,
+			name: "External metric (accepted)",
+			metrics: []autoscalingv2.MetricSpec{{
+				Type: autoscalingv2.ExternalMetricSourceType,
+				External: &autoscalingv2.ExternalMetricSource{
+					Metric: autoscalingv2.MetricIdentifier{Name: "memcached_current_connections"},
+					Target: autoscalingv2.MetricTarget{
+						Type:  autoscalingv2.ValueMetricType,
+						Value: resource.NewQuantity(1000, resource.DecimalSI),
 					},
 				},
-			},
-			wantError: true,
+			}},
 		},
 		{
-			name: "replicas nil + autoscaling enabled (accepted)",
-			mc: &Memcached{
-				Spec: MemcachedSpec{
-					Autoscaling: &AutoscalingSpec{
+			name:      "Pods type without pods source (rejected)",
+			metrics:   []autoscalingv2.MetricSpec{{Type: autoscalingv2.PodsMetricSourceType}},
+			wantError: "spec.autoscaling.metrics[0].pods",
+		},
+		{
+			name:      "External type without external source (rejected)",
+			metrics:   append(connectionsMetrics(), autoscalingv2.MetricSpec{Type: autoscalingv2.ExternalMetricSourceType}),
+			wantError: "spec.autoscaling.metrics[1].external",
+		},
+		{
+			name:      "unknown type (rejected)",
+			metrics:   []autoscalingv2.MetricSpec{{Type: "Custom"}},
+			wantError: "spec.autoscaling.metrics[0].type",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &Memcached{
+				Spec: MemcachedSpec{
+					Autoscaling: &AutoscalingSpec{Enabled: true, MaxReplicas: 10, Metrics: tt.metrics},
+				},
+			}
+			v := &MemcachedCustomValidator{}
+			_, err := v.ValidateCreate(context.Background(), mc)
+			if tt.wantError == "" {
+				if err != nil {
+					t.Fatalf("expected no error, got: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("expected error containing %q, got nil", tt.wantError)
+			}
+			if !strings.Contains(err.Error(), tt.wantError) {
+				t.Errorf("expected error containing %q, got: %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestValidateAutoscalingReplicasMutualExclusivity(t *testing.T) {
+	replicas := int32(3)
+	zeroReplicas := int32(0)
+
+	tests := []struct {
+		name      string
+		mc        *Memcached
+		wantError bool
+	}{
+		{
+			name: "replicas set + autoscaling enabled (rejected)",
+			mc: &Memcached{
+				Spec: MemcachedSpec{
+					Replicas: &replicas,
+					Autoscaling: &AutoscalingSpec{
+						Enabled:     true,
+						MaxReplicas: 10,
+					},
+				},
+			},
+			wantError: true,
+		},
+		{
+			name: "replicas nil + autoscaling enabled (accepted)",
+			mc: &Memcached{
+				Spec: MemcachedSpec{
+					Autoscaling: &AutoscalingSpec{
 						Enabled:     true,
 						MaxReplicas: 10,
+						Metrics:     connectionsMetrics(),
 					},
 				},
 			},
@@ -1212,6 +1668,7 @@ func TestValidateAutoscalingMinMaxReplicas(t *testing.T) {
 						Enabled:     true,
 						MinReplicas: &min3,
 						MaxReplicas: 3,
+						Metrics:     connectionsMetrics(),
 					},
 				},
 			},
@@ -1225,6 +1682,7 @@ func TestValidateAutoscalingMinMaxReplicas(t *testing.T) {
 						Enabled:     true,
 						MinReplicas: &min1,
 						MaxReplicas: 10,
+						Metrics:     connectionsMetrics(),
 					},
 				},
 			},
@@ -1237,6 +1695,7 @@ func TestValidateAutoscalingMinMaxReplicas(t *testing.T) {
 					Autoscaling: &AutoscalingSpec{
 						Enabled:     true,
 						MaxReplicas: 10,
+						Metrics:     connectionsMetrics(),
 					},
 				},
 			},
@@ -1395,18 +1854,6 @@ func TestValidateAutoscalingCPURequests(t *testing.T) {
 			},
 			wantError: false,
 		},
-		{
-			name: "no metrics (accepted)",
-			mc: &Memcached{
-				Spec: MemcachedSpec{
-					Autoscaling: &AutoscalingSpec{
-						Enabled:     true,
-						MaxReplicas: 10,
-					},
-				},
-			},
-			wantError: false,
-		},
 		{
 			name: "CPU AverageValue metric + no CPU request (accepted, only Utilization requires it)",
 			mc: &Memcached{
@@ -1596,3 +2043,1431 @@ func TestValidateAutoscaling_ErrorMessages(t *testing.T) {
 		}
 	})
 }
+
+func TestWarnImageVersion(t *testing.T) {
+	newMC := func(image string, tlsEnabled bool) *Memcached {
+		mc := &Memcached{
+			Spec: MemcachedSpec{
+				Security: &SecuritySpec{
+					TLS: &TLSSpec{
+						Enabled:              tlsEnabled,
+						CertificateSecretRef: corev1.LocalObjectReference{Name: "tls-secret"},
+					},
+				},
+			},
+		}
+		if image != "" {
+			mc.Spec.Image = &image
+		}
+		return mc
+	}
+
+	tests := []struct {
+		name        string
+		mc          *Memcached
+		wantWarning bool
+	}{
+		{name: "1.5 tag with TLS", mc: newMC("memcached:1.5", true), wantWarning: true},
+		{name: "1.5.12 tag with TLS", mc: newMC("memcached:1.5.12-alpine", true), wantWarning: true},
+		{name: "1.6.21 tag with TLS", mc: newMC("memcached:1.6.21", true), wantWarning: false},
+		{name: "default image with TLS", mc: newMC("", true), wantWarning: false},
+		{name: "digest with TLS", mc: newMC("memcached@sha256:abc123", true), wantWarning: false},
+		{name: "registry port without tag", mc: newMC("registry:5000/memcached", true), wantWarning: false},
+		{name: "non-version tag", mc: newMC("memcached:latest", true), wantWarning: false},
+		{name: "1.5 tag without TLS", mc: newMC("memcached:1.5", false), wantWarning: false},
+	}
+
+	v := &MemcachedCustomValidator{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			warnings, err := v.ValidateCreate(context.Background(), tt.mc)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := len(warnings) > 0; got != tt.wantWarning {
+				t.Errorf("wantWarning=%v, got warnings=%v", tt.wantWarning, warnings)
+			}
+		})
+	}
+}
+
+func TestValidateExtraArgs(t *testing.T) {
+	tests := []struct {
+		name      string
+		extraArgs []string
+		wantError bool
+	}{
+		{name: "nil extraArgs", extraArgs: nil, wantError: false},
+		{name: "benign -o modern", extraArgs: []string{"-o", "modern"}, wantError: false},
+		{name: "benign unmanaged flags", extraArgs: []string{"-B", "binary", "-R", "20"}, wantError: false},
+		{name: "conflicting -m", extraArgs: []string{"-m", "128"}, wantError: true},
+		{name: "conflicting attached -m value", extraArgs: []string{"-m128"}, wantError: true},
+		{name: "conflicting long --memory-limit", extraArgs: []string{"--memory-limit=128"}, wantError: true},
+		{name: "conflicting -c", extraArgs: []string{"-c", "2048"}, wantError: true},
+		{name: "conflicting -t", extraArgs: []string{"-t", "8"}, wantError: true},
+		{name: "conflicting -I", extraArgs: []string{"-I", "2m"}, wantError: true},
+		{name: "conflicting -v", extraArgs: []string{"-v"}, wantError: true},
+		{name: "conflicting -vv", extraArgs: []string{"-vv"}, wantError: true},
+		{name: "conflicting -Y", extraArgs: []string{"-Y", "/etc/auth"}, wantError: true},
+		{name: "conflicting -Z", extraArgs: []string{"-Z"}, wantError: true},
+		{name: "conflicting ssl_key option", extraArgs: []string{"-o", "ssl_key=/tmp/key.pem"}, wantError: true},
+		{name: "conflicting ssl option in list", extraArgs: []string{"-o", "modern,ssl_chain_cert=/tmp/c.pem"}, wantError: true},
+		{name: "conflicting attached ssl option", extraArgs: []string{"-ossl_ca_cert=/tmp/ca.pem"}, wantError: true},
+		{name: "conflicting ssl_verify_mode option", extraArgs: []string{"-o", "ssl_verify_mode=1"}, wantError: true},
+		{name: "conflicting long --extended ssl option", extraArgs: []string{"--extended=ssl_key=/tmp/key.pem"}, wantError: true},
+		{name: "unmanaged ssl option", extraArgs: []string{"-o", "ssl_session_cache"}, wantError: false},
+		{name: "unrelated -o options", extraArgs: []string{"-o", "modern,hash_algorithm=murmur3", "-o", "ssl_min_version=tlsv1.3"}, wantError: false},
+		{name: "conflicting idle_timeout option", extraArgs: []string{"-o", "idle_timeout=60"}, wantError: true},
+		{name: "conflicting slab_automove option", extraArgs: []string{"-o", "slab_reassign,slab_automove=2"}, wantError: true},
+		{name: "conflicting no_slab_reassign option", extraArgs: []string{"-o", "no_slab_reassign"}, wantError: true},
+		{name: "conflicting -l", extraArgs: []string{"-l", "127.0.0.1"}, wantError: true},
+		{name: "conflicting -s", extraArgs: []string{"-s", "/tmp/mc.sock"}, wantError: true},
+		{name: "conflicting --unix-socket=", extraArgs: []string{"--unix-socket=/tmp/mc.sock"}, wantError: true},
+	}
+
+	v := &MemcachedCustomValidator{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &Memcached{
+				Spec: MemcachedSpec{
+					Memcached: &MemcachedConfig{ExtraArgs: tt.extraArgs},
+				},
+			}
+			_, err := v.ValidateCreate(context.Background(), mc)
+			if (err != nil) != tt.wantError {
+				t.Errorf("wantError=%v, got err=%v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestValidateVerbosity(t *testing.T) {
+	tests := []struct {
+		name      string
+		verbosity int32
+		wantErr   bool
+	}{
+		{name: "verbosity 0", verbosity: 0},
+		{name: "verbosity 2", verbosity: 2},
+		{name: "verbosity 3", verbosity: 3},
+		{name: "verbosity 4", verbosity: 4, wantErr: true},
+		{name: "negative verbosity", verbosity: -1, wantErr: true},
+	}
+
+	v := &MemcachedCustomValidator{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &Memcached{
+				Spec: MemcachedSpec{
+					Memcached: &MemcachedConfig{Verbosity: tt.verbosity},
+				},
+			}
+			_, err := v.ValidateCreate(context.Background(), mc)
+			if !tt.wantErr {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), "spec.memcached.verbosity") {
+				t.Errorf("error = %v, want it to mention spec.memcached.verbosity", err)
+			}
+		})
+	}
+}
+
+func TestValidateSlabRebalancing(t *testing.T) {
+	i32 := func(v int32) *int32 { return &v }
+	enabled, disabled := true, false
+	tests := []struct {
+		name         string
+		slabReassign *bool
+		slabAutomove *int32
+		wantErr      string
+	}{
+		{name: "unset"},
+		{name: "automove 0", slabAutomove: i32(0)},
+		{name: "automove 1", slabAutomove: i32(1)},
+		{name: "automove 2 with reassign", slabReassign: &enabled, slabAutomove: i32(2)},
+		{name: "automove 3", slabAutomove: i32(3), wantErr: "spec.memcached.slabAutomove: Unsupported value: 3"},
+		{name: "negative automove", slabAutomove: i32(-1), wantErr: "spec.memcached.slabAutomove: Unsupported value: -1"},
+		{name: "reassign disabled without automove", slabReassign: &disabled},
+		{name: "automove 0 with reassign disabled", slabReassign: &disabled, slabAutomove: i32(0)},
+		{name: "automove 1 with reassign disabled", slabReassign: &disabled, slabAutomove: i32(1), wantErr: "slabAutomove requires slab reassignment"},
+	}
+
+	v := &MemcachedCustomValidator{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &Memcached{
+				Spec: MemcachedSpec{
+					Memcached: &MemcachedConfig{SlabReassign: tt.slabReassign, SlabAutomove: tt.slabAutomove},
+				},
+			}
+			_, err := v.ValidateCreate(context.Background(), mc)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateCommand(t *testing.T) {
+	tests := []struct {
+		name      string
+		command   []string
+		wantError bool
+	}{
+		{name: "nil command", command: nil, wantError: false},
+		{name: "wrapper entrypoint", command: []string{"/entrypoint.sh", "memcached"}, wantError: false},
+		{name: "empty command", command: []string{}, wantError: true},
+		{name: "blank entry", command: []string{"/entrypoint.sh", " "}, wantError: true},
+	}
+
+	v := &MemcachedCustomValidator{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &Memcached{
+				Spec: MemcachedSpec{
+					Memcached: &MemcachedConfig{Command: tt.command},
+				},
+			}
+			_, err := v.ValidateCreate(context.Background(), mc)
+			if (err != nil) != tt.wantError {
+				t.Errorf("wantError=%v, got err=%v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestValidateEntrypoint(t *testing.T) {
+	ref := &corev1.LocalObjectReference{Name: "memcached-entrypoint"}
+	script := "entrypoint.sh"
+	nested := "bin/entrypoint.sh"
+
+	tests := []struct {
+		name      string
+		config    *MemcachedConfig
+		wantError string
+	}{
+		{name: "unset", config: &MemcachedConfig{}},
+		{name: "ref and path", config: &MemcachedConfig{EntrypointConfigMapRef: ref, EntrypointPath: &script}},
+		{
+			name:      "path without ref",
+			config:    &MemcachedConfig{EntrypointPath: &script},
+			wantError: "spec.memcached.entrypointConfigMapRef.name",
+		},
+		{
+			name:      "path with empty ref name",
+			config:    &MemcachedConfig{EntrypointConfigMapRef: &corev1.LocalObjectReference{}, EntrypointPath: &script},
+			wantError: "spec.memcached.entrypointConfigMapRef.name",
+		},
+		{
+			name:      "ref without path",
+			config:    &MemcachedConfig{EntrypointConfigMapRef: ref},
+			wantError: "spec.memcached.entrypointPath",
+		},
+		{
+			name:      "path is not a ConfigMap key",
+			config:    &MemcachedConfig{EntrypointConfigMapRef: ref, EntrypointPath: &nested},
+			wantError: "spec.memcached.entrypointPath",
+		},
+		{
+			name: "combined with command",
+			config: &MemcachedConfig{
+				EntrypointConfigMapRef: ref,
+				EntrypointPath:         &script,
+				Command:                []string{"/entrypoint.sh"},
+			},
+			wantError: "spec.memcached.command",
+		},
+	}
+
+	v := &MemcachedCustomValidator{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &Memcached{Spec: MemcachedSpec{Memcached: tt.config}}
+			_, err := v.ValidateCreate(context.Background(), mc)
+			if tt.wantError == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantError) {
+				t.Errorf("expected error naming %s, got: %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestValidateUnixSocket(t *testing.T) {
+	socket := &UnixSocketSpec{Enabled: true}
+
+	tests := []struct {
+		name      string
+		config    *MemcachedConfig
+		security  *SecuritySpec
+		wantError string
+	}{
+		{name: "socket only", config: &MemcachedConfig{UnixSocket: socket}},
+		{
+			name:   "disabled socket with listen addresses",
+			config: &MemcachedConfig{UnixSocket: &UnixSocketSpec{}, ListenAddresses: []string{"127.0.0.1"}},
+		},
+		{
+			name:      "socket with loopback listen address",
+			config:    &MemcachedConfig{UnixSocket: socket, ListenAddresses: []string{"127.0.0.1"}},
+			wantError: "spec.memcached.listenAddresses",
+		},
+		{
+			name:      "socket mixed with pod IP listen address",
+			config:    &MemcachedConfig{UnixSocket: socket, ListenAddresses: []string{"127.0.0.1", PodIPToken}},
+			wantError: "spec.memcached.listenAddresses",
+		},
+		{
+			name:   "socket with TLS",
+			config: &MemcachedConfig{UnixSocket: socket},
+			security: &SecuritySpec{TLS: &TLSSpec{
+				Enabled:              true,
+				CertificateSecretRef: corev1.LocalObjectReference{Name: "tls"},
+			}},
+			wantError: "spec.security.tls.enabled",
+		},
+	}
+
+	v := &MemcachedCustomValidator{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &Memcached{
+				Spec: MemcachedSpec{Memcached: tt.config, Security: tt.security},
+			}
+			_, err := v.ValidateCreate(context.Background(), mc)
+			if tt.wantError == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantError) {
+				t.Errorf("expected error naming %s, got: %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestValidateTmpfsSizeLimit(t *testing.T) {
+	tests := []struct {
+		name      string
+		sizeLimit string
+		wantError bool
+	}{
+		{name: "unset"},
+		{name: "positive", sizeLimit: "64Mi"},
+		{name: "zero", sizeLimit: "0", wantError: true},
+		{name: "negative", sizeLimit: "-1Mi", wantError: true},
+	}
+
+	v := &MemcachedCustomValidator{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := &MemcachedConfig{}
+			if tt.sizeLimit != "" {
+				q := resource.MustParse(tt.sizeLimit)
+				config.TmpfsSizeLimit = &q
+			}
+			mc := &Memcached{Spec: MemcachedSpec{Memcached: config}}
+			_, err := v.ValidateCreate(context.Background(), mc)
+			if !tt.wantError {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), "spec.memcached.tmpfsSizeLimit") {
+				t.Errorf("expected error naming spec.memcached.tmpfsSizeLimit, got: %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateDisabledMetricGroups(t *testing.T) {
+	tests := []struct {
+		name      string
+		groups    []string
+		wantError string
+	}{
+		{name: "nil groups", groups: nil},
+		{name: "known groups", groups: []string{"slabs", "items", "settings"}},
+		{name: "unknown group", groups: []string{"slabs", "keyspace"}, wantError: "spec.monitoring.disabledMetricGroups[1]"},
+		{name: "flag instead of group", groups: []string{"--no-memcached.slabs"}, wantError: "spec.monitoring.disabledMetricGroups[0]"},
+	}
+
+	v := &MemcachedCustomValidator{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &Memcached{
+				Spec: MemcachedSpec{
+					Monitoring: &MonitoringSpec{Enabled: true, DisabledMetricGroups: tt.groups},
+				},
+			}
+			_, err := v.ValidateCreate(context.Background(), mc)
+			if tt.wantError == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantError) {
+				t.Errorf("expected error naming %s, got: %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestValidatePodOverhead(t *testing.T) {
+	kata := "kata"
+	tests := []struct {
+		name             string
+		overhead         corev1.ResourceList
+		runtimeClassName *string
+		wantError        string
+	}{
+		{name: "unset", overhead: nil},
+		{
+			name:             "with runtime class",
+			overhead:         corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("250m"), corev1.ResourceMemory: resource.MustParse("120Mi")},
+			runtimeClassName: &kata,
+		},
+		{
+			name:             "zero quantity",
+			overhead:         corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("0")},
+			runtimeClassName: &kata,
+		},
+		{
+			name:             "negative quantity",
+			overhead:         corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("-1Mi")},
+			runtimeClassName: &kata,
+			wantError:        "spec.podOverhead[memory]",
+		},
+		{
+			name:      "without runtime class",
+			overhead:  corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("250m")},
+			wantError: "spec.runtimeClassName",
+		},
+	}
+
+	v := &MemcachedCustomValidator{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &Memcached{
+				Spec: MemcachedSpec{PodOverhead: tt.overhead, RuntimeClassName: tt.runtimeClassName},
+			}
+			_, err := v.ValidateCreate(context.Background(), mc)
+			if tt.wantError == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantError) {
+				t.Errorf("expected error naming %s, got: %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestValidateQoSClass(t *testing.T) {
+	tests := []struct {
+		name      string
+		qosClass  corev1.PodQOSClass
+		resources *corev1.ResourceRequirements
+		wantError string
+	}{
+		{name: "unset class ignores resources"},
+		{
+			name:     "burstable with mismatched values",
+			qosClass: corev1.PodQOSBurstable,
+			resources: &corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m")},
+				Limits:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+			},
+		},
+		{
+			name:     "guaranteed with requests only",
+			qosClass: corev1.PodQOSGuaranteed,
+			resources: &corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m"), corev1.ResourceMemory: resource.MustParse("128Mi")},
+			},
+		},
+		{
+			name:     "guaranteed with equal requests and limits",
+			qosClass: corev1.PodQOSGuaranteed,
+			resources: &corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1"), corev1.ResourceMemory: resource.MustParse("256Mi")},
+				Limits:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1000m"), corev1.ResourceMemory: resource.MustParse("256Mi")},
+			},
+		},
+		{
+			name:     "guaranteed with mismatched cpu",
+			qosClass: corev1.PodQOSGuaranteed,
+			resources: &corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m"), corev1.ResourceMemory: resource.MustParse("256Mi")},
+				Limits:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1"), corev1.ResourceMemory: resource.MustParse("256Mi")},
+			},
+			wantError: "spec.resources.limits[cpu]",
+		},
+		{
+			name:     "guaranteed without memory",
+			qosClass: corev1.PodQOSGuaranteed,
+			resources: &corev1.ResourceRequirements{
+				Limits: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+			},
+			wantError: "spec.resources.limits[memory]",
+		},
+		{
+			name:      "guaranteed without resources",
+			qosClass:  corev1.PodQOSGuaranteed,
+			wantError: "spec.resources.limits[cpu]",
+		},
+	}
+
+	v := &MemcachedCustomValidator{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &Memcached{Spec: MemcachedSpec{QoSClass: tt.qosClass, Resources: tt.resources}}
+			_, err := v.ValidateCreate(context.Background(), mc)
+			if tt.wantError == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantError) {
+				t.Errorf("expected error naming %s, got: %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestValidateQoSClass_ExporterResources(t *testing.T) {
+	guaranteed := &corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1"), corev1.ResourceMemory: resource.MustParse("256Mi")},
+	}
+	tests := []struct {
+		name      string
+		exporter  *corev1.ResourceRequirements
+		wantError string
+	}{
+		{
+			name: "exporter requests only",
+			exporter: &corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("50m"), corev1.ResourceMemory: resource.MustParse("32Mi")},
+			},
+		},
+		{
+			name:      "exporter without resources",
+			wantError: "spec.monitoring.exporterResources.limits[cpu]",
+		},
+		{
+			name: "exporter with mismatched memory",
+			exporter: &corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("50m"), corev1.ResourceMemory: resource.MustParse("32Mi")},
+				Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("64Mi")},
+			},
+			wantError: "spec.monitoring.exporterResources.limits[memory]",
+		},
+	}
+
+	v := &MemcachedCustomValidator{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &Memcached{Spec: MemcachedSpec{
+				QoSClass:   corev1.PodQOSGuaranteed,
+				Resources:  guaranteed,
+				Monitoring: &MonitoringSpec{Enabled: true, ExporterResources: tt.exporter},
+			}}
+			_, err := v.ValidateCreate(context.Background(), mc)
+			if tt.wantError == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantError) {
+				t.Errorf("expected error naming %s, got: %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestWarnQoSClass(t *testing.T) {
+	image := "example.com/memcached-stats:1.0"
+	tests := []struct {
+		name        string
+		spec        MemcachedSpec
+		wantWarning string
+	}{
+		{name: "guaranteed without sidecars", spec: MemcachedSpec{QoSClass: corev1.PodQOSGuaranteed}},
+		{
+			name: "burstable with stats sidecar",
+			spec: MemcachedSpec{QoSClass: corev1.PodQOSBurstable, StatsSidecar: &StatsSidecarSpec{Enabled: true, Image: &image}},
+		},
+		{
+			name:        "guaranteed with stats sidecar",
+			spec:        MemcachedSpec{QoSClass: corev1.PodQOSGuaranteed, StatsSidecar: &StatsSidecarSpec{Enabled: true, Image: &image}},
+			wantWarning: "the stats sidecar has",
+		},
+		{
+			name: "guaranteed with pushgateway and stats sidecars",
+			spec: MemcachedSpec{
+				QoSClass:     corev1.PodQOSGuaranteed,
+				Monitoring:   &MonitoringSpec{Enabled: true, PushGateway: &PushGatewaySpec{URL: "http://pushgateway:9091"}},
+				StatsSidecar: &StatsSidecarSpec{Enabled: true, Image: &image},
+			},
+			wantWarning: "the pushgateway and stats sidecars have",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			warnings := warnQoSClass(&Memcached{Spec: tt.spec})
+			if tt.wantWarning == "" {
+				if len(warnings) != 0 {
+					t.Errorf("unexpected warnings: %v", warnings)
+				}
+				return
+			}
+			if len(warnings) != 1 || !strings.Contains(warnings[0], tt.wantWarning) {
+				t.Errorf("warnings = %v, want one containing %q", warnings, tt.wantWarning)
+			}
+		})
+	}
+}
+
+func TestValidateMemoryLimit_GuaranteedUsesRequest(t *testing.T) {
+	mc := &Memcached{
+		Spec: MemcachedSpec{
+			QoSClass:  corev1.PodQOSGuaranteed,
+			Memcached: &MemcachedConfig{MaxMemoryMB: 256},
+			Resources: &corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1"), corev1.ResourceMemory: resource.MustParse("128Mi")},
+			},
+		},
+	}
+	v := &MemcachedCustomValidator{}
+	_, err := v.ValidateCreate(context.Background(), mc)
+	if err == nil || !strings.Contains(err.Error(), "spec.resources.limits.memory") {
+		t.Errorf("expected memory limit error from the copied request, got: %v", err)
+	}
+}
+
+func TestValidateRestartPolicy(t *testing.T) {
+	tests := []struct {
+		name      string
+		policy    corev1.RestartPolicy
+		wantError bool
+	}{
+		{name: "unset"},
+		{name: "always", policy: corev1.RestartPolicyAlways},
+		{name: "on failure", policy: corev1.RestartPolicyOnFailure, wantError: true},
+		{name: "never", policy: corev1.RestartPolicyNever, wantError: true},
+	}
+
+	v := &MemcachedCustomValidator{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &Memcached{Spec: MemcachedSpec{RestartPolicy: tt.policy}}
+			_, err := v.ValidateCreate(context.Background(), mc)
+			if !tt.wantError {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), "spec.restartPolicy") || !strings.Contains(err.Error(), `"Always"`) {
+				t.Errorf("expected error naming spec.restartPolicy and Always, got: %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateClientAffinity(t *testing.T) {
+	tests := []struct {
+		name      string
+		affinity  *ClientAffinitySpec
+		wantError string
+	}{
+		{name: "unset"},
+		{
+			name:     "match labels",
+			affinity: &ClientAffinitySpec{PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "client"}}, Weight: 50},
+		},
+		{
+			name: "match expressions",
+			affinity: &ClientAffinitySpec{PodSelector: metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: "app", Operator: metav1.LabelSelectorOpIn, Values: []string{"api", "worker"}},
+			}}},
+		},
+		{
+			name:      "empty selector",
+			affinity:  &ClientAffinitySpec{},
+			wantError: "spec.highAvailability.clientAffinity.podSelector",
+		},
+		{
+			name:      "invalid label key",
+			affinity:  &ClientAffinitySpec{PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"bad key": "client"}}},
+			wantError: "spec.highAvailability.clientAffinity.podSelector.matchLabels",
+		},
+		{
+			name: "In operator without values",
+			affinity: &ClientAffinitySpec{PodSelector: metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: "app", Operator: metav1.LabelSelectorOpIn},
+			}}},
+			wantError: "spec.highAvailability.clientAffinity.podSelector.matchExpressions[0].values",
+		},
+	}
+
+	v := &MemcachedCustomValidator{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &Memcached{
+				Spec: MemcachedSpec{HighAvailability: &HighAvailabilitySpec{ClientAffinity: tt.affinity}},
+			}
+			_, err := v.ValidateCreate(context.Background(), mc)
+			if tt.wantError == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantError) {
+				t.Errorf("expected error naming %s, got: %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestValidateMaxReplicas(t *testing.T) {
+	sixteen, eight, two := int32(16), int32(8), int32(2)
+	tests := []struct {
+		name        string
+		maxReplicas int32
+		spec        MemcachedSpec
+		wantError   string
+	}{
+		{name: "replicas at the cap", maxReplicas: 8, spec: MemcachedSpec{Replicas: &eight}},
+		{
+			name:        "replicas above the cap",
+			maxReplicas: 8,
+			spec:        MemcachedSpec{Replicas: &sixteen},
+			wantError:   "spec.replicas",
+		},
+		{name: "no cap configured", maxReplicas: 0, spec: MemcachedSpec{Replicas: &sixteen}},
+		{
+			name:        "autoscaling maxReplicas above the cap",
+			maxReplicas: 8,
+			spec:        MemcachedSpec{Autoscaling: &AutoscalingSpec{Enabled: true, MaxReplicas: 16}},
+			wantError:   "spec.autoscaling.maxReplicas",
+		},
+		{
+			name:        "disabled autoscaling is not checked",
+			maxReplicas: 8,
+			spec:        MemcachedSpec{Replicas: &two, Autoscaling: &AutoscalingSpec{MaxReplicas: 16}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := &MemcachedCustomValidator{Options: WebhookOptions{MaxReplicas: tt.maxReplicas}}
+			_, err := v.ValidateCreate(context.Background(), &Memcached{Spec: tt.spec})
+			if tt.wantError == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantError) {
+				t.Fatalf("expected error naming %s, got: %v", tt.wantError, err)
+			}
+			if !strings.Contains(err.Error(), "maximum of 8 replicas") {
+				t.Errorf("expected error to cite the cap, got: %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateMinReplicas(t *testing.T) {
+	zero, one, two, three := int32(0), int32(1), int32(2), int32(3)
+	tests := []struct {
+		name        string
+		minReplicas int32
+		allowZero   bool
+		spec        MemcachedSpec
+		wantError   string
+	}{
+		{name: "replicas below the floor", minReplicas: 2, spec: MemcachedSpec{Replicas: &one}, wantError: "spec.replicas"},
+		{name: "replicas at the floor", minReplicas: 2, spec: MemcachedSpec{Replicas: &two}},
+		{name: "replicas above the floor", minReplicas: 2, spec: MemcachedSpec{Replicas: &three}},
+		{name: "no floor configured", minReplicas: 0, spec: MemcachedSpec{Replicas: &one}},
+		{name: "zero replicas rejected by default", minReplicas: 2, spec: MemcachedSpec{Replicas: &zero}, wantError: "spec.replicas"},
+		{name: "zero replicas explicitly allowed", minReplicas: 2, allowZero: true, spec: MemcachedSpec{Replicas: &zero}},
+		{
+			name:        "allowing zero does not admit one",
+			minReplicas: 2,
+			allowZero:   true,
+			spec:        MemcachedSpec{Replicas: &one},
+			wantError:   "spec.replicas",
+		},
+		{
+			name:        "autoscaling minReplicas below the floor",
+			minReplicas: 2,
+			spec: MemcachedSpec{Autoscaling: &AutoscalingSpec{
+				Enabled: true, MinReplicas: &one, MaxReplicas: 5, Metrics: connectionsMetrics(),
+			}},
+			wantError: "spec.autoscaling.minReplicas",
+		},
+		{
+			name:        "autoscaling minReplicas unset counts as 1",
+			minReplicas: 2,
+			spec: MemcachedSpec{Autoscaling: &AutoscalingSpec{
+				Enabled: true, MaxReplicas: 5, Metrics: connectionsMetrics(),
+			}},
+			wantError: "spec.autoscaling.minReplicas",
+		},
+		{
+			name:        "autoscaling minReplicas at the floor",
+			minReplicas: 2,
+			spec: MemcachedSpec{Autoscaling: &AutoscalingSpec{
+				Enabled: true, MinReplicas: &two, MaxReplicas: 5, Metrics: connectionsMetrics(),
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := &MemcachedCustomValidator{Options: WebhookOptions{MinReplicas: tt.minReplicas, AllowZeroReplicas: tt.allowZero}}
+			_, err := v.ValidateCreate(context.Background(), &Memcached{Spec: tt.spec})
+			if tt.wantError == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantError) {
+				t.Fatalf("expected error naming %s, got: %v", tt.wantError, err)
+			}
+			if !strings.Contains(err.Error(), "minimum of 2 replicas") {
+				t.Errorf("expected error to cite the floor, got: %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateEphemeralStorage(t *testing.T) {
+	withStorage := &corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{corev1.ResourceEphemeralStorage: resource.MustParse("10Gi")},
+	}
+	memoryOnly := &corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("128Mi")},
+	}
+
+	tests := []struct {
+		name      string
+		extraArgs []string
+		resources *corev1.ResourceRequirements
+		wantError bool
+	}{
+		{name: "no extstore", extraArgs: []string{"-o", "modern"}, wantError: false},
+		{name: "extstore with request", extraArgs: []string{"-o", "ext_path=/data/extstore:8G"}, resources: withStorage, wantError: false},
+		{name: "extstore without resources", extraArgs: []string{"-o", "ext_path=/data/extstore:8G"}, wantError: true},
+		{name: "extstore without storage request", extraArgs: []string{"-o", "ext_path=/data/extstore:8G"}, resources: memoryOnly, wantError: true},
+		{name: "extstore in option list", extraArgs: []string{"-o", "modern,ext_path=/data/extstore:8G"}, wantError: true},
+		{name: "attached extstore option", extraArgs: []string{"-oext_path=/data/extstore:8G"}, wantError: true},
+		{name: "long extstore option", extraArgs: []string{"--extended=ext_path=/data/extstore:8G"}, wantError: true},
+	}
+
+	v := &MemcachedCustomValidator{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &Memcached{
+				Spec: MemcachedSpec{
+					Resources: tt.resources,
+					Memcached: &MemcachedConfig{ExtraArgs: tt.extraArgs},
+				},
+			}
+			_, err := v.ValidateCreate(context.Background(), mc)
+			if (err != nil) != tt.wantError {
+				t.Errorf("wantError=%v, got err=%v", tt.wantError, err)
+			}
+			if err != nil && !strings.Contains(err.Error(), "spec.resources.requests.ephemeral-storage") {
+				t.Errorf("expected error to name spec.resources.requests.ephemeral-storage, got: %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateExtraArgs_MessageNamesTypedField(t *testing.T) {
+	mc := &Memcached{
+		Spec: MemcachedSpec{
+			Memcached: &MemcachedConfig{ExtraArgs: []string{"-m", "128"}},
+		},
+	}
+
+	_, err := (&MemcachedCustomValidator{}).ValidateCreate(context.Background(), mc)
+	if err == nil {
+		t.Fatal("expected error for conflicting -m")
+	}
+	if !strings.Contains(err.Error(), "spec.memcached.maxMemoryMB") {
+		t.Errorf("expected error to name spec.memcached.maxMemoryMB, got: %v", err)
+	}
+	if !strings.Contains(err.Error(), "spec.memcached.extraArgs[0]") {
+		t.Errorf("expected error to point at spec.memcached.extraArgs[0], got: %v", err)
+	}
+}
+
+func TestValidateRollingUpdate(t *testing.T) {
+	i32 := func(v int32) *int32 { return &v }
+	tests := []struct {
+		name          string
+		rollingUpdate *RollingUpdateSpec
+		wantError     bool
+	}{
+		{name: "nil rollingUpdate", rollingUpdate: nil, wantError: false},
+		{name: "percentages only", rollingUpdate: &RollingUpdateSpec{MaxSurgePercent: i32(25), MaxUnavailablePercent: i32(10)}, wantError: false},
+		{name: "absolute only", rollingUpdate: &RollingUpdateSpec{MaxSurge: i32(2), MaxUnavailable: i32(1)}, wantError: false},
+		{name: "absolute surge with percent unavailable", rollingUpdate: &RollingUpdateSpec{MaxSurge: i32(2), MaxUnavailablePercent: i32(10)}, wantError: false},
+		{name: "maxSurge and maxSurgePercent", rollingUpdate: &RollingUpdateSpec{MaxSurge: i32(1), MaxSurgePercent: i32(25)}, wantError: true},
+		{name: "maxUnavailable and maxUnavailablePercent", rollingUpdate: &RollingUpdateSpec{MaxUnavailable: i32(1), MaxUnavailablePercent: i32(10)}, wantError: true},
+		{name: "zero surge with default unavailable", rollingUpdate: &RollingUpdateSpec{MaxSurge: i32(0)}, wantError: true},
+		{name: "zero surge percent with zero unavailable percent", rollingUpdate: &RollingUpdateSpec{MaxSurgePercent: i32(0), MaxUnavailablePercent: i32(0)}, wantError: true},
+		{name: "zero surge with nonzero unavailable", rollingUpdate: &RollingUpdateSpec{MaxSurge: i32(0), MaxUnavailable: i32(1)}, wantError: false},
+	}
+
+	v := &MemcachedCustomValidator{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &Memcached{Spec: MemcachedSpec{RollingUpdate: tt.rollingUpdate}}
+			_, err := v.ValidateCreate(context.Background(), mc)
+			if (err != nil) != tt.wantError {
+				t.Errorf("wantError=%v, got err=%v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestWarnTrafficDistribution(t *testing.T) {
+	preferClose := "PreferClose"
+	headless := ServiceTypeHeadless
+	clusterIP := ServiceTypeClusterIP
+	tests := []struct {
+		name        string
+		service     *ServiceSpec
+		wantWarning bool
+	}{
+		{name: "nil service", service: nil, wantWarning: false},
+		{name: "trafficDistribution unset", service: &ServiceSpec{}, wantWarning: false},
+		{name: "trafficDistribution on headless Service", service: &ServiceSpec{TrafficDistribution: &preferClose}, wantWarning: true},
+		{
+			name:        "trafficDistribution on explicit headless Service",
+			service:     &ServiceSpec{Type: &headless, TrafficDistribution: &preferClose},
+			wantWarning: true,
+		},
+		{
+			name:        "trafficDistribution on ClusterIP Service",
+			service:     &ServiceSpec{Type: &clusterIP, TrafficDistribution: &preferClose},
+			wantWarning: false,
+		},
+	}
+
+	v := &MemcachedCustomValidator{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &Memcached{Spec: MemcachedSpec{Service: tt.service}}
+			warnings, err := v.ValidateCreate(context.Background(), mc)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := len(warnings) > 0; got != tt.wantWarning {
+				t.Errorf("wantWarning=%v, got warnings=%v", tt.wantWarning, warnings)
+			}
+		})
+	}
+}
+
+func TestWarnTopologyAwareHints(t *testing.T) {
+	zoneSpread := &HighAvailabilitySpec{
+		TopologySpreadConstraints: []corev1.TopologySpreadConstraint{
+			{MaxSkew: 1, TopologyKey: corev1.LabelTopologyZone, WhenUnsatisfiable: corev1.ScheduleAnyway},
+		},
+	}
+	hostSpread := &HighAvailabilitySpec{
+		TopologySpreadConstraints: []corev1.TopologySpreadConstraint{
+			{MaxSkew: 1, TopologyKey: corev1.LabelHostname, WhenUnsatisfiable: corev1.ScheduleAnyway},
+		},
+	}
+	tests := []struct {
+		name        string
+		service     *ServiceSpec
+		ha          *HighAvailabilitySpec
+		wantWarning bool
+	}{
+		{name: "nil service", service: nil, ha: nil, wantWarning: false},
+		{name: "hints disabled", service: &ServiceSpec{}, ha: nil, wantWarning: false},
+		{name: "hints without spreading", service: &ServiceSpec{TopologyAwareHints: true}, ha: nil, wantWarning: true},
+		{name: "hints with host spreading only", service: &ServiceSpec{TopologyAwareHints: true}, ha: hostSpread, wantWarning: true},
+		{name: "hints with zone spreading", service: &ServiceSpec{TopologyAwareHints: true}, ha: zoneSpread, wantWarning: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &Memcached{Spec: MemcachedSpec{Service: tt.service, HighAvailability: tt.ha}}
+			warnings := warnTopologyAwareHints(mc)
+			if got := len(warnings) > 0; got != tt.wantWarning {
+				t.Errorf("wantWarning=%v, got warnings=%v", tt.wantWarning, warnings)
+			}
+		})
+	}
+}
+
+func TestWarnListenAddresses(t *testing.T) {
+	exporterAddr := "10.0.0.1:11211"
+	statsImage := "stats:1.0"
+	tests := []struct {
+		name         string
+		addresses    []string
+		monitoring   bool
+		exporterAddr *string
+		tls          bool
+		statsSidecar bool
+		wantWarnings int
+	}{
+		{name: "unset", addresses: nil, monitoring: true, wantWarnings: 0},
+		{name: "pod IP and loopback", addresses: []string{"127.0.0.1", PodIPToken}, monitoring: true, wantWarnings: 0},
+		{name: "wildcard", addresses: []string{"0.0.0.0"}, monitoring: true, wantWarnings: 0},
+		{name: "loopback only", addresses: []string{"127.0.0.1"}, monitoring: true, wantWarnings: 1},
+		{name: "loopback only with TLS", addresses: []string{"127.0.0.1"}, monitoring: true, tls: true, wantWarnings: 0},
+		{name: "pod IP only with monitoring", addresses: []string{PodIPToken}, monitoring: true, wantWarnings: 1},
+		{name: "pod IP only with exporter address", addresses: []string{PodIPToken}, monitoring: true, exporterAddr: &exporterAddr, wantWarnings: 0},
+		{name: "pod IP only without monitoring", addresses: []string{PodIPToken}, monitoring: false, wantWarnings: 0},
+		{name: "pod IP only with stats sidecar", addresses: []string{PodIPToken}, statsSidecar: true, wantWarnings: 1},
+		{name: "pod IP only with stats sidecar and exporter address", addresses: []string{PodIPToken}, statsSidecar: true, exporterAddr: &exporterAddr, wantWarnings: 0},
+	}
+
+	v := &MemcachedCustomValidator{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &Memcached{
+				Spec: MemcachedSpec{
+					Memcached: &MemcachedConfig{ListenAddresses: tt.addresses},
+					Monitoring: &MonitoringSpec{
+						Enabled:                  tt.monitoring,
+						ExporterMemcachedAddress: tt.exporterAddr,
+					},
+				},
+			}
+			if tt.tls {
+				mc.Spec.Security = &SecuritySpec{
+					TLS: &TLSSpec{Enabled: true, CertificateSecretRef: corev1.LocalObjectReference{Name: "tls"}},
+				}
+			}
+			if tt.statsSidecar {
+				mc.Spec.StatsSidecar = &StatsSidecarSpec{Enabled: true, Image: &statsImage}
+			}
+			warnings, err := v.ValidateCreate(context.Background(), mc)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(warnings) != tt.wantWarnings {
+				t.Errorf("got %d warnings %v, want %d", len(warnings), warnings, tt.wantWarnings)
+			}
+		})
+	}
+}
+
+func TestWarnReplicaSpreading(t *testing.T) {
+	one, three := int32(1), int32(3)
+	soft := AntiAffinityPresetSoft
+	spread := []corev1.TopologySpreadConstraint{{
+		MaxSkew:           1,
+		TopologyKey:       "topology.kubernetes.io/zone",
+		WhenUnsatisfiable: corev1.ScheduleAnyway,
+	}}
+	tests := []struct {
+		name        string
+		replicas    *int32
+		autoscaling *AutoscalingSpec
+		ha          *HighAvailabilitySpec
+		wantWarning bool
+	}{
+		{name: "replicas unset", wantWarning: false},
+		{name: "single replica", replicas: &one, wantWarning: false},
+		{name: "multiple replicas without spreading", replicas: &three, wantWarning: true},
+		{name: "multiple replicas with empty HA spec", replicas: &three, ha: &HighAvailabilitySpec{}, wantWarning: true},
+		{name: "multiple replicas with anti-affinity", replicas: &three, ha: &HighAvailabilitySpec{AntiAffinityPreset: &soft}, wantWarning: false},
+		{name: "multiple replicas with topology spread", replicas: &three, ha: &HighAvailabilitySpec{TopologySpreadConstraints: spread}, wantWarning: false},
+		{
+			name:        "autoscaling without spreading",
+			autoscaling: &AutoscalingSpec{Enabled: true, MaxReplicas: 5},
+			wantWarning: true,
+		},
+		{
+			name:        "autoscaling to one replica",
+			autoscaling: &AutoscalingSpec{Enabled: true, MaxReplicas: 1},
+			wantWarning: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &Memcached{Spec: MemcachedSpec{
+				Replicas:         tt.replicas,
+				Autoscaling:      tt.autoscaling,
+				HighAvailability: tt.ha,
+			}}
+			warnings := warnReplicaSpreading(mc)
+			if got := len(warnings) > 0; got != tt.wantWarning {
+				t.Errorf("wantWarning=%v, got warnings=%v", tt.wantWarning, warnings)
+			}
+		})
+	}
+}
+
+func TestWarnTopologyKeys(t *testing.T) {
+	tests := []struct {
+		name         string
+		keys         []string
+		wantWarnings int
+	}{
+		{name: "no constraints", wantWarnings: 0},
+		{
+			name:         "well-known keys",
+			keys:         []string{"kubernetes.io/hostname", "topology.kubernetes.io/zone", "topology.kubernetes.io/region"},
+			wantWarnings: 0,
+		},
+		{name: "custom key", keys: []string{"example.com/rack"}, wantWarnings: 1},
+		{
+			name:         "custom keys alongside a well-known key",
+			keys:         []string{"topology.kubernetes.io/zone", "example.com/rack", "failure-domain.beta.kubernetes.io/zone"},
+			wantWarnings: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ha := &HighAvailabilitySpec{}
+			for _, key := range tt.keys {
+				ha.TopologySpreadConstraints = append(ha.TopologySpreadConstraints, corev1.TopologySpreadConstraint{
+					MaxSkew:           1,
+					TopologyKey:       key,
+					WhenUnsatisfiable: corev1.ScheduleAnyway,
+				})
+			}
+			mc := &Memcached{Spec: MemcachedSpec{HighAvailability: ha}}
+
+			warnings := warnTopologyKeys(mc)
+			if len(warnings) != tt.wantWarnings {
+				t.Fatalf("got %d warnings, want %d: %v", len(warnings), tt.wantWarnings, warnings)
+			}
+			for _, w := range warnings {
+				if !strings.Contains(w, "topologyKey") {
+					t.Errorf("warning %q does not name the topologyKey", w)
+				}
+			}
+		})
+	}
+}
+
+func TestWarnSASLWithClientCert(t *testing.T) {
+	tests := []struct {
+		name        string
+		sasl        bool
+		tls         bool
+		clientCert  bool
+		wantWarning bool
+	}{
+		{name: "nothing enabled"},
+		{name: "SASL only", sasl: true},
+		{name: "TLS only", tls: true},
+		{name: "mTLS only", tls: true, clientCert: true},
+		{name: "SASL with TLS", sasl: true, tls: true},
+		{name: "SASL with client cert but TLS disabled", sasl: true, clientCert: true},
+		{name: "SASL with mTLS", sasl: true, tls: true, clientCert: true, wantWarning: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &Memcached{Spec: MemcachedSpec{
+				Security: &SecuritySpec{
+					SASL: &SASLSpec{Enabled: tt.sasl},
+					TLS:  &TLSSpec{Enabled: tt.tls, EnableClientCert: tt.clientCert},
+				},
+			}}
+			warnings := warnSASLWithClientCert(mc)
+			if got := len(warnings) > 0; got != tt.wantWarning {
+				t.Errorf("wantWarning=%v, got warnings=%v", tt.wantWarning, warnings)
+			}
+		})
+	}
+}
+
+func TestWarnExporterImage(t *testing.T) {
+	tests := []struct {
+		name          string
+		image         string
+		exporterImage string
+		wantWarning   bool
+	}{
+		{name: "distinct images", image: "memcached:1.6", exporterImage: DefaultExporterImage},
+		{name: "exporter image set to memcached image", image: "memcached:1.6", exporterImage: "memcached:1.6", wantWarning: true},
+		{name: "exporter image set to default memcached image", exporterImage: DefaultImage, wantWarning: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exporterImage := tt.exporterImage
+			mc := &Memcached{Spec: MemcachedSpec{
+				Monitoring: &MonitoringSpec{Enabled: true, ExporterImage: &exporterImage},
+			}}
+			if tt.image != "" {
+				image := tt.image
+				mc.Spec.Image = &image
+			}
+			warnings := warnExporterImage(mc)
+			if got := len(warnings) > 0; got != tt.wantWarning {
+				t.Errorf("wantWarning=%v, got warnings=%v", tt.wantWarning, warnings)
+			}
+		})
+	}
+
+	t.Run("monitoring unset", func(t *testing.T) {
+		if warnings := warnExporterImage(&Memcached{}); len(warnings) != 0 {
+			t.Errorf("expected no warnings, got %v", warnings)
+		}
+	})
+}
+
+func TestWarnPushGateway(t *testing.T) {
+	pushGateway := &PushGatewaySpec{URL: "http://pushgateway:9091"}
+	tests := []struct {
+		name        string
+		monitoring  *MonitoringSpec
+		wantWarning bool
+	}{
+		{name: "monitoring unset"},
+		{name: "no pushGateway", monitoring: &MonitoringSpec{Enabled: false}},
+		{name: "pushGateway with monitoring", monitoring: &MonitoringSpec{Enabled: true, PushGateway: pushGateway}},
+		{name: "pushGateway without monitoring", monitoring: &MonitoringSpec{PushGateway: pushGateway}, wantWarning: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			warnings := warnPushGateway(&Memcached{Spec: MemcachedSpec{Monitoring: tt.monitoring}})
+			if got := len(warnings) > 0; got != tt.wantWarning {
+				t.Errorf("wantWarning=%v, got warnings=%v", tt.wantWarning, warnings)
+			}
+		})
+	}
+}
+
+func TestWarnPreStopDelay(t *testing.T) {
+	tests := []struct {
+		name        string
+		gs          *GracefulShutdownSpec
+		wantWarning bool
+	}{
+		{name: "graceful shutdown unset", wantWarning: false},
+		{name: "graceful shutdown disabled", gs: &GracefulShutdownSpec{PreStopDelaySeconds: 1}, wantWarning: false},
+		{
+			name:        "delay shorter than readiness removal window",
+			gs:          &GracefulShutdownSpec{Enabled: true, PreStopDelaySeconds: 5, TerminationGracePeriodSeconds: 30},
+			wantWarning: true,
+		},
+		{
+			name:        "delay defaulted",
+			gs:          &GracefulShutdownSpec{Enabled: true},
+			wantWarning: false,
+		},
+		{
+			name:        "CRD defaults, delay equal to readiness removal window",
+			gs:          &GracefulShutdownSpec{Enabled: true, PreStopDelaySeconds: 15, TerminationGracePeriodSeconds: 30},
+			wantWarning: false,
+		},
+		{
+			name:        "delay longer than readiness removal window",
+			gs:          &GracefulShutdownSpec{Enabled: true, PreStopDelaySeconds: 20, TerminationGracePeriodSeconds: 30},
+			wantWarning: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &Memcached{Spec: MemcachedSpec{
+				HighAvailability: &HighAvailabilitySpec{GracefulShutdown: tt.gs},
+			}}
+			warnings := warnPreStopDelay(mc)
+			if got := len(warnings) > 0; got != tt.wantWarning {
+				t.Errorf("wantWarning=%v, got warnings=%v", tt.wantWarning, warnings)
+			}
+		})
+	}
+}
+
+func TestValidateStatsSidecar(t *testing.T) {
+	image := "example.com/memcached-stats:1.0"
+	port := func(p int32) *int32 { return &p }
+	tests := []struct {
+		name       string
+		sidecar    *StatsSidecarSpec
+		monitoring bool
+		tls        bool
+		wantErrs   int
+	}{
+		{name: "nil", wantErrs: 0},
+		{name: "disabled without image", sidecar: &StatsSidecarSpec{}, wantErrs: 0},
+		{name: "enabled with image", sidecar: &StatsSidecarSpec{Enabled: true, Image: &image}, wantErrs: 0},
+		{name: "enabled without image", sidecar: &StatsSidecarSpec{Enabled: true}, wantErrs: 1},
+		{name: "memcached port", sidecar: &StatsSidecarSpec{Enabled: true, Image: &image, Port: port(11211)}, wantErrs: 1},
+		{name: "TLS port", sidecar: &StatsSidecarSpec{Enabled: true, Image: &image, Port: port(DefaultTLSPort)}, tls: true, wantErrs: 1},
+		{name: "metrics port with monitoring", sidecar: &StatsSidecarSpec{Enabled: true, Image: &image, Port: port(9150)}, monitoring: true, wantErrs: 1},
+		{name: "metrics port without monitoring", sidecar: &StatsSidecarSpec{Enabled: true, Image: &image, Port: port(9150)}, wantErrs: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &Memcached{Spec: MemcachedSpec{
+				StatsSidecar: tt.sidecar,
+				Monitoring:   &MonitoringSpec{Enabled: tt.monitoring},
+				Security: &SecuritySpec{
+					TLS: &TLSSpec{Enabled: tt.tls, CertificateSecretRef: corev1.LocalObjectReference{Name: "tls"}},
+				},
+			}}
+			if errs := validateStatsSidecar(mc); len(errs) != tt.wantErrs {
+				t.Errorf("got %d errors (%v), want %d", len(errs), errs, tt.wantErrs)
+			}
+		})
+	}
+}
+
+func TestValidateCanary(t *testing.T) {
+	image := "memcached:1.6.38"
+	tests := []struct {
+		name     string
+		canary   *CanarySpec
+		wantErrs int
+	}{
+		{name: "nil", wantErrs: 0},
+		{name: "disabled without image", canary: &CanarySpec{}, wantErrs: 0},
+		{name: "enabled with image", canary: &CanarySpec{Enabled: true, Image: &image}, wantErrs: 0},
+		{name: "enabled without image", canary: &CanarySpec{Enabled: true}, wantErrs: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &Memcached{Spec: MemcachedSpec{Canary: tt.canary}}
+			if errs := validateCanary(mc); len(errs) != tt.wantErrs {
+				t.Errorf("got %d errors (%v), want %d", len(errs), errs, tt.wantErrs)
+			}
+		})
+	}
+}
+
+func TestValidateMaintenanceWindow(t *testing.T) {
+	tests := []struct {
+		name     string
+		window   *MaintenanceWindowSpec
+		wantErrs int
+	}{
+		{name: "nil", wantErrs: 0},
+		{name: "weekly", window: &MaintenanceWindowSpec{Schedule: "0 2 * * 6", DurationMinutes: 120}, wantErrs: 0},
+		{name: "every six hours", window: &MaintenanceWindowSpec{Schedule: "30 */6 * * *", DurationMinutes: 30}, wantErrs: 0},
+		{name: "wrong field count", window: &MaintenanceWindowSpec{Schedule: "0 2 * *", DurationMinutes: 60}, wantErrs: 1},
+		{name: "out of range", window: &MaintenanceWindowSpec{Schedule: "0 24 * * *", DurationMinutes: 60}, wantErrs: 1},
+		{name: "descriptor", window: &MaintenanceWindowSpec{Schedule: "@weekly", DurationMinutes: 60}, wantErrs: 1},
+		{name: "never fires", window: &MaintenanceWindowSpec{Schedule: "0 0 31 4 *", DurationMinutes: 60}, wantErrs: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &Memcached{Spec: MemcachedSpec{MaintenanceWindow: tt.window}}
+			if errs := validateMaintenanceWindow(mc); len(errs) != tt.wantErrs {
+				t.Errorf("got %d errors (%v), want %d", len(errs), errs, tt.wantErrs)
+			}
+		})
+	}
+}
+
+func TestWarnTLSConnections(t *testing.T) {
+	tests := []struct {
+		name           string
+		tls            bool
+		maxConnections int32
+		memLimit       string
+		wantWarning    bool
+	}{
+		{name: "high connections with TLS and small memory limit", tls: true, maxConnections: 20000, memLimit: "256Mi", wantWarning: true},
+		{name: "high connections with TLS and ample memory limit", tls: true, maxConnections: 20000, memLimit: "1Gi", wantWarning: false},
+		{name: "high connections without TLS", maxConnections: 20000, memLimit: "256Mi", wantWarning: false},
+		{name: "default connections with TLS", tls: true, maxConnections: 1024, memLimit: "128Mi", wantWarning: false},
+		{name: "high connections with TLS and no memory limit", tls: true, maxConnections: 20000, wantWarning: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &Memcached{Spec: MemcachedSpec{
+				Memcached: &MemcachedConfig{MaxMemoryMB: 64, MaxConnections: tt.maxConnections},
+				Resources: &corev1.ResourceRequirements{},
+			}}
+			if tt.tls {
+				mc.Spec.Security = &SecuritySpec{TLS: &TLSSpec{
+					Enabled:              true,
+					CertificateSecretRef: corev1.LocalObjectReference{Name: "mc-tls"},
+				}}
+			}
+			if tt.memLimit != "" {
+				mc.Spec.Resources.Limits = corev1.ResourceList{corev1.ResourceMemory: resource.MustParse(tt.memLimit)}
+			}
+			warnings := warnTLSConnections(mc)
+			if got := len(warnings) > 0; got != tt.wantWarning {
+				t.Errorf("wantWarning=%v, got warnings=%v", tt.wantWarning, warnings)
+			}
+		})
+	}
+}
+
+func TestWarnThreadsPerCPU(t *testing.T) {
+	tests := []struct {
+		name        string
+		threads     int32
+		cpuLimit    string
+		wantWarning bool
+	}{
+		{name: "no CPU limit", threads: 128, wantWarning: false},
+		{name: "over-threaded small CPU", threads: 128, cpuLimit: "100m", wantWarning: true},
+		{name: "small CPU rounds up to one core", threads: 4, cpuLimit: "100m", wantWarning: false},
+		{name: "aligned", threads: 8, cpuLimit: "2", wantWarning: false},
+		{name: "just over bound", threads: 9, cpuLimit: "2", wantWarning: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &Memcached{Spec: MemcachedSpec{
+				Memcached: &MemcachedConfig{Threads: tt.threads},
+				Resources: &corev1.ResourceRequirements{},
+			}}
+			if tt.cpuLimit != "" {
+				mc.Spec.Resources.Limits = corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(tt.cpuLimit)}
+			}
+			warnings := warnThreadsPerCPU(mc)
+			if got := len(warnings) > 0; got != tt.wantWarning {
+				t.Errorf("wantWarning=%v, got warnings=%v", tt.wantWarning, warnings)
+			}
+		})
+	}
+}
@@ -28,24 +28,72 @@ const (
 	DefaultServiceMonitorScrapeTimeout   = "10s"
 	DefaultAutoscalingCPUUtilization     = int32(80)
 	DefaultScaleDownStabilizationSeconds = int32(300)
+	DefaultTLSPort                       = int32(11212)
+	DefaultFSGroup                       = int64(1000)
+	DefaultStatsSidecarPort              = int32(8080)
+	DefaultRevisionHistoryLimit          = int32(10)
+	DefaultUnixSocketPath                = "/var/run/memcached/memcached.sock"
+	DefaultPushGatewayIntervalSeconds    = int32(30)
+	DefaultCanaryReplicas                = int32(1)
 )
 
+// PodIPToken is the placeholder in spec.memcached.listenAddresses that expands to
+// the Pod IP through a downward API environment variable.
+const PodIPToken = "$(POD_IP)"
+
 // log is for logging in this package.
 var memcachedlog = logf.Log.WithName("memcached-resource")
 
 // MemcachedCustomDefaulter applies defaults to Memcached resources.
-type MemcachedCustomDefaulter struct{}
+type MemcachedCustomDefaulter struct {
+	Options WebhookOptions
+}
 
 // Compile-time interface check.
 var _ admission.Defaulter[*Memcached] = &MemcachedCustomDefaulter{}
 
 // +kubebuilder:webhook:path=/mutate-memcached-c5c3-io-v1beta1-memcached,mutating=true,failurePolicy=fail,sideEffects=None,groups=memcached.c5c3.io,resources=memcacheds,verbs=create;update,versions=v1beta1,name=mmemcached-v1beta1.kb.io,admissionReviewVersions=v1
 
+// WebhookOptions holds operator configuration consumed by the Memcached webhooks.
+// The zero value applies the built-in behavior.
+type WebhookOptions struct {
+	// AllowUnsafeSysctls accepts spec.security.sysctls outside the Kubernetes safe set.
+	AllowUnsafeSysctls bool
+
+	// MaxReplicas caps spec.replicas and spec.autoscaling.maxReplicas below the
+	// CRD maximum. Zero leaves only the CRD bound in place.
+	MaxReplicas int32
+
+	// MinReplicas is a floor for spec.replicas and spec.autoscaling.minReplicas,
+	// guarding against accidental scale-downs. Zero disables the floor.
+	MinReplicas int32
+
+	// AllowZeroReplicas exempts spec.replicas=0 from MinReplicas, so instances
+	// can still be scaled to zero on purpose.
+	AllowZeroReplicas bool
+
+	// DefaultThreads replaces DefaultThreads for spec.memcached.threads when
+	// nonzero. Per-CR values always win.
+	DefaultThreads int32
+
+	// DefaultMaxItemSize replaces DefaultMaxItemSize for
+	// spec.memcached.maxItemSize when non-empty. Per-CR values always win.
+	DefaultMaxItemSize string
+
+	// DefaultExtraArgs is applied to spec.memcached.extraArgs when the field is
+	// empty. Per-CR values replace the list rather than extend it.
+	DefaultExtraArgs []string
+
+	// TLSCopySourceNamespaces lists the namespaces spec.security.tls.copyFromNamespace
+	// may name. Empty disables copying TLS Secrets across namespaces.
+	TLSCopySourceNamespaces []string
+}
+
 // SetupMemcachedWebhookWithManager registers the defaulting and validation webhooks with the manager.
-func SetupMemcachedWebhookWithManager(mgr ctrl.Manager) error {
+func SetupMemcachedWebhookWithManager(mgr ctrl.Manager, opts WebhookOptions) error {
 	return ctrl.NewWebhookManagedBy(mgr, &Memcached{}).
-		WithDefaulter(&MemcachedCustomDefaulter{}).
-		WithValidator(&MemcachedCustomValidator{}).
+		WithDefaulter(&MemcachedCustomDefaulter{Options: opts}).
+		WithValidator(&MemcachedCustomValidator{Options: opts}).
 		Complete()
 }
 
@@ -53,11 +101,12 @@ func SetupMemcachedWebhookWithManager(mgr ctrl.Manager) error {
 func (d *MemcachedCustomDefaulter) Default(ctx context.Context, mc *Memcached) error {
 	memcachedlog.Info("defaulting", "name", mc.GetName())
 
-	// REQ-001: Default replicas to 1 when nil, unless autoscaling is enabled
-	// (spec.replicas and autoscaling.enabled are mutually exclusive).
+	// REQ-001: Default replicas to 1, or to the --min-replicas floor when higher,
+	// when nil, unless autoscaling is enabled (spec.replicas and
+	// autoscaling.enabled are mutually exclusive).
 	autoscalingEnabled := mc.Spec.Autoscaling != nil && mc.Spec.Autoscaling.Enabled
 	if mc.Spec.Replicas == nil && !autoscalingEnabled {
-		defaultReplicas := DefaultReplicas
+		defaultReplicas := max(DefaultReplicas, d.Options.MinReplicas)
 		mc.Spec.Replicas = &defaultReplicas
 	}
 
@@ -67,8 +116,16 @@ func (d *MemcachedCustomDefaulter) Default(ctx context.Context, mc *Memcached) e
 		mc.Spec.Image = &defaultImage
 	}
 
-	defaultMemcachedConfig(mc)
+	// v1beta1-only fields are absent from objects converted from v1alpha1 and
+	// must be defaulted here.
+	if mc.Spec.RevisionHistoryLimit == nil {
+		defaultRevisionHistoryLimit := DefaultRevisionHistoryLimit
+		mc.Spec.RevisionHistoryLimit = &defaultRevisionHistoryLimit
+	}
+
+	defaultMemcachedConfig(mc, d.Options)
 	defaultMonitoring(mc)
+	defaultFSGroup(mc)
 
 	// REQ-005: Default highAvailability sub-fields only when the HA section already exists.
 	if mc.Spec.HighAvailability != nil {
@@ -79,7 +136,7 @@ func (d *MemcachedCustomDefaulter) Default(ctx context.Context, mc *Memcached) e
 	}
 
 	if autoscalingEnabled {
-		defaultAutoscaling(mc)
+		defaultAutoscaling(mc, d.Options.MinReplicas)
 	}
 
 	return nil
@@ -87,7 +144,8 @@ func (d *MemcachedCustomDefaulter) Default(ctx context.Context, mc *Memcached) e
 
 // defaultMemcachedConfig initializes the memcached section and populates zero-valued fields.
 // The memcached section is always initialized because its fields are core operational parameters.
-func defaultMemcachedConfig(mc *Memcached) {
+// Operator-level defaults from opts take precedence over the built-in constants.
+func defaultMemcachedConfig(mc *Memcached, opts WebhookOptions) {
 	if mc.Spec.Memcached == nil {
 		mc.Spec.Memcached = &MemcachedConfig{}
 	}
@@ -99,9 +157,18 @@ func defaultMemcachedConfig(mc *Memcached) {
 	}
 	if mc.Spec.Memcached.Threads == 0 {
 		mc.Spec.Memcached.Threads = DefaultThreads
+		if opts.DefaultThreads != 0 {
+			mc.Spec.Memcached.Threads = opts.DefaultThreads
+		}
 	}
 	if mc.Spec.Memcached.MaxItemSize == "" {
 		mc.Spec.Memcached.MaxItemSize = DefaultMaxItemSize
+		if opts.DefaultMaxItemSize != "" {
+			mc.Spec.Memcached.MaxItemSize = opts.DefaultMaxItemSize
+		}
+	}
+	if len(mc.Spec.Memcached.ExtraArgs) == 0 && len(opts.DefaultExtraArgs) > 0 {
+		mc.Spec.Memcached.ExtraArgs = append([]string(nil), opts.DefaultExtraArgs...)
 	}
 	// Verbosity defaults to 0, which is the Go zero value — no action needed.
 }
@@ -125,9 +192,47 @@ func defaultMonitoring(mc *Memcached) {
 	}
 }
 
+// defaultFSGroup sets podSecurityContext.fsGroup when SASL or TLS Secrets are mounted
+// into a non-root pod, so the mounted files are group-readable by the memcached user.
+// It defaults to the configured runAsUser (pod-level first, then container-level),
+// or DefaultFSGroup when neither is set. A user-provided fsGroup is never overridden.
+func defaultFSGroup(mc *Memcached) {
+	if !mc.IsSASLEnabled() && !mc.IsTLSEnabled() {
+		return
+	}
+
+	sec := mc.Spec.Security
+	podSC := sec.PodSecurityContext
+	containerSC := sec.ContainerSecurityContext
+
+	runAsNonRoot := (podSC != nil && podSC.RunAsNonRoot != nil && *podSC.RunAsNonRoot) ||
+		(containerSC != nil && containerSC.RunAsNonRoot != nil && *containerSC.RunAsNonRoot)
+	if !runAsNonRoot || (podSC != nil && podSC.FSGroup != nil) {
+		return
+	}
+
+	fsGroup := DefaultFSGroup
+	switch {
+	case podSC != nil && podSC.RunAsUser != nil:
+		fsGroup = *podSC.RunAsUser
+	case containerSC != nil && containerSC.RunAsUser != nil:
+		fsGroup = *containerSC.RunAsUser
+	}
+
+	if podSC == nil {
+		sec.PodSecurityContext = &corev1.PodSecurityContext{}
+	}
+	sec.PodSecurityContext.FSGroup = &fsGroup
+}
+
 // defaultAutoscaling sets defaults for autoscaling sub-fields.
 // Must only be called when autoscaling is enabled.
-func defaultAutoscaling(mc *Memcached) {
+func defaultAutoscaling(mc *Memcached, minReplicas int32) {
+	// Raise the HPA's implicit minimum of 1 to the --min-replicas floor.
+	if mc.Spec.Autoscaling.MinReplicas == nil && minReplicas > 1 {
+		mc.Spec.Autoscaling.MinReplicas = &minReplicas
+	}
+
 	// Inject 80% CPU utilization metric when Metrics is empty.
 	if len(mc.Spec.Autoscaling.Metrics) == 0 {
 		cpuUtilization := DefaultAutoscalingCPUUtilization
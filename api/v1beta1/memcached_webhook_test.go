@@ -3,6 +3,7 @@ package v1beta1
 
 import (
 	"context"
+	"slices"
 	"testing"
 
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
@@ -952,7 +953,7 @@ func TestDefaultThenValidate_AutoscalingEnabled(t *testing.T) {
 		t.Fatalf("defaulting error: %v", err)
 	}
 
-	if err := validateMemcached(mc); err != nil {
+	if err := validateMemcached(mc, WebhookOptions{}); err != nil {
 		t.Errorf("expected no validation error after defaulting autoscaling CR, got: %v", err)
 	}
 }
@@ -984,7 +985,7 @@ func TestDefaultThenValidate_AutoscalingWithExplicitReplicas(t *testing.T) {
 		t.Errorf("expected replicas to be preserved (3), got %v", mc.Spec.Replicas)
 	}
 
-	if err := validateMemcached(mc); err == nil {
+	if err := validateMemcached(mc, WebhookOptions{}); err == nil {
 		t.Error("expected validation error for replicas + autoscaling conflict, got nil")
 	}
 }
@@ -1002,7 +1003,213 @@ func TestDefaultThenValidate_NoAutoscaling(t *testing.T) {
 		t.Errorf("expected replicas=1, got %v", mc.Spec.Replicas)
 	}
 
-	if err := validateMemcached(mc); err != nil {
+	if err := validateMemcached(mc, WebhookOptions{}); err != nil {
 		t.Errorf("expected no validation error after defaulting minimal CR, got: %v", err)
 	}
 }
+
+func TestDefaultThenValidate_MinReplicasFloor(t *testing.T) {
+	opts := WebhookOptions{MinReplicas: 2}
+
+	t.Run("replicas omitted", func(t *testing.T) {
+		mc := &Memcached{}
+		d := &MemcachedCustomDefaulter{Options: opts}
+		if err := d.Default(context.Background(), mc); err != nil {
+			t.Fatalf("defaulting error: %v", err)
+		}
+		if mc.Spec.Replicas == nil || *mc.Spec.Replicas != 2 {
+			t.Errorf("expected replicas=2, got %v", mc.Spec.Replicas)
+		}
+		if err := validateMemcached(mc, opts); err != nil {
+			t.Errorf("expected CR without replicas to be admitted, got: %v", err)
+		}
+	})
+
+	t.Run("autoscaling minReplicas omitted", func(t *testing.T) {
+		mc := &Memcached{Spec: MemcachedSpec{
+			Autoscaling: &AutoscalingSpec{Enabled: true, MaxReplicas: 5},
+			Resources: &corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+			},
+		}}
+		d := &MemcachedCustomDefaulter{Options: opts}
+		if err := d.Default(context.Background(), mc); err != nil {
+			t.Fatalf("defaulting error: %v", err)
+		}
+		if mc.Spec.Autoscaling.MinReplicas == nil || *mc.Spec.Autoscaling.MinReplicas != 2 {
+			t.Errorf("expected autoscaling.minReplicas=2, got %v", mc.Spec.Autoscaling.MinReplicas)
+		}
+		if err := validateMemcached(mc, opts); err != nil {
+			t.Errorf("expected CR without autoscaling.minReplicas to be admitted, got: %v", err)
+		}
+	})
+}
+
+func TestMemcachedDefaulting_FSGroup(t *testing.T) {
+	i64 := func(v int64) *int64 { return &v }
+	boolTrue := true
+
+	tests := []struct {
+		name        string
+		sasl        bool
+		tls         bool
+		podSC       *corev1.PodSecurityContext
+		containerSC *corev1.SecurityContext
+		want        *int64
+	}{
+		{
+			name:  "TLS with pod runAsNonRoot and runAsUser",
+			tls:   true,
+			podSC: &corev1.PodSecurityContext{RunAsNonRoot: &boolTrue, RunAsUser: i64(11211)},
+			want:  i64(11211),
+		},
+		{
+			name:        "SASL with container runAsNonRoot and runAsUser",
+			sasl:        true,
+			containerSC: &corev1.SecurityContext{RunAsNonRoot: &boolTrue, RunAsUser: i64(2000)},
+			want:        i64(2000),
+		},
+		{
+			name:  "SASL with runAsNonRoot and no runAsUser",
+			sasl:  true,
+			podSC: &corev1.PodSecurityContext{RunAsNonRoot: &boolTrue},
+			want:  i64(DefaultFSGroup),
+		},
+		{
+			name:  "user-provided fsGroup is not overridden",
+			tls:   true,
+			podSC: &corev1.PodSecurityContext{RunAsNonRoot: &boolTrue, RunAsUser: i64(11211), FSGroup: i64(3000)},
+			want:  i64(3000),
+		},
+		{
+			name:  "no secrets mounted",
+			podSC: &corev1.PodSecurityContext{RunAsNonRoot: &boolTrue},
+			want:  nil,
+		},
+		{
+			name:  "runAsNonRoot not set",
+			tls:   true,
+			podSC: &corev1.PodSecurityContext{RunAsUser: i64(11211)},
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &Memcached{
+				Spec: MemcachedSpec{
+					Security: &SecuritySpec{
+						PodSecurityContext:       tt.podSC,
+						ContainerSecurityContext: tt.containerSC,
+						SASL:                     &SASLSpec{Enabled: tt.sasl},
+						TLS:                      &TLSSpec{Enabled: tt.tls},
+					},
+				},
+			}
+			d := &MemcachedCustomDefaulter{}
+
+			if err := d.Default(context.Background(), mc); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var got *int64
+			if psc := mc.Spec.Security.PodSecurityContext; psc != nil {
+				got = psc.FSGroup
+			}
+			switch {
+			case tt.want == nil && got != nil:
+				t.Errorf("fsGroup = %d, want nil", *got)
+			case tt.want != nil && got == nil:
+				t.Errorf("fsGroup = nil, want %d", *tt.want)
+			case tt.want != nil && *got != *tt.want:
+				t.Errorf("fsGroup = %d, want %d", *got, *tt.want)
+			}
+		})
+	}
+}
+
+func TestMemcachedDefaulting_OperatorDefaults(t *testing.T) {
+	opts := WebhookOptions{
+		DefaultThreads:     16,
+		DefaultMaxItemSize: "4m",
+		DefaultExtraArgs:   []string{"-o", "modern"},
+	}
+
+	tests := []struct {
+		name          string
+		opts          WebhookOptions
+		config        *MemcachedConfig
+		wantThreads   int32
+		wantItemSize  string
+		wantExtraArgs []string
+	}{
+		{
+			name:         "flags off use built-in defaults",
+			wantThreads:  DefaultThreads,
+			wantItemSize: DefaultMaxItemSize,
+		},
+		{
+			name:          "flags apply to omitted memcached section",
+			opts:          opts,
+			wantThreads:   16,
+			wantItemSize:  "4m",
+			wantExtraArgs: []string{"-o", "modern"},
+		},
+		{
+			name:          "flags apply to unset fields",
+			opts:          opts,
+			config:        &MemcachedConfig{MaxMemoryMB: 128},
+			wantThreads:   16,
+			wantItemSize:  "4m",
+			wantExtraArgs: []string{"-o", "modern"},
+		},
+		{
+			name: "per-CR values win",
+			opts: opts,
+			config: &MemcachedConfig{
+				Threads:     2,
+				MaxItemSize: "2m",
+				ExtraArgs:   []string{"-R", "40"},
+			},
+			wantThreads:   2,
+			wantItemSize:  "2m",
+			wantExtraArgs: []string{"-R", "40"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &Memcached{Spec: MemcachedSpec{Memcached: tt.config}}
+			d := &MemcachedCustomDefaulter{Options: tt.opts}
+
+			if err := d.Default(context.Background(), mc); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got := mc.Spec.Memcached.Threads; got != tt.wantThreads {
+				t.Errorf("threads = %d, want %d", got, tt.wantThreads)
+			}
+			if got := mc.Spec.Memcached.MaxItemSize; got != tt.wantItemSize {
+				t.Errorf("maxItemSize = %q, want %q", got, tt.wantItemSize)
+			}
+			if got := mc.Spec.Memcached.ExtraArgs; !slices.Equal(got, tt.wantExtraArgs) {
+				t.Errorf("extraArgs = %v, want %v", got, tt.wantExtraArgs)
+			}
+		})
+	}
+}
+
+func TestMemcachedDefaulting_OperatorExtraArgsNotAliased(t *testing.T) {
+	opts := WebhookOptions{DefaultExtraArgs: []string{"-o", "modern"}}
+	d := &MemcachedCustomDefaulter{Options: opts}
+	mc := &Memcached{}
+
+	if err := d.Default(context.Background(), mc); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	mc.Spec.Memcached.ExtraArgs[0] = "-v"
+
+	if opts.DefaultExtraArgs[0] != "-o" {
+		t.Errorf("defaulting aliased operator extraArgs: %v", opts.DefaultExtraArgs)
+	}
+}
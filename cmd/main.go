@@ -3,19 +3,27 @@ package main
 
 import (
 	"crypto/tls"
+	"errors"
 	"flag"
+	"fmt"
 	"os"
+	"regexp"
 	"sort"
 	"strings"
+	"time"
 
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
@@ -40,17 +48,39 @@ func init() {
 	utilruntime.Must(monitoringv1.AddToScheme(scheme))
 }
 
-// buildWebhookServer returns a configured webhook server when enabled is true,
-// or nil when false. Passing nil to ctrl.Options.WebhookServer disables the
-// webhook listener, preventing TLS certificate loading errors in environments
+// defaultWebhookPort is the default port the webhook server listens on.
+const defaultWebhookPort = 9443
+
+// buildWebhookOptions returns the webhook server options for the given port and
+// certificate directory. An empty certDir keeps the controller-runtime default
+// (<temp-dir>/k8s-webhook-server/serving-certs).
+func buildWebhookOptions(port int, certDir string, tlsOpts []func(*tls.Config)) webhook.Options {
+	return webhook.Options{
+		Port:    port,
+		CertDir: certDir,
+		TLSOpts: tlsOpts,
+	}
+}
+
+// buildWebhookServer returns a webhook server configured with opts when enabled
+// is true, or nil when false. Passing nil to ctrl.Options.WebhookServer disables
+// the webhook listener, preventing TLS certificate loading errors in environments
 // where cert-manager is not available (MO-0055).
-func buildWebhookServer(enabled bool, tlsOpts []func(*tls.Config)) webhook.Server {
+func buildWebhookServer(enabled bool, opts webhook.Options) webhook.Server {
 	if !enabled {
 		return nil
 	}
-	return webhook.NewServer(webhook.Options{
-		TLSOpts: tlsOpts,
-	})
+	return webhook.NewServer(opts)
+}
+
+// setupWebhooks registers the Memcached defaulting and validation webhooks with
+// mgr when enabled is true. When false, nothing is registered, so the manager
+// never adds its webhook server as a runnable and needs no serving certificate.
+func setupWebhooks(mgr ctrl.Manager, enabled bool, opts memcachedv1beta1.WebhookOptions) error {
+	if !enabled {
+		return nil
+	}
+	return memcachedv1beta1.SetupMemcachedWebhookWithManager(mgr, opts)
 }
 
 // parseWatchNamespaces splits a comma-separated namespace string into a
@@ -72,6 +102,104 @@ func parseWatchNamespaces(namespaces string) map[string]cache.Config {
 	return result
 }
 
+// resolveWatchNamespaces returns the namespaces the manager cache is restricted
+// to. With watchOwnNamespace, the operator watches only the namespace it runs in,
+// read from the POD_NAMESPACE environment variable (set via the downward API),
+// and watchNamespaces is ignored. Otherwise watchNamespaces is parsed as by
+// parseWatchNamespaces.
+func resolveWatchNamespaces(watchNamespaces string, watchOwnNamespace bool, getenv func(string) string) (map[string]cache.Config, error) {
+	if !watchOwnNamespace {
+		return parseWatchNamespaces(watchNamespaces), nil
+	}
+	ns := strings.TrimSpace(getenv("POD_NAMESPACE"))
+	if ns == "" {
+		return nil, errors.New("--watch-own-namespace requires the POD_NAMESPACE environment variable")
+	}
+	return map[string]cache.Config{ns: {}}, nil
+}
+
+// parseAnnotationAllowlist splits a comma-separated list of annotation key
+// prefixes, dropping empty entries. It returns nil when the input is empty.
+func parseAnnotationAllowlist(prefixes string) []string {
+	return splitCommaList(prefixes)
+}
+
+// parseTLSCopySourceNamespaces splits the comma-separated namespaces TLS Secrets
+// may be copied from, dropping empty entries. It returns nil when the input is
+// empty, which disables copying.
+func parseTLSCopySourceNamespaces(namespaces string) []string {
+	return splitCommaList(namespaces)
+}
+
+// splitCommaList splits s on commas, trimming whitespace and dropping empty
+// entries. It returns nil when no entries remain.
+func splitCommaList(s string) []string {
+	var result []string
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry != "" {
+			result = append(result, entry)
+		}
+	}
+	return result
+}
+
+// parseNamespaceLabelSelector parses a label selector expression used to decide
+// which namespaces the operator reconciles. It returns a nil selector when the
+// input is empty or whitespace-only, which means every namespace is selected.
+func parseNamespaceLabelSelector(selector string) (labels.Selector, error) {
+	selector = strings.TrimSpace(selector)
+	if selector == "" {
+		return nil, nil
+	}
+	return labels.Parse(selector)
+}
+
+// defaultResyncPeriod is the default --resync-period, matching controller-runtime's
+// own default informer resync period.
+const defaultResyncPeriod = 10 * time.Hour
+
+// buildCacheOptions returns the manager cache options for the watched namespaces
+// and informer resync period. A zero resyncPeriod disables periodic resyncs; a
+// negative one is rejected. Pods and EndpointSlices are only cached when they carry
+// the operator's managed-by label, so the cache does not hold every Pod and
+// EndpointSlice in the watched namespaces.
+func buildCacheOptions(nsMap map[string]cache.Config, resyncPeriod time.Duration) (cache.Options, error) {
+	if resyncPeriod < 0 {
+		return cache.Options{}, fmt.Errorf("--resync-period must not be negative, got %s", resyncPeriod)
+	}
+	managed := cache.ByObject{
+		Label: labels.SelectorFromSet(labels.Set{controller.LabelManagedBy: controller.ManagedByOperator}),
+	}
+	return cache.Options{
+		DefaultNamespaces: nsMap,
+		SyncPeriod:        &resyncPeriod,
+		ByObject: map[client.Object]cache.ByObject{
+			&corev1.Pod{}:                managed,
+			&discoveryv1.EndpointSlice{}: managed,
+		},
+	}, nil
+}
+
+// maxItemSizePattern mirrors the CRD validation of spec.memcached.maxItemSize.
+var maxItemSizePattern = regexp.MustCompile(`^[0-9]+(k|m)$`)
+
+// validateMemcachedDefaults checks the operator-level memcached defaults against
+// the same bounds the CRD and the validating webhook enforce on the corresponding
+// spec fields. Zero and empty values keep the built-in defaults and are always accepted.
+func validateMemcachedDefaults(threads int, maxItemSize string, extraArgs []string) error {
+	if threads < 0 || threads > 128 {
+		return fmt.Errorf("--default-threads must be between 0 and 128, got %d", threads)
+	}
+	if maxItemSize != "" && !maxItemSizePattern.MatchString(maxItemSize) {
+		return fmt.Errorf("--default-max-item-size must match %s, got %q", maxItemSizePattern, maxItemSize)
+	}
+	if err := memcachedv1beta1.ValidateExtraArgs(extraArgs); err != nil {
+		return fmt.Errorf("--default-extra-args: %w", err)
+	}
+	return nil
+}
+
 func main() {
 	var metricsAddr string
 	var enableLeaderElection bool
@@ -79,7 +207,23 @@ func main() {
 	var secureMetrics bool
 	var enableHTTP2 bool
 	var enableWebhooks bool
+	var webhookPort int
+	var webhookCertDir string
 	var watchNamespaces string
+	var watchOwnNamespace bool
+	var namespaceLabelSelector string
+	var reconcileTimeout time.Duration
+	var resyncPeriod time.Duration
+	var pruneUnmanagedAnnotations bool
+	var annotationAllowlist string
+	var allowUnsafeSysctls bool
+	var maxReplicas int
+	var minReplicas int
+	var allowZeroReplicas bool
+	var defaultThreads int
+	var defaultMaxItemSize string
+	var defaultExtraArgs string
+	var tlsCopySourceNamespaces string
 	var tlsOpts []func(*tls.Config)
 
 	flag.StringVar(&metricsAddr, "metrics-bind-address", "0", "The address the metrics endpoint binds to. Use :8443 for HTTPS or :8080 for HTTP.")
@@ -88,7 +232,41 @@ func main() {
 	flag.BoolVar(&secureMetrics, "metrics-secure", true, "If set, the metrics endpoint is served securely via HTTPS.")
 	flag.BoolVar(&enableHTTP2, "enable-http2", false, "If set, HTTP/2 will be enabled for the metrics and webhook servers.")
 	flag.BoolVar(&enableWebhooks, "enable-webhooks", true, "Enable webhook server and admission webhook registration.")
+	flag.IntVar(&webhookPort, "webhook-port", defaultWebhookPort, "The port the webhook server listens on (1-65535).")
+	flag.StringVar(&webhookCertDir, "webhook-cert-dir", "",
+		"Directory containing the webhook server tls.crt and tls.key. Empty uses <temp-dir>/k8s-webhook-server/serving-certs.")
 	flag.StringVar(&watchNamespaces, "watch-namespaces", "", "Comma-separated list of namespaces to watch. Empty means all namespaces (cluster-scoped).")
+	flag.BoolVar(&watchOwnNamespace, "watch-own-namespace", false,
+		"Watch only the namespace the operator runs in, read from the POD_NAMESPACE environment variable. "+
+			"Takes precedence over --watch-namespaces.")
+	flag.StringVar(&namespaceLabelSelector, "namespace-label-selector", "",
+		"Label selector (e.g. memcached-operator/enabled=true) restricting reconciliation to matching namespaces. "+
+			"Mutually exclusive with --watch-namespaces.")
+	flag.DurationVar(&reconcileTimeout, "reconcile-timeout", 2*time.Minute,
+		"Maximum duration of a single reconcile before it is aborted and requeued. Zero disables the timeout.")
+	flag.DurationVar(&resyncPeriod, "resync-period", defaultResyncPeriod,
+		"Interval at which informers replay every cached object to the controller. Zero disables periodic resyncs.")
+	flag.BoolVar(&pruneUnmanagedAnnotations, "prune-unmanaged-annotations", false,
+		"If set, annotations on owned resources that are neither operator-managed nor allowlisted are removed.")
+	flag.StringVar(&annotationAllowlist, "annotation-allowlist", "deployment.kubernetes.io/",
+		"Comma-separated annotation key prefixes preserved by --prune-unmanaged-annotations.")
+	flag.BoolVar(&allowUnsafeSysctls, "allow-unsafe-sysctls", false,
+		"If set, the validation webhook accepts spec.security.sysctls outside the Kubernetes safe set.")
+	flag.IntVar(&maxReplicas, "max-replicas", 64,
+		"Maximum spec.replicas and spec.autoscaling.maxReplicas accepted by the validation webhook (1-64).")
+	flag.IntVar(&minReplicas, "min-replicas", 0,
+		"Minimum spec.replicas and spec.autoscaling.minReplicas accepted by the validation webhook "+
+			"(0 to --max-replicas). Zero disables the floor.")
+	flag.BoolVar(&allowZeroReplicas, "allow-zero-replicas", false,
+		"If set, spec.replicas=0 is accepted below --min-replicas as a deliberate scale to zero.")
+	flag.IntVar(&defaultThreads, "default-threads", 0,
+		"spec.memcached.threads applied by the mutating webhook when unset (0-128). Zero keeps the built-in default of 4.")
+	flag.StringVar(&defaultMaxItemSize, "default-max-item-size", "",
+		"spec.memcached.maxItemSize applied by the mutating webhook when unset (e.g. 2m). Empty keeps the built-in default of 1m.")
+	flag.StringVar(&defaultExtraArgs, "default-extra-args", "",
+		"Whitespace-separated spec.memcached.extraArgs applied by the mutating webhook when a CR sets none.")
+	flag.StringVar(&tlsCopySourceNamespaces, "tls-copy-source-namespaces", "",
+		"Comma-separated namespaces spec.security.tls.copyFromNamespace may copy the TLS Secret from. Empty disables copying.")
 
 	opts := zap.Options{
 		Development: true,
@@ -98,7 +276,50 @@ func main() {
 
 	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
 
-	nsMap := parseWatchNamespaces(watchNamespaces)
+	nsMap, err := resolveWatchNamespaces(watchNamespaces, watchOwnNamespace, os.Getenv)
+	if err != nil {
+		setupLog.Error(err, "unable to resolve the operator namespace")
+		os.Exit(1)
+	}
+	if watchOwnNamespace && parseWatchNamespaces(watchNamespaces) != nil {
+		setupLog.Info("ignoring --watch-namespaces because --watch-own-namespace is set")
+	}
+	nsSelector, err := parseNamespaceLabelSelector(namespaceLabelSelector)
+	if err != nil {
+		setupLog.Error(err, "invalid --namespace-label-selector")
+		os.Exit(1)
+	}
+	cacheOpts, err := buildCacheOptions(nsMap, resyncPeriod)
+	if err != nil {
+		setupLog.Error(err, "invalid cache options")
+		os.Exit(1)
+	}
+	if maxReplicas < 1 || maxReplicas > 64 {
+		setupLog.Error(nil, "--max-replicas must be between 1 and 64", "maxReplicas", maxReplicas)
+		os.Exit(1)
+	}
+	if minReplicas < 0 || minReplicas > maxReplicas {
+		setupLog.Error(nil, "--min-replicas must be between 0 and --max-replicas",
+			"minReplicas", minReplicas, "maxReplicas", maxReplicas)
+		os.Exit(1)
+	}
+	if err := validateMemcachedDefaults(defaultThreads, defaultMaxItemSize, strings.Fields(defaultExtraArgs)); err != nil {
+		setupLog.Error(err, "invalid memcached defaults")
+		os.Exit(1)
+	}
+	copySourceNamespaces := parseTLSCopySourceNamespaces(tlsCopySourceNamespaces)
+	if webhookPort < 1 || webhookPort > 65535 {
+		setupLog.Error(nil, "--webhook-port must be between 1 and 65535", "webhookPort", webhookPort)
+		os.Exit(1)
+	}
+	if watchOwnNamespace && nsSelector != nil {
+		setupLog.Error(nil, "--watch-own-namespace and --namespace-label-selector are mutually exclusive")
+		os.Exit(1)
+	}
+	if nsMap != nil && nsSelector != nil {
+		setupLog.Error(nil, "--watch-namespaces and --namespace-label-selector are mutually exclusive")
+		os.Exit(1)
+	}
 	if nsMap != nil {
 		nsList := make([]string, 0, len(nsMap))
 		for ns := range nsMap {
@@ -106,6 +327,8 @@ func main() {
 		}
 		sort.Strings(nsList)
 		setupLog.Info("watching namespaces", "namespaces", nsList)
+	} else if nsSelector != nil {
+		setupLog.Info("watching namespaces matching label selector", "selector", nsSelector.String())
 	} else {
 		setupLog.Info("watching all namespaces")
 	}
@@ -116,7 +339,7 @@ func main() {
 		})
 	}
 
-	webhookServer := buildWebhookServer(enableWebhooks, tlsOpts)
+	webhookServer := buildWebhookServer(enableWebhooks, buildWebhookOptions(webhookPort, webhookCertDir, tlsOpts))
 	if !enableWebhooks {
 		setupLog.Info("webhooks are disabled")
 	}
@@ -138,7 +361,7 @@ func main() {
 		HealthProbeBindAddress: probeAddr,
 		LeaderElection:         enableLeaderElection,
 		LeaderElectionID:       "d4f3c8a2.c5c3.io",
-		Cache:                  cache.Options{DefaultNamespaces: nsMap},
+		Cache:                  cacheOpts,
 	})
 	if err != nil {
 		setupLog.Error(err, "unable to start manager")
@@ -149,16 +372,31 @@ func main() {
 		Client:   mgr.GetClient(),
 		Scheme:   mgr.GetScheme(),
 		Recorder: mgr.GetEventRecorder("memcached-controller"),
+
+		NamespaceSelector: nsSelector,
+		ReconcileTimeout:  reconcileTimeout,
+
+		PruneUnmanagedAnnotations: pruneUnmanagedAnnotations,
+		AnnotationAllowlist:       parseAnnotationAllowlist(annotationAllowlist),
+		TLSCopySourceNamespaces:   copySourceNamespaces,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Memcached")
 		os.Exit(1)
 	}
 
-	if enableWebhooks {
-		if err = memcachedv1beta1.SetupMemcachedWebhookWithManager(mgr); err != nil {
-			setupLog.Error(err, "unable to create webhook", "webhook", "Memcached")
-			os.Exit(1)
-		}
+	if err = setupWebhooks(mgr, enableWebhooks, memcachedv1beta1.WebhookOptions{
+		AllowUnsafeSysctls: allowUnsafeSysctls,
+		MaxReplicas:        int32(maxReplicas), //nolint:gosec // bounded to 1-64 above
+		MinReplicas:        int32(minReplicas), //nolint:gosec // bounded to 0-64 above
+		AllowZeroReplicas:  allowZeroReplicas,
+		DefaultThreads:     int32(defaultThreads), //nolint:gosec // bounded to 0-128 above
+		DefaultMaxItemSize: defaultMaxItemSize,
+		DefaultExtraArgs:   strings.Fields(defaultExtraArgs),
+
+		TLSCopySourceNamespaces: copySourceNamespaces,
+	}); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "Memcached")
+		os.Exit(1)
 	}
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
@@ -2,10 +2,24 @@ package main
 
 import (
 	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"testing"
+	"time"
 
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/rest"
+	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/config"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+
+	memcachedv1beta1 "github.com/c5c3/memcached-operator/api/v1beta1"
+	"github.com/c5c3/memcached-operator/internal/controller"
 )
 
 func TestBuildWebhookServer(t *testing.T) {
@@ -47,7 +61,7 @@ func TestBuildWebhookServer(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := buildWebhookServer(tt.enabled, tt.tlsOpts)
+			result := buildWebhookServer(tt.enabled, buildWebhookOptions(defaultWebhookPort, "", tt.tlsOpts))
 			if tt.wantNil && result != nil {
 				t.Fatalf("expected nil, got %v", result)
 			}
@@ -58,6 +72,37 @@ func TestBuildWebhookServer(t *testing.T) {
 	}
 }
 
+func TestBuildWebhookOptions(t *testing.T) {
+	tlsOpts := []func(*tls.Config){
+		func(c *tls.Config) { c.NextProtos = []string{"http/1.1"} },
+	}
+	tests := []struct {
+		name        string
+		port        int
+		certDir     string
+		wantPort    int
+		wantCertDir string
+	}{
+		{name: "defaults", port: defaultWebhookPort, certDir: "", wantPort: 9443, wantCertDir: ""},
+		{name: "overrides", port: 10250, certDir: "/etc/webhook/certs", wantPort: 10250, wantCertDir: "/etc/webhook/certs"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := buildWebhookOptions(tt.port, tt.certDir, tlsOpts)
+			if opts.Port != tt.wantPort {
+				t.Errorf("Port = %d, want %d", opts.Port, tt.wantPort)
+			}
+			if opts.CertDir != tt.wantCertDir {
+				t.Errorf("CertDir = %q, want %q", opts.CertDir, tt.wantCertDir)
+			}
+			if len(opts.TLSOpts) != len(tlsOpts) {
+				t.Errorf("TLSOpts has %d entries, want %d", len(opts.TLSOpts), len(tlsOpts))
+			}
+		})
+	}
+}
+
 func TestParseWatchNamespaces(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -147,3 +192,302 @@ func TestParseWatchNamespaces(t *testing.T) {
 		})
 	}
 }
+
+func TestResolveWatchNamespaces(t *testing.T) {
+	tests := []struct {
+		name              string
+		watchNamespaces   string
+		watchOwnNamespace bool
+		podNamespace      string
+		expected          map[string]cache.Config
+		wantErr           bool
+	}{
+		{
+			name:     "no flags watches all namespaces",
+			expected: nil,
+		},
+		{
+			name:            "watch-namespaces only",
+			watchNamespaces: "ns1,ns2",
+			podNamespace:    "operator-system",
+			expected:        map[string]cache.Config{"ns1": {}, "ns2": {}},
+		},
+		{
+			name:              "watch-own-namespace uses POD_NAMESPACE",
+			watchOwnNamespace: true,
+			podNamespace:      "operator-system",
+			expected:          map[string]cache.Config{"operator-system": {}},
+		},
+		{
+			name:              "watch-own-namespace takes precedence over watch-namespaces",
+			watchNamespaces:   "ns1,ns2",
+			watchOwnNamespace: true,
+			podNamespace:      "operator-system",
+			expected:          map[string]cache.Config{"operator-system": {}},
+		},
+		{
+			name:              "watch-own-namespace trims POD_NAMESPACE",
+			watchOwnNamespace: true,
+			podNamespace:      " operator-system ",
+			expected:          map[string]cache.Config{"operator-system": {}},
+		},
+		{
+			name:              "watch-own-namespace without POD_NAMESPACE",
+			watchOwnNamespace: true,
+			wantErr:           true,
+		},
+		{
+			name:              "watch-own-namespace does not fall back to watch-namespaces",
+			watchNamespaces:   "ns1",
+			watchOwnNamespace: true,
+			wantErr:           true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			getenv := func(key string) string {
+				if key == "POD_NAMESPACE" {
+					return tt.podNamespace
+				}
+				return ""
+			}
+			result, err := resolveWatchNamespaces(tt.watchNamespaces, tt.watchOwnNamespace, getenv)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("wantErr=%v, got err=%v", tt.wantErr, err)
+			}
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestParseNamespaceLabelSelector(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		wantNil   bool
+		wantErr   bool
+		matches   labels.Set
+		noMatches labels.Set
+	}{
+		{
+			name:    "empty string returns nil",
+			input:   "",
+			wantNil: true,
+		},
+		{
+			name:    "whitespace-only returns nil",
+			input:   "   ",
+			wantNil: true,
+		},
+		{
+			name:      "equality selector",
+			input:     "memcached-operator/enabled=true",
+			matches:   labels.Set{"memcached-operator/enabled": "true"},
+			noMatches: labels.Set{"memcached-operator/enabled": "false"},
+		},
+		{
+			name:      "set-based selector",
+			input:     " env in (prod,staging) ",
+			matches:   labels.Set{"env": "prod"},
+			noMatches: labels.Set{"env": "dev"},
+		},
+		{
+			name:    "invalid selector",
+			input:   "env in (prod",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selector, err := parseNamespaceLabelSelector(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("wantErr=%v, got err=%v", tt.wantErr, err)
+			}
+			if tt.wantErr {
+				return
+			}
+			if tt.wantNil {
+				if selector != nil {
+					t.Fatalf("expected nil selector, got %v", selector)
+				}
+				return
+			}
+			if selector == nil {
+				t.Fatal("expected non-nil selector")
+			}
+			if !selector.Matches(tt.matches) {
+				t.Errorf("selector %q should match %v", selector, tt.matches)
+			}
+			if selector.Matches(tt.noMatches) {
+				t.Errorf("selector %q should not match %v", selector, tt.noMatches)
+			}
+		})
+	}
+}
+
+func TestParseAnnotationAllowlist(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{name: "empty string returns nil", input: "", expected: nil},
+		{name: "single prefix", input: "deployment.kubernetes.io/", expected: []string{"deployment.kubernetes.io/"}},
+		{
+			name:     "multiple prefixes with whitespace and empty segments",
+			input:    " deployment.kubernetes.io/ ,, sidecar.istio.io/ ,",
+			expected: []string{"deployment.kubernetes.io/", "sidecar.istio.io/"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parseAnnotationAllowlist(tt.input)
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("parseAnnotationAllowlist(%q) = %v, want %v", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseTLSCopySourceNamespaces(t *testing.T) {
+	if got := parseTLSCopySourceNamespaces(""); got != nil {
+		t.Errorf("parseTLSCopySourceNamespaces(\"\") = %v, want nil", got)
+	}
+	want := []string{"certs", "shared"}
+	if got := parseTLSCopySourceNamespaces(" certs ,, shared"); !reflect.DeepEqual(got, want) {
+		t.Errorf("parseTLSCopySourceNamespaces() = %v, want %v", got, want)
+	}
+}
+
+func TestValidateMemcachedDefaults(t *testing.T) {
+	tests := []struct {
+		name        string
+		threads     int
+		maxItemSize string
+		extraArgs   []string
+		wantErr     bool
+	}{
+		{name: "flags off", threads: 0, maxItemSize: ""},
+		{name: "unmanaged extra args", extraArgs: []string{"-R", "40", "-o", "hashpower=20"}},
+		{name: "extra args set a managed flag", extraArgs: []string{"-t", "8"}, wantErr: true},
+		{name: "extra args set a managed extended option", extraArgs: []string{"-o", "ssl_key=/tmp/key.pem"}, wantErr: true},
+		{name: "valid overrides", threads: 16, maxItemSize: "4m"},
+		{name: "kilobyte item size", threads: 1, maxItemSize: "512k"},
+		{name: "negative threads", threads: -1, wantErr: true},
+		{name: "threads above CRD maximum", threads: 129, wantErr: true},
+		{name: "item size without unit", maxItemSize: "1024", wantErr: true},
+		{name: "item size with uppercase unit", maxItemSize: "2M", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateMemcachedDefaults(tt.threads, tt.maxItemSize, tt.extraArgs)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateMemcachedDefaults(%d, %q, %q) error = %v, wantErr %v",
+					tt.threads, tt.maxItemSize, tt.extraArgs, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestBuildCacheOptions(t *testing.T) {
+	nsMap := map[string]cache.Config{"team-a": {}}
+	tests := []struct {
+		name         string
+		resyncPeriod time.Duration
+		wantErr      bool
+	}{
+		{name: "default", resyncPeriod: defaultResyncPeriod},
+		{name: "custom", resyncPeriod: 30 * time.Minute},
+		{name: "disabled", resyncPeriod: 0},
+		{name: "negative", resyncPeriod: -time.Minute, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts, err := buildCacheOptions(nsMap, tt.resyncPeriod)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("buildCacheOptions(%s) error = %v, wantErr %v", tt.resyncPeriod, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if opts.SyncPeriod == nil || *opts.SyncPeriod != tt.resyncPeriod {
+				t.Errorf("SyncPeriod = %v, want %s", opts.SyncPeriod, tt.resyncPeriod)
+			}
+			if len(opts.DefaultNamespaces) != 1 {
+				t.Errorf("DefaultNamespaces = %v, want %v", opts.DefaultNamespaces, nsMap)
+			}
+			managed := labels.Set{controller.LabelManagedBy: controller.ManagedByOperator}
+			for _, obj := range []client.Object{&corev1.Pod{}, &discoveryv1.EndpointSlice{}} {
+				var selector labels.Selector
+				for o, byObject := range opts.ByObject {
+					if reflect.TypeOf(o) == reflect.TypeOf(obj) {
+						selector = byObject.Label
+					}
+				}
+				if selector == nil || !selector.Matches(managed) || selector.Matches(labels.Set{}) {
+					t.Errorf("ByObject[%T].Label = %v, want a selector on the managed-by label", obj, selector)
+				}
+			}
+		})
+	}
+}
+
+func TestSetupWebhooks(t *testing.T) {
+	const validatePath = "/validate-memcached-c5c3-io-v1beta1-memcached"
+
+	tests := []struct {
+		name           string
+		enabled        bool
+		wantRegistered bool
+	}{
+		{name: "enabled registers the webhooks", enabled: true, wantRegistered: true},
+		{name: "disabled registers nothing", enabled: false, wantRegistered: false},
+	}
+
+	// Each subtest sets up the "memcached" controller on a fresh manager.
+	skipNameValidation := true
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The manager is never started, so the API server address is not dialed.
+			mgr, err := ctrl.NewManager(&rest.Config{Host: "https://127.0.0.1:6443"}, ctrl.Options{
+				Scheme:                 scheme,
+				Metrics:                metricsserver.Options{BindAddress: "0"},
+				HealthProbeBindAddress: "0",
+				WebhookServer:          buildWebhookServer(tt.enabled, buildWebhookOptions(defaultWebhookPort, "", nil)),
+				Controller:             config.Controller{SkipNameValidation: &skipNameValidation},
+			})
+			if err != nil {
+				t.Fatalf("NewManager() error = %v", err)
+			}
+
+			if err := setupWebhooks(mgr, tt.enabled, memcachedv1beta1.WebhookOptions{MaxReplicas: 64}); err != nil {
+				t.Fatalf("setupWebhooks() error = %v", err)
+			}
+
+			// The controller is set up regardless of the webhook flag.
+			if err := (&controller.MemcachedReconciler{
+				Client: mgr.GetClient(),
+				Scheme: mgr.GetScheme(),
+			}).SetupWithManager(mgr); err != nil {
+				t.Fatalf("SetupWithManager() error = %v", err)
+			}
+
+			// The webhook server initializes its mux on the first registration.
+			registered := false
+			if mux := mgr.GetWebhookServer().WebhookMux(); mux != nil {
+				_, pattern := mux.Handler(httptest.NewRequest(http.MethodPost, validatePath, nil))
+				registered = pattern != ""
+			}
+			if registered != tt.wantRegistered {
+				t.Errorf("validating webhook registered = %v, want %v", registered, tt.wantRegistered)
+			}
+		})
+	}
+}
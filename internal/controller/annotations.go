@@ -0,0 +1,54 @@
+// Package controller implements the reconciliation logic for the memcached-operator.
+package controller
+
+import (
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// operatorAnnotationPrefix is the prefix of annotation keys written by the operator
+// itself. Such annotations are never pruned.
+const operatorAnnotationPrefix = "memcached.c5c3.io/"
+
+// unmanagedAnnotationKeys returns, in sorted order, the annotation keys that match
+// neither operatorAnnotationPrefix nor any prefix in allowlist.
+func unmanagedAnnotationKeys(annotations map[string]string, allowlist []string) []string {
+	var keys []string
+	for k := range annotations {
+		if !annotationAllowed(k, allowlist) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// annotationAllowed reports whether key is operator-managed or matches a prefix in allowlist.
+func annotationAllowed(key string, allowlist []string) bool {
+	if strings.HasPrefix(key, operatorAnnotationPrefix) {
+		return true
+	}
+	for _, prefix := range allowlist {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// pruneUnmanagedAnnotations removes every annotation from obj that is not allowed
+// by annotationAllowed. It runs before a resource's mutate function, which then
+// re-asserts the annotations the operator manages (for example propagated
+// annotations and spec.service.annotations), so only foreign keys are dropped.
+func pruneUnmanagedAnnotations(obj metav1.Object, allowlist []string) {
+	annotations := obj.GetAnnotations()
+	for _, k := range unmanagedAnnotationKeys(annotations, allowlist) {
+		delete(annotations, k)
+	}
+	if len(annotations) == 0 {
+		annotations = nil
+	}
+	obj.SetAnnotations(annotations)
+}
@@ -0,0 +1,112 @@
+// Package controller implements the reconciliation logic for the memcached-operator.
+package controller
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	memcachedv1beta1 "github.com/c5c3/memcached-operator/api/v1beta1"
+)
+
+func TestPruneUnmanagedAnnotations(t *testing.T) {
+	dep := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				AnnotationSpecHash:                  "abc",
+				"deployment.kubernetes.io/revision": "3",
+				"backup.example.com/last-run":       "yesterday",
+			},
+		},
+	}
+
+	pruneUnmanagedAnnotations(dep, []string{"deployment.kubernetes.io/"})
+
+	want := map[string]string{
+		AnnotationSpecHash:                  "abc",
+		"deployment.kubernetes.io/revision": "3",
+	}
+	if !reflect.DeepEqual(dep.Annotations, want) {
+		t.Errorf("annotations = %v, want %v", dep.Annotations, want)
+	}
+}
+
+func TestPruneUnmanagedAnnotations_AllRemovedLeavesNil(t *testing.T) {
+	dep := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{"backup.example.com/last-run": "yesterday"},
+		},
+	}
+
+	pruneUnmanagedAnnotations(dep, nil)
+
+	if dep.Annotations != nil {
+		t.Errorf("annotations = %v, want nil", dep.Annotations)
+	}
+}
+
+func TestReconcileDeployment_PrunesUnmanagedAnnotations(t *testing.T) {
+	tests := []struct {
+		name        string
+		prune       bool
+		wantForeign bool
+	}{
+		{name: "pruning enabled", prune: true, wantForeign: false},
+		{name: "pruning disabled", prune: false, wantForeign: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &memcachedv1beta1.Memcached{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        testInstanceName,
+					Namespace:   testDefaultNamespace,
+					UID:         "uid-1",
+					Annotations: map[string]string{"example.com/owner": "alice"},
+				},
+				Spec: memcachedv1beta1.MemcachedSpec{
+					PropagateAnnotations: []string{"example.com/owner"},
+				},
+			}
+			existing := &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      testInstanceName,
+					Namespace: testDefaultNamespace,
+					Annotations: map[string]string{
+						"deployment.kubernetes.io/revision": "3",
+						"mesh.example.com/injected":         "true",
+					},
+				},
+			}
+			c := newFakeClient(mc, existing)
+			r := newTestReconciler(c)
+			r.PruneUnmanagedAnnotations = tt.prune
+			r.AnnotationAllowlist = []string{"deployment.kubernetes.io/"}
+
+			if _, err := r.reconcileDeployment(context.Background(), mc); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			dep := &appsv1.Deployment{}
+			if err := c.Get(context.Background(), client.ObjectKeyFromObject(existing), dep); err != nil {
+				t.Fatalf("failed to get deployment: %v", err)
+			}
+			if _, ok := dep.Annotations["mesh.example.com/injected"]; ok != tt.wantForeign {
+				t.Errorf("foreign annotation present = %v, want %v", ok, tt.wantForeign)
+			}
+			if dep.Annotations["deployment.kubernetes.io/revision"] != "3" {
+				t.Error("allowlisted annotation should be kept")
+			}
+			if dep.Annotations["example.com/owner"] != "alice" {
+				t.Error("propagated annotation should be kept")
+			}
+			if dep.Annotations[AnnotationSpecHash] == "" {
+				t.Error("spec hash annotation should be kept")
+			}
+		})
+	}
+}
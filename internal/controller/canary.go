@@ -0,0 +1,98 @@
+package controller
+
+import (
+	"context"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	memcachedv1beta1 "github.com/c5c3/memcached-operator/api/v1beta1"
+)
+
+// LabelTrack marks the pods of the canary Deployment. The regular pods do not
+// carry it, so the canary Deployment selects only its own pods, while the
+// Service and NetworkPolicy, which select on labelsForMemcached, cover both sets.
+// The regular Deployment's selector, which is immutable, also matches the canary
+// pods, so everything else that treats the pods as the regular ones (the
+// PodDisruptionBudget and listMemcachedPods) excludes them via notCanary.
+const LabelTrack = "memcached.c5c3.io/track"
+
+// trackCanary is the LabelTrack value of the canary pods.
+const trackCanary = "canary"
+
+// canaryDeploymentName returns the name of the canary Deployment of mc.
+func canaryDeploymentName(mc *memcachedv1beta1.Memcached) string {
+	return mc.Name + "-canary"
+}
+
+// labelsForCanary returns the selector labels of the canary Deployment: the
+// standard labels plus LabelTrack.
+func labelsForCanary(name string) map[string]string {
+	labels := labelsForMemcached(name)
+	labels[LabelTrack] = trackCanary
+	return labels
+}
+
+// notCanary returns the label selector requirement that excludes canary pods.
+func notCanary() metav1.LabelSelectorRequirement {
+	return metav1.LabelSelectorRequirement{
+		Key:      LabelTrack,
+		Operator: metav1.LabelSelectorOpNotIn,
+		Values:   []string{trackCanary},
+	}
+}
+
+// regularPodSelector returns the label selector of the regular (non-canary) pods of mc.
+func regularPodSelector(name string) *metav1.LabelSelector {
+	return &metav1.LabelSelector{
+		MatchLabels:      labelsForMemcached(name),
+		MatchExpressions: []metav1.LabelSelectorRequirement{notCanary()},
+	}
+}
+
+// constructCanaryDeployment sets the desired state of the canary Deployment. The pods
+// are built like the regular pods, but run spec.canary.image, carry LabelTrack and
+// are scaled to spec.canary.replicas independently of autoscaling and maintenance.
+func constructCanaryDeployment(mc *memcachedv1beta1.Memcached, dep *appsv1.Deployment, secretHash, restartTrigger string) {
+	canary := mc.DeepCopy()
+	canary.Spec.Image = mc.Spec.Canary.Image
+	constructDeployment(canary, dep, secretHash, restartTrigger)
+
+	replicas := mc.CanaryReplicas()
+	dep.Spec.Replicas = &replicas
+	dep.Labels[LabelTrack] = trackCanary
+	dep.Spec.Selector = &metav1.LabelSelector{MatchLabels: labelsForCanary(mc.Name)}
+	dep.Spec.Template.Labels[LabelTrack] = trackCanary
+}
+
+// reconcileCanaryDeployment ensures the canary Deployment matches spec.canary. When
+// the canary is disabled, any existing canary Deployment is deleted; it is never
+// orphaned, since a retained canary would keep serving the canary image.
+func (r *MemcachedReconciler) reconcileCanaryDeployment(ctx context.Context, mc *memcachedv1beta1.Memcached) error {
+	dep := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      canaryDeploymentName(mc),
+			Namespace: mc.Namespace,
+		},
+	}
+	if !mc.IsCanaryEnabled() {
+		return r.deleteOwnedResource(ctx, mc, dep, "Deployment")
+	}
+
+	found, _ := fetchReferencedSecrets(ctx, r.Client, mc)
+	secretHash := computeSecretHash(found...)
+	securityHash := computeSecurityHash(found...)
+	restartTrigger := mc.Annotations[AnnotationRestartTrigger]
+
+	clientCAUnavailable := r.clientCAUnavailable(ctx, mc)
+
+	_, err := r.reconcileResource(ctx, mc, dep, func() error {
+		constructCanaryDeployment(mc, dep, secretHash, restartTrigger)
+		if clientCAUnavailable {
+			removeClientCA(dep)
+		}
+		setSecurityHashAnnotation(dep, securityHash)
+		return nil
+	}, "Deployment")
+	return err
+}
@@ -0,0 +1,111 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+
+	memcachedv1beta1 "github.com/c5c3/memcached-operator/api/v1beta1"
+)
+
+func newCanaryMemcached(canary *memcachedv1beta1.CanarySpec) *memcachedv1beta1.Memcached {
+	return &memcachedv1beta1.Memcached{
+		ObjectMeta: metav1.ObjectMeta{Name: testInstanceName, Namespace: testDefaultNamespace},
+		Spec: memcachedv1beta1.MemcachedSpec{
+			Replicas: int32Ptr(3),
+			Image:    stringPtr("memcached:1.6.29"),
+			Canary:   canary,
+		},
+	}
+}
+
+func TestConstructCanaryDeployment(t *testing.T) {
+	mc := newCanaryMemcached(&memcachedv1beta1.CanarySpec{
+		Enabled: true,
+		Image:   stringPtr("memcached:1.6.38"),
+	})
+	mc.Spec.Autoscaling = &memcachedv1beta1.AutoscalingSpec{Enabled: true, MaxReplicas: 10}
+
+	dep := &appsv1.Deployment{}
+	constructCanaryDeployment(mc, dep, "", "")
+
+	if dep.Spec.Replicas == nil || *dep.Spec.Replicas != memcachedv1beta1.DefaultCanaryReplicas {
+		t.Errorf("replicas = %v, want %d", dep.Spec.Replicas, memcachedv1beta1.DefaultCanaryReplicas)
+	}
+	if got := dep.Spec.Template.Spec.Containers[0].Image; got != "memcached:1.6.38" {
+		t.Errorf("image = %q, want memcached:1.6.38", got)
+	}
+	if got := dep.Spec.Template.Labels["app.kubernetes.io/version"]; got != "1.6.38" {
+		t.Errorf("version label = %q, want 1.6.38", got)
+	}
+	if got := dep.Labels[LabelTrack]; got != trackCanary {
+		t.Errorf("Deployment %s label = %q, want %q", LabelTrack, got, trackCanary)
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(dep.Spec.Selector)
+	if err != nil {
+		t.Fatalf("invalid selector: %v", err)
+	}
+	if !selector.Matches(labels.Set(dep.Spec.Template.Labels)) {
+		t.Error("canary selector does not match its own pod template labels")
+	}
+	if selector.Matches(labels.Set(labelsForMemcached(mc.Name))) {
+		t.Error("canary selector matches the regular pods")
+	}
+	if !labels.SelectorFromSet(labelsForMemcached(mc.Name)).Matches(labels.Set(dep.Spec.Template.Labels)) {
+		t.Error("Service selector does not match the canary pods")
+	}
+
+	if mc.Spec.Image == nil || *mc.Spec.Image != "memcached:1.6.29" {
+		t.Errorf("spec.image was modified: %v", mc.Spec.Image)
+	}
+}
+
+func TestConstructCanaryDeployment_Replicas(t *testing.T) {
+	mc := newCanaryMemcached(&memcachedv1beta1.CanarySpec{
+		Enabled:  true,
+		Image:    stringPtr("memcached:1.6.38"),
+		Replicas: int32Ptr(2),
+	})
+
+	dep := &appsv1.Deployment{}
+	constructCanaryDeployment(mc, dep, "", "")
+
+	if dep.Spec.Replicas == nil || *dep.Spec.Replicas != 2 {
+		t.Errorf("replicas = %v, want 2", dep.Spec.Replicas)
+	}
+}
+
+func TestReconcileCanaryDeployment_CreatesAndDeletes(t *testing.T) {
+	mc := newCanaryMemcached(&memcachedv1beta1.CanarySpec{
+		Enabled: true,
+		Image:   stringPtr("memcached:1.6.38"),
+	})
+	c := newFakeClient(mc)
+	r := newTestReconciler(c)
+	key := types.NamespacedName{Name: testInstanceName + "-canary", Namespace: testDefaultNamespace}
+
+	if err := r.reconcileCanaryDeployment(context.Background(), mc); err != nil {
+		t.Fatalf("reconcileCanaryDeployment: %v", err)
+	}
+	dep := &appsv1.Deployment{}
+	if err := c.Get(context.Background(), key, dep); err != nil {
+		t.Fatalf("canary Deployment not created: %v", err)
+	}
+	if !metav1.IsControlledBy(dep, mc) {
+		t.Error("canary Deployment is not controlled by the Memcached CR")
+	}
+
+	mc.Spec.Canary.Enabled = false
+	if err := r.reconcileCanaryDeployment(context.Background(), mc); err != nil {
+		t.Fatalf("reconcileCanaryDeployment: %v", err)
+	}
+	if err := c.Get(context.Background(), key, &appsv1.Deployment{}); !apierrors.IsNotFound(err) {
+		t.Errorf("canary Deployment still present after disable: %v", err)
+	}
+}
@@ -0,0 +1,76 @@
+// Package controller implements the reconciliation logic for the memcached-operator.
+package controller
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	memcachedv1beta1 "github.com/c5c3/memcached-operator/api/v1beta1"
+)
+
+// certificateGVK identifies the cert-manager Certificate kind. Certificates are
+// handled as unstructured objects so the operator does not depend on the
+// cert-manager API module and runs on clusters without cert-manager installed.
+var certificateGVK = schema.GroupVersionKind{Group: "cert-manager.io", Version: "v1", Kind: "Certificate"}
+
+// newCertificate returns an empty unstructured cert-manager Certificate named after mc.
+func newCertificate(mc *memcachedv1beta1.Memcached) *unstructured.Unstructured {
+	cert := &unstructured.Unstructured{}
+	cert.SetGroupVersionKind(certificateGVK)
+	cert.SetName(mc.Name)
+	cert.SetNamespace(mc.Namespace)
+	return cert
+}
+
+// defaultCertificateDNSNames returns the DNS names used for the generated Certificate
+// when spec.security.tls.generateCertificate.dnsNames is empty: the names under which
+// the Service (named after mc) resolves, plus wildcards for the per-pod records a
+// headless Service publishes.
+func defaultCertificateDNSNames(mc *memcachedv1beta1.Memcached) []string {
+	svc := mc.Name + "." + mc.Namespace + ".svc"
+	return []string{
+		mc.Name,
+		mc.Name + "." + mc.Namespace,
+		svc,
+		svc + ".cluster.local",
+		"*." + svc,
+		"*." + svc + ".cluster.local",
+	}
+}
+
+// constructCertificate sets the desired state of the cert-manager Certificate based on the
+// Memcached CR spec. The Certificate issues the Secret named by spec.security.tls.certificateSecretRef
+// for spec.security.tls.generateCertificate.dnsNames, or defaultCertificateDNSNames when those are empty.
+// It mutates cert in-place and is designed to be called from within controllerutil.CreateOrUpdate.
+func constructCertificate(mc *memcachedv1beta1.Memcached, cert *unstructured.Unstructured) error {
+	tls := mc.Spec.Security.TLS
+	gen := tls.GenerateCertificate
+
+	cert.SetLabels(withPropagatedLabels(mc, labelsForMemcached(mc.Name)))
+	cert.SetAnnotations(mergePropagatedAnnotations(mc, cert.GetAnnotations()))
+
+	kind := gen.IssuerRef.Kind
+	if kind == "" {
+		kind = "Issuer"
+	}
+	names := gen.DNSNames
+	if len(names) == 0 {
+		names = defaultCertificateDNSNames(mc)
+	}
+	dnsNames := make([]interface{}, 0, len(names))
+	for _, name := range names {
+		dnsNames = append(dnsNames, name)
+	}
+
+	if err := unstructured.SetNestedField(cert.Object, tls.CertificateSecretRef.Name, "spec", "secretName"); err != nil {
+		return err
+	}
+	if err := unstructured.SetNestedSlice(cert.Object, dnsNames, "spec", "dnsNames"); err != nil {
+		return err
+	}
+	return unstructured.SetNestedStringMap(cert.Object, map[string]string{
+		"name":  gen.IssuerRef.Name,
+		"kind":  kind,
+		"group": certificateGVK.Group,
+	}, "spec", "issuerRef")
+}
@@ -0,0 +1,124 @@
+// Package controller implements the reconciliation logic for the memcached-operator.
+package controller
+
+import (
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	memcachedv1beta1 "github.com/c5c3/memcached-operator/api/v1beta1"
+)
+
+func certificateMemcached(kind string) *memcachedv1beta1.Memcached {
+	return &memcachedv1beta1.Memcached{
+		ObjectMeta: metav1.ObjectMeta{Name: "cache", Namespace: "prod"},
+		Spec: memcachedv1beta1.MemcachedSpec{
+			Security: &memcachedv1beta1.SecuritySpec{
+				TLS: &memcachedv1beta1.TLSSpec{
+					Enabled:              true,
+					CertificateSecretRef: corev1.LocalObjectReference{Name: "cache-tls"},
+					GenerateCertificate: &memcachedv1beta1.GenerateCertificateSpec{
+						IssuerRef: memcachedv1beta1.CertificateIssuerRef{Name: "selfsigned", Kind: kind},
+						DNSNames:  []string{"cache.prod.svc", "*.cache.prod.svc.cluster.local"},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestNewCertificate(t *testing.T) {
+	cert := newCertificate(certificateMemcached("Issuer"))
+
+	if got := cert.GroupVersionKind(); got != certificateGVK {
+		t.Errorf("GVK = %v, want %v", got, certificateGVK)
+	}
+	if cert.GetName() != "cache" || cert.GetNamespace() != "prod" {
+		t.Errorf("key = %s/%s, want prod/cache", cert.GetNamespace(), cert.GetName())
+	}
+}
+
+func TestConstructCertificate(t *testing.T) {
+	tests := []struct {
+		name     string
+		kind     string
+		wantKind string
+	}{
+		{name: "issuer", kind: "Issuer", wantKind: "Issuer"},
+		{name: "cluster issuer", kind: "ClusterIssuer", wantKind: "ClusterIssuer"},
+		{name: "kind unset defaults to Issuer", kind: "", wantKind: "Issuer"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := certificateMemcached(tt.kind)
+			cert := newCertificate(mc)
+
+			if err := constructCertificate(mc, cert); err != nil {
+				t.Fatalf("constructCertificate() error = %v", err)
+			}
+
+			secretName, _, _ := unstructured.NestedString(cert.Object, "spec", "secretName")
+			if secretName != "cache-tls" {
+				t.Errorf("spec.secretName = %q, want %q", secretName, "cache-tls")
+			}
+			dnsNames, _, _ := unstructured.NestedStringSlice(cert.Object, "spec", "dnsNames")
+			if want := []string{"cache.prod.svc", "*.cache.prod.svc.cluster.local"}; !reflect.DeepEqual(dnsNames, want) {
+				t.Errorf("spec.dnsNames = %v, want %v", dnsNames, want)
+			}
+			issuerRef, _, _ := unstructured.NestedStringMap(cert.Object, "spec", "issuerRef")
+			wantRef := map[string]string{"name": "selfsigned", "kind": tt.wantKind, "group": "cert-manager.io"}
+			if !reflect.DeepEqual(issuerRef, wantRef) {
+				t.Errorf("spec.issuerRef = %v, want %v", issuerRef, wantRef)
+			}
+			if !reflect.DeepEqual(cert.GetLabels(), labelsForMemcached("cache")) {
+				t.Errorf("labels = %v, want %v", cert.GetLabels(), labelsForMemcached("cache"))
+			}
+		})
+	}
+}
+
+func TestConstructCertificate_DNSNames(t *testing.T) {
+	tests := []struct {
+		name     string
+		dnsNames []string
+		want     []string
+	}{
+		{
+			name: "defaults cover the Service and per-pod names",
+			want: []string{
+				"cache",
+				"cache.prod",
+				"cache.prod.svc",
+				"cache.prod.svc.cluster.local",
+				"*.cache.prod.svc",
+				"*.cache.prod.svc.cluster.local",
+			},
+		},
+		{
+			name:     "overrides replace the defaults",
+			dnsNames: []string{"memcached.example.com"},
+			want:     []string{"memcached.example.com"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := certificateMemcached("Issuer")
+			mc.Spec.Security.TLS.GenerateCertificate.DNSNames = tt.dnsNames
+			cert := newCertificate(mc)
+
+			if err := constructCertificate(mc, cert); err != nil {
+				t.Fatalf("constructCertificate() error = %v", err)
+			}
+
+			dnsNames, _, _ := unstructured.NestedStringSlice(cert.Object, "spec", "dnsNames")
+			if !reflect.DeepEqual(dnsNames, tt.want) {
+				t.Errorf("spec.dnsNames = %v, want %v", dnsNames, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	memcachedv1beta1 "github.com/c5c3/memcached-operator/api/v1beta1"
+)
+
+// clientCAKey is the key of the CA certificate in the TLS certificate Secret,
+// used to verify client certificates when spec.security.tls.enableClientCert is set.
+const clientCAKey = "ca.crt"
+
+// clientCAUnavailable reports whether mTLS is enabled but the TLS certificate
+// Secret has no non-empty ca.crt key, so memcached has no CA to verify client
+// certificates with. A missing Secret is not reported here, as it is already
+// reported as SecretNotFound.
+func (r *MemcachedReconciler) clientCAUnavailable(ctx context.Context, mc *memcachedv1beta1.Memcached) bool {
+	if !mc.IsTLSEnabled() || !mc.Spec.Security.TLS.EnableClientCert {
+		return false
+	}
+	secret := &corev1.Secret{}
+	key := types.NamespacedName{Name: mc.Spec.Security.TLS.CertificateSecretRef.Name, Namespace: mc.Namespace}
+	if err := r.Get(ctx, key, secret); err != nil {
+		return false
+	}
+	return len(secret.Data[clientCAKey]) == 0
+}
+
+// removeClientCA strips the client CA from the desired Deployment dep: the
+// ssl_ca_cert option from the memcached container and the ca.crt item from the
+// TLS volume, which would otherwise keep the pods from starting. ssl_verify_mode=2
+// is kept, so memcached fails closed and rejects every client certificate rather
+// than silently accepting clients without one.
+func removeClientCA(dep *appsv1.Deployment) {
+	caOption := "ssl_ca_cert=" + tlsMountPath + "/" + clientCAKey
+	for i := range dep.Spec.Template.Spec.Containers {
+		c := &dep.Spec.Template.Spec.Containers[i]
+		if c.Name != "memcached" {
+			continue
+		}
+		for j := 0; j+1 < len(c.Args); j++ {
+			if c.Args[j] == "-o" && c.Args[j+1] == caOption {
+				c.Args = slices.Delete(c.Args, j, j+2)
+				break
+			}
+		}
+	}
+	for i := range dep.Spec.Template.Spec.Volumes {
+		v := &dep.Spec.Template.Spec.Volumes[i]
+		if v.Name != tlsVolumeName || v.Secret == nil {
+			continue
+		}
+		v.Secret.Items = slices.DeleteFunc(v.Secret.Items, func(item corev1.KeyToPath) bool {
+			return item.Key == clientCAKey
+		})
+	}
+}
+
+// clientCAUnavailableCondition returns the Degraded condition reported when mTLS
+// is enabled but no client CA is available.
+func clientCAUnavailableCondition(mc *memcachedv1beta1.Memcached) *metav1.Condition {
+	return &metav1.Condition{
+		Type:   ConditionTypeDegraded,
+		Status: metav1.ConditionTrue,
+		Reason: ConditionReasonClientCAUnavailable,
+		Message: fmt.Sprintf("spec.security.tls.enableClientCert is set but Secret %s has no %s key; "+
+			"client certificates cannot be verified and all TLS clients are rejected",
+			mc.Spec.Security.TLS.CertificateSecretRef.Name, clientCAKey),
+		ObservedGeneration: mc.Generation,
+	}
+}
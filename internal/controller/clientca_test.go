@@ -0,0 +1,127 @@
+package controller
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	memcachedv1beta1 "github.com/c5c3/memcached-operator/api/v1beta1"
+)
+
+func newMTLSMemcached(enableClientCert bool) *memcachedv1beta1.Memcached {
+	return &memcachedv1beta1.Memcached{
+		ObjectMeta: metav1.ObjectMeta{Name: testInstanceName, Namespace: testDefaultNamespace, Generation: 1},
+		Spec: memcachedv1beta1.MemcachedSpec{
+			Replicas: int32Ptr(1),
+			Security: &memcachedv1beta1.SecuritySpec{
+				TLS: &memcachedv1beta1.TLSSpec{
+					Enabled:              true,
+					EnableClientCert:     enableClientCert,
+					CertificateSecretRef: corev1.LocalObjectReference{Name: "tls-secret"},
+				},
+			},
+		},
+	}
+}
+
+func tlsSecret(data map[string][]byte) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "tls-secret", Namespace: testDefaultNamespace},
+		Data:       data,
+	}
+}
+
+func TestClientCAUnavailable(t *testing.T) {
+	certAndKey := map[string][]byte{"tls.crt": []byte("cert"), "tls.key": []byte("key")}
+	withCA := map[string][]byte{"tls.crt": []byte("cert"), "tls.key": []byte("key"), "ca.crt": []byte("ca")}
+	emptyCA := map[string][]byte{"tls.crt": []byte("cert"), "tls.key": []byte("key"), "ca.crt": {}}
+
+	tests := []struct {
+		name             string
+		enableClientCert bool
+		secret           *corev1.Secret
+		want             bool
+	}{
+		{name: "mTLS disabled", enableClientCert: false, secret: tlsSecret(certAndKey), want: false},
+		{name: "Secret missing", enableClientCert: true, secret: nil, want: false},
+		{name: "ca.crt present", enableClientCert: true, secret: tlsSecret(withCA), want: false},
+		{name: "ca.crt absent", enableClientCert: true, secret: tlsSecret(certAndKey), want: true},
+		{name: "ca.crt empty", enableClientCert: true, secret: tlsSecret(emptyCA), want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := newMTLSMemcached(tt.enableClientCert)
+			objs := []client.Object{mc}
+			if tt.secret != nil {
+				objs = append(objs, tt.secret)
+			}
+			r := newTestReconciler(newFakeClient(objs...))
+			if got := r.clientCAUnavailable(context.Background(), mc); got != tt.want {
+				t.Errorf("clientCAUnavailable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRemoveClientCA(t *testing.T) {
+	mc := newMTLSMemcached(true)
+	dep := &appsv1.Deployment{}
+	constructDeployment(mc, dep, "", "")
+
+	removeClientCA(dep)
+
+	args := dep.Spec.Template.Spec.Containers[0].Args
+	if slices.Contains(args, "ssl_ca_cert="+tlsMountPath+"/ca.crt") {
+		t.Errorf("ssl_ca_cert still in args: %v", args)
+	}
+	if !slices.Contains(args, "ssl_verify_mode=2") {
+		t.Errorf("ssl_verify_mode=2 missing from args: %v", args)
+	}
+	for i, a := range args {
+		if a == "-o" && (i+1 == len(args) || args[i+1] == "-o") {
+			t.Errorf("dangling -o in args: %v", args)
+		}
+	}
+
+	for _, v := range dep.Spec.Template.Spec.Volumes {
+		if v.Name != tlsVolumeName {
+			continue
+		}
+		for _, item := range v.Secret.Items {
+			if item.Key == "ca.crt" {
+				t.Error("ca.crt item still projected into the TLS volume")
+			}
+		}
+		if len(v.Secret.Items) != 2 {
+			t.Errorf("TLS volume items = %v, want tls.crt and tls.key", v.Secret.Items)
+		}
+	}
+}
+
+func TestReconcileStatus_ClientCAUnavailable(t *testing.T) {
+	mc := newMTLSMemcached(true)
+	secret := tlsSecret(map[string][]byte{"tls.crt": []byte("cert"), "tls.key": []byte("key")})
+	c := fake.NewClientBuilder().WithScheme(testScheme()).WithObjects(mc, secret).
+		WithStatusSubresource(&memcachedv1beta1.Memcached{}).Build()
+	r := newTestReconciler(c)
+
+	if err := r.reconcileStatus(context.Background(), mc, nil); err != nil {
+		t.Fatalf("reconcileStatus: %v", err)
+	}
+
+	degraded := meta.FindStatusCondition(mc.Status.Conditions, ConditionTypeDegraded)
+	if degraded == nil || degraded.Status != metav1.ConditionTrue || degraded.Reason != ConditionReasonClientCAUnavailable {
+		t.Fatalf("Degraded = %+v, want True/%s", degraded, ConditionReasonClientCAUnavailable)
+	}
+	if mc.Status.Phase != PhaseDegraded {
+		t.Errorf("phase = %q, want %q", mc.Status.Phase, PhaseDegraded)
+	}
+}
@@ -0,0 +1,87 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	memcachedv1beta1 "github.com/c5c3/memcached-operator/api/v1beta1"
+)
+
+// annotationPodDeletionCost is the well-known annotation the ReplicaSet controller
+// consults to pick which pods to remove first on scale-down (lowest cost first).
+const annotationPodDeletionCost = "controller.kubernetes.io/pod-deletion-cost"
+
+// podDeletionCosts returns the desired deletion cost of each pod keyed by pod name.
+// A pod's cost is the number of live pods created after it, so the newest pod gets
+// 0 and the cost grows with age while staying stable between reconciles (unlike
+// the raw age, which would re-patch every pod on every reconcile). Pods created at
+// the same instant share a cost. Terminating pods are skipped.
+func podDeletionCosts(pods []corev1.Pod) map[string]string {
+	live := make([]corev1.Pod, 0, len(pods))
+	for _, p := range pods {
+		if p.DeletionTimestamp == nil {
+			live = append(live, p)
+		}
+	}
+	// Newest first.
+	sort.SliceStable(live, func(i, j int) bool {
+		return live[j].CreationTimestamp.Before(&live[i].CreationTimestamp)
+	})
+
+	costs := make(map[string]string, len(live))
+	cost := 0
+	for i, p := range live {
+		if i > 0 && !live[i-1].CreationTimestamp.Equal(&p.CreationTimestamp) {
+			cost = i
+		}
+		costs[p.Name] = strconv.Itoa(cost)
+	}
+	return costs
+}
+
+// reconcilePodDeletionCost keeps the pod-deletion-cost annotation of the Memcached
+// pods in line with spec.preserveWarmPodsOnScaleDown. When enabled, pods are
+// annotated by age so the newest (coldest) pods are removed first on scale-down;
+// when disabled, annotations left behind by an earlier reconcile are removed.
+func (r *MemcachedReconciler) reconcilePodDeletionCost(ctx context.Context, mc *memcachedv1beta1.Memcached) error {
+	pods, err := r.listMemcachedPods(ctx, mc)
+	if err != nil {
+		return err
+	}
+
+	var costs map[string]string
+	if mc.Spec.PreserveWarmPodsOnScaleDown {
+		costs = podDeletionCosts(pods)
+	}
+
+	for i := range pods {
+		pod := &pods[i]
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+		current, has := pod.Annotations[annotationPodDeletionCost]
+		desired, want := costs[pod.Name]
+		if has == want && current == desired {
+			continue
+		}
+
+		patch := client.MergeFrom(pod.DeepCopy())
+		if want {
+			if pod.Annotations == nil {
+				pod.Annotations = make(map[string]string, 1)
+			}
+			pod.Annotations[annotationPodDeletionCost] = desired
+		} else {
+			delete(pod.Annotations, annotationPodDeletionCost)
+		}
+		if err := r.Patch(ctx, pod, patch); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("patching deletion cost of pod %s: %w", pod.Name, err)
+		}
+	}
+	return nil
+}
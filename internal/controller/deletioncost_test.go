@@ -0,0 +1,152 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	memcachedv1beta1 "github.com/c5c3/memcached-operator/api/v1beta1"
+)
+
+func podCreatedAt(name string, created time.Time) corev1.Pod {
+	return corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         testDefaultNamespace,
+			Labels:            labelsForMemcached(testInstanceName),
+			CreationTimestamp: metav1.NewTime(created),
+		},
+	}
+}
+
+func TestPodDeletionCosts(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	terminating := podCreatedAt("terminating", now.Add(-time.Hour))
+	deletedAt := metav1.NewTime(now)
+	terminating.DeletionTimestamp = &deletedAt
+
+	tests := []struct {
+		name string
+		pods []corev1.Pod
+		want map[string]string
+	}{
+		{name: "no pods", pods: nil, want: map[string]string{}},
+		{
+			name: "older pods cost more",
+			pods: []corev1.Pod{
+				podCreatedAt("middle", now.Add(-time.Minute)),
+				podCreatedAt("newest", now),
+				podCreatedAt("oldest", now.Add(-time.Hour)),
+			},
+			want: map[string]string{"newest": "0", "middle": "1", "oldest": "2"},
+		},
+		{
+			name: "pods created together share a cost",
+			pods: []corev1.Pod{
+				podCreatedAt("a", now.Add(-time.Hour)),
+				podCreatedAt("b", now.Add(-time.Hour)),
+				podCreatedAt("c", now),
+			},
+			want: map[string]string{"a": "1", "b": "1", "c": "0"},
+		},
+		{
+			name: "terminating pods are skipped",
+			pods: []corev1.Pod{terminating, podCreatedAt("live", now)},
+			want: map[string]string{"live": "0"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := podDeletionCosts(tt.pods)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+			for name, cost := range tt.want {
+				if got[name] != cost {
+					t.Errorf("cost of %s = %q, want %q", name, got[name], cost)
+				}
+			}
+		})
+	}
+}
+
+func TestReconcilePodDeletionCost(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now().Truncate(time.Second)
+	mc := &memcachedv1beta1.Memcached{
+		ObjectMeta: metav1.ObjectMeta{Name: testInstanceName, Namespace: testDefaultNamespace},
+		Spec:       memcachedv1beta1.MemcachedSpec{PreserveWarmPodsOnScaleDown: true},
+	}
+	old := podCreatedAt("old", now.Add(-time.Hour))
+	young := podCreatedAt("young", now)
+	c := newFakeClient(mc, &old, &young)
+	r := newTestReconciler(c)
+
+	costOf := func(name string) (string, bool) {
+		t.Helper()
+		pod := &corev1.Pod{}
+		if err := c.Get(ctx, client.ObjectKey{Name: name, Namespace: testDefaultNamespace}, pod); err != nil {
+			t.Fatalf("failed to get pod %s: %v", name, err)
+		}
+		cost, ok := pod.Annotations[annotationPodDeletionCost]
+		return cost, ok
+	}
+
+	if err := r.reconcilePodDeletionCost(ctx, mc); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cost, _ := costOf("old"); cost != "1" {
+		t.Errorf("old pod cost = %q, want %q", cost, "1")
+	}
+	if cost, _ := costOf("young"); cost != "0" {
+		t.Errorf("young pod cost = %q, want %q", cost, "0")
+	}
+
+	mc.Spec.PreserveWarmPodsOnScaleDown = false
+	if err := r.reconcilePodDeletionCost(ctx, mc); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, name := range []string{"old", "young"} {
+		if _, ok := costOf(name); ok {
+			t.Errorf("pod %s still carries %s after disabling", name, annotationPodDeletionCost)
+		}
+	}
+}
+
+func TestReconcilePodDeletionCost_SkipsCanaryPods(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now().Truncate(time.Second)
+	mc := &memcachedv1beta1.Memcached{
+		ObjectMeta: metav1.ObjectMeta{Name: testInstanceName, Namespace: testDefaultNamespace},
+		Spec:       memcachedv1beta1.MemcachedSpec{PreserveWarmPodsOnScaleDown: true},
+	}
+	regular := podCreatedAt("regular", now)
+	canary := podCreatedAt("canary", now.Add(-time.Hour))
+	canary.Labels = labelsForCanary(testInstanceName)
+	c := newFakeClient(mc, &regular, &canary)
+	r := newTestReconciler(c)
+
+	if err := r.reconcilePodDeletionCost(ctx, mc); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := &corev1.Pod{}
+	if err := c.Get(ctx, client.ObjectKeyFromObject(&canary), got); err != nil {
+		t.Fatalf("failed to get canary pod: %v", err)
+	}
+	if cost, ok := got.Annotations[annotationPodDeletionCost]; ok {
+		t.Errorf("canary pod carries %s=%q, want no annotation", annotationPodDeletionCost, cost)
+	}
+	if err := c.Get(ctx, client.ObjectKeyFromObject(&regular), got); err != nil {
+		t.Fatalf("failed to get regular pod: %v", err)
+	}
+	// The older canary pod is not counted, so the only regular pod is the newest.
+	if cost := got.Annotations[annotationPodDeletionCost]; cost != "0" {
+		t.Errorf("regular pod cost = %q, want %q", cost, "0")
+	}
+}
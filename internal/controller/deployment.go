@@ -2,13 +2,22 @@
 package controller
 
 import (
+	"errors"
 	"fmt"
+	"maps"
+	"path"
+	"slices"
+	"sort"
+	"strconv"
 	"strings"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation"
 
 	memcachedv1beta1 "github.com/c5c3/memcached-operator/api/v1beta1"
 )
@@ -17,24 +26,63 @@ import (
 // These labels are used for selectors and must not include mutable fields like version.
 func labelsForMemcached(name string) map[string]string {
 	return map[string]string{
-		"app.kubernetes.io/name":       "memcached",
-		"app.kubernetes.io/instance":   name,
-		"app.kubernetes.io/managed-by": "memcached-operator",
+		"app.kubernetes.io/name":     "memcached",
+		"app.kubernetes.io/instance": name,
+		LabelManagedBy:               ManagedByOperator,
 	}
 }
 
-// imageVersion extracts the tag portion from a container image reference.
-// For example, "memcached:1.6" returns "1.6" and "registry.io/img:v2" returns "v2".
-// Returns an empty string if no tag is present.
+// LabelManagedBy set to ManagedByOperator marks every resource the operator manages,
+// including the Pods and EndpointSlices that inherit it from the Deployment and
+// Service. The manager cache only holds Pods and EndpointSlices carrying it.
+const (
+	LabelManagedBy    = "app.kubernetes.io/managed-by"
+	ManagedByOperator = "memcached-operator"
+)
+
+// imageVersionUnknown is the app.kubernetes.io/version label value for images
+// whose reference carries no usable tag, such as digest-pinned images.
+const imageVersionUnknown = "unknown"
+
+// imageVersion extracts the tag portion from a container image reference for the
+// app.kubernetes.io/version label. For example, "memcached:1.6.29" returns "1.6.29"
+// and "registry.io:5000/img:v2" returns "v2". Digest-pinned images without a tag, and
+// tags that are not valid label values, return imageVersionUnknown. Returns an empty
+// string if the image is neither tagged nor pinned.
 func imageVersion(image string) string {
-	// Handle digests (image@sha256:...) — no meaningful version tag.
-	if strings.Contains(image, "@") {
+	name, _, pinned := strings.Cut(image, "@")
+	// Only a colon after the last slash starts a tag; earlier ones belong to a registry port.
+	name = name[strings.LastIndex(name, "/")+1:]
+	idx := strings.LastIndex(name, ":")
+	if idx == -1 {
+		if pinned {
+			return imageVersionUnknown
+		}
 		return ""
 	}
-	if idx := strings.LastIndex(image, ":"); idx != -1 {
-		return image[idx+1:]
+	if tag := name[idx+1:]; len(validation.IsValidLabelValue(tag)) == 0 {
+		return tag
 	}
-	return ""
+	return imageVersionUnknown
+}
+
+// imagePullPolicy returns the pull policy for image: spec.imagePullPolicy when set,
+// otherwise Always for untagged and ":latest" images and IfNotPresent for versioned
+// tags and digests. Setting it explicitly keeps the Pod template independent of
+// API server defaulting.
+func imagePullPolicy(mc *memcachedv1beta1.Memcached, image string) corev1.PullPolicy {
+	if mc.Spec.ImagePullPolicy != nil {
+		return *mc.Spec.ImagePullPolicy
+	}
+	if strings.Contains(image, "@") {
+		return corev1.PullIfNotPresent
+	}
+	// Only a colon after the last slash starts a tag; earlier ones belong to a registry port.
+	name := image[strings.LastIndex(image, "/")+1:]
+	if idx := strings.LastIndex(name, ":"); idx != -1 && name[idx+1:] != "latest" {
+		return corev1.PullIfNotPresent
+	}
+	return corev1.PullAlways
 }
 
 // buildMemcachedArgs constructs the command-line arguments for a memcached process
@@ -75,12 +123,51 @@ func buildMemcachedArgs(config *memcachedv1beta1.MemcachedConfig, sasl *memcache
 		"-I", maxItemSize,
 	}
 
-	// Verbosity: 1 → "-v", 2 → "-vv".
+	// Listen addresses: one -l flag per address. "$(POD_IP)" is expanded by the
+	// kubelet from the POD_IP environment variable set by buildMemcachedEnv. With
+	// TLS, the plaintext and TLS listeners are laid out by tlsListenArgs instead.
+	unixSocket := config.UnixSocket != nil && config.UnixSocket.Enabled
+	if tls != nil && tls.Enabled && !unixSocket {
+		args = append(args, tlsListenArgs(config.ListenAddresses, tlsListenPort(tls))...)
+	} else {
+		for _, addr := range config.ListenAddresses {
+			args = append(args, "-l", addr)
+		}
+	}
+
+	// Unix socket: -s <path>. memcached does not open TCP listeners while set.
+	if unixSocket {
+		socketPath := memcachedv1beta1.DefaultUnixSocketPath
+		if config.UnixSocket.Path != nil {
+			socketPath = *config.UnixSocket.Path
+		}
+		args = append(args, "-s", socketPath)
+	}
+
+	// Verbosity: 1 → "-v", 2 → "-vv", 3 → "-vvv".
 	switch config.Verbosity {
 	case 1:
 		args = append(args, "-v")
 	case 2:
 		args = append(args, "-vv")
+	case 3:
+		args = append(args, "-vvv")
+	}
+
+	// Extended options precede the TLS options so that all operator-generated
+	// "-o" flags are grouped ahead of user-supplied extra args.
+	if config.IdleTimeoutSeconds != nil {
+		args = append(args, "-o", fmt.Sprintf("idle_timeout=%d", *config.IdleTimeoutSeconds))
+	}
+	if config.SlabReassign != nil {
+		if *config.SlabReassign {
+			args = append(args, "-o", "slab_reassign")
+		} else {
+			args = append(args, "-o", "no_slab_reassign")
+		}
+	}
+	if config.SlabAutomove != nil {
+		args = append(args, "-o", fmt.Sprintf("slab_automove=%d", *config.SlabAutomove))
 	}
 
 	// SASL authentication: -Y <password-file>.
@@ -88,7 +175,9 @@ func buildMemcachedArgs(config *memcachedv1beta1.MemcachedConfig, sasl *memcache
 		args = append(args, "-Y", saslMountPath+"/password-file")
 	}
 
-	// TLS encryption: -Z, -o ssl_chain_cert, -o ssl_key, optionally -o ssl_ca_cert.
+	// TLS encryption: -Z, -o ssl_chain_cert, -o ssl_key, and for mTLS -o ssl_ca_cert
+	// with ssl_verify_mode=2, which makes memcached require a client certificate;
+	// its default mode (0) accepts clients without one.
 	if tls != nil && tls.Enabled {
 		args = append(args,
 			"-Z",
@@ -96,7 +185,10 @@ func buildMemcachedArgs(config *memcachedv1beta1.MemcachedConfig, sasl *memcache
 			"-o", "ssl_key="+tlsMountPath+"/tls.key",
 		)
 		if tls.EnableClientCert {
-			args = append(args, "-o", "ssl_ca_cert="+tlsMountPath+"/ca.crt")
+			args = append(args,
+				"-o", "ssl_ca_cert="+tlsMountPath+"/ca.crt",
+				"-o", "ssl_verify_mode=2",
+			)
 		}
 	}
 
@@ -108,6 +200,82 @@ func buildMemcachedArgs(config *memcachedv1beta1.MemcachedConfig, sasl *memcache
 	return args
 }
 
+// tlsListenArgs returns the -l flags for a TLS-enabled memcached. -Z turns every
+// listener into a TLS listener unless its address carries the "notls:" prefix, so
+// the plaintext listeners are bound with that prefix on PortMemcached and a TLS
+// listener is bound on tlsPort. Unset addresses listen on all interfaces. The TLS
+// listener shares the plaintext addresses, except that it binds to the Pod IP
+// when plaintext is restricted to loopback, so clients and probes can reach it.
+func tlsListenArgs(addresses []string, tlsPort int32) []string {
+	if len(addresses) == 0 {
+		addresses = []string{"*"}
+	}
+
+	var args []string
+	tlsAddresses := make([]string, 0, len(addresses))
+	for _, addr := range addresses {
+		args = append(args, "-l", "notls:"+listenAddressWithPort(addr, PortMemcached))
+		if !memcachedv1beta1.IsLoopbackAddress(addr) {
+			tlsAddresses = append(tlsAddresses, addr)
+		}
+	}
+	if len(tlsAddresses) == 0 {
+		tlsAddresses = append(tlsAddresses, memcachedv1beta1.PodIPToken)
+	}
+	for _, addr := range tlsAddresses {
+		args = append(args, "-l", listenAddressWithPort(addr, tlsPort))
+	}
+	return args
+}
+
+// listenAddressWithPort joins a memcached listen address and port, bracketing
+// IPv6 literals as memcached expects.
+func listenAddressWithPort(addr string, port int32) string {
+	if strings.Contains(addr, ":") && !strings.HasPrefix(addr, "[") {
+		addr = "[" + addr + "]"
+	}
+	return fmt.Sprintf("%s:%d", addr, port)
+}
+
+// tlsListenPort returns spec.security.tls.port, or PortMemcachedTLS when unset.
+func tlsListenPort(tls *memcachedv1beta1.TLSSpec) int32 {
+	if tls.Port != nil {
+		return *tls.Port
+	}
+	return PortMemcachedTLS
+}
+
+// errSelectorImmutableConflict is returned from the Deployment mutate function when
+// an existing Deployment's selector cannot match the managed pod labels.
+var errSelectorImmutableConflict = errors.New("existing Deployment selector does not match the managed labels")
+
+// selectorMatchesLabels reports whether sel selects a pod carrying lbls. A nil or
+// unparsable selector never matches.
+func selectorMatchesLabels(sel *metav1.LabelSelector, lbls map[string]string) bool {
+	if sel == nil {
+		return false
+	}
+	selector, err := metav1.LabelSelectorAsSelector(sel)
+	if err != nil || selector.Empty() {
+		return false
+	}
+	return selector.Matches(labels.Set(lbls))
+}
+
+// selectorConflictCondition returns the Degraded condition reported while the
+// existing Deployment's immutable selector cannot match the managed pod labels.
+func selectorConflictCondition(mc *memcachedv1beta1.Memcached, dep *appsv1.Deployment) *metav1.Condition {
+	return &metav1.Condition{
+		Type:   ConditionTypeDegraded,
+		Status: metav1.ConditionTrue,
+		Reason: ConditionReasonSelectorConflict,
+		Message: fmt.Sprintf("Deployment %s has selector %s, which does not match the managed labels and cannot be "+
+			"changed; delete the Deployment to let the operator recreate it",
+			dep.Name, metav1.FormatLabelSelector(dep.Spec.Selector)),
+		ObservedGeneration: mc.Generation,
+	}
+}
+
 // buildAntiAffinity returns a PodAntiAffinity-based Affinity for the given Memcached CR,
 // or nil if no anti-affinity is configured.
 func buildAntiAffinity(mc *memcachedv1beta1.Memcached) *corev1.Affinity {
@@ -153,13 +321,55 @@ func buildAntiAffinity(mc *memcachedv1beta1.Memcached) *corev1.Affinity {
 	}
 }
 
+// defaultClientAffinityWeight is the client affinity weight used when
+// spec.highAvailability.clientAffinity.weight is unset.
+const defaultClientAffinityWeight = 100
+
+// buildClientAffinity returns a PodAffinity preferring nodes that run the client
+// pods selected by spec.highAvailability.clientAffinity, or nil if it is not configured.
+func buildClientAffinity(mc *memcachedv1beta1.Memcached) *corev1.PodAffinity {
+	if mc.Spec.HighAvailability == nil || mc.Spec.HighAvailability.ClientAffinity == nil {
+		return nil
+	}
+
+	ca := mc.Spec.HighAvailability.ClientAffinity
+	weight := ca.Weight
+	if weight == 0 {
+		weight = defaultClientAffinityWeight
+	}
+	return &corev1.PodAffinity{
+		PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{
+			{
+				Weight: weight,
+				PodAffinityTerm: corev1.PodAffinityTerm{
+					TopologyKey:   "kubernetes.io/hostname",
+					LabelSelector: ca.PodSelector.DeepCopy(),
+				},
+			},
+		},
+	}
+}
+
 // buildTopologySpreadConstraints returns the topology spread constraints from the Memcached CR,
-// or nil if none are configured.
+// or nil if none are configured. With spreadPerRevision, pod-template-hash is added to the
+// matchLabelKeys of each constraint that has a labelSelector, which matchLabelKeys requires.
 func buildTopologySpreadConstraints(mc *memcachedv1beta1.Memcached) []corev1.TopologySpreadConstraint {
 	if mc.Spec.HighAvailability == nil || len(mc.Spec.HighAvailability.TopologySpreadConstraints) == 0 {
 		return nil
 	}
-	return mc.Spec.HighAvailability.TopologySpreadConstraints
+	if !mc.Spec.HighAvailability.SpreadPerRevision {
+		return mc.Spec.HighAvailability.TopologySpreadConstraints
+	}
+
+	constraints := make([]corev1.TopologySpreadConstraint, len(mc.Spec.HighAvailability.TopologySpreadConstraints))
+	for i := range mc.Spec.HighAvailability.TopologySpreadConstraints {
+		c := mc.Spec.HighAvailability.TopologySpreadConstraints[i].DeepCopy()
+		if c.LabelSelector != nil && !slices.Contains(c.MatchLabelKeys, appsv1.DefaultDeploymentUniqueLabelKey) {
+			c.MatchLabelKeys = append(c.MatchLabelKeys, appsv1.DefaultDeploymentUniqueLabelKey)
+		}
+		constraints[i] = *c
+	}
+	return constraints
 }
 
 // buildGracefulShutdown returns the Lifecycle hook and terminationGracePeriodSeconds for graceful
@@ -173,7 +383,7 @@ func buildGracefulShutdown(mc *memcachedv1beta1.Memcached) (*corev1.Lifecycle, *
 
 	preStopDelaySeconds := gs.PreStopDelaySeconds
 	if preStopDelaySeconds == 0 {
-		preStopDelaySeconds = 10
+		preStopDelaySeconds = 15
 	}
 
 	terminationGracePeriod := gs.TerminationGracePeriodSeconds
@@ -192,6 +402,136 @@ func buildGracefulShutdown(mc *memcachedv1beta1.Memcached) (*corev1.Lifecycle, *
 	return lifecycle, &terminationGracePeriod
 }
 
+// Downward API environment variables of the memcached container.
+const (
+	envPodName      = "POD_NAME"
+	envPodNamespace = "POD_NAMESPACE"
+	envPodIP        = "POD_IP"
+)
+
+// buildMemcachedCommand returns the entrypoint override of the memcached container:
+// the mounted entrypoint script when configured, otherwise spec.memcached.command,
+// or nil to keep the image's entrypoint.
+func buildMemcachedCommand(mc *memcachedv1beta1.Memcached) []string {
+	if isEntrypointConfigured(mc) {
+		return []string{path.Join(entrypointMountPath, *mc.Spec.Memcached.EntrypointPath)}
+	}
+	if mc.Spec.Memcached == nil || len(mc.Spec.Memcached.Command) == 0 {
+		return nil
+	}
+	return mc.Spec.Memcached.Command
+}
+
+// guaranteedResources lists the resources whose requests and limits must match for
+// the Guaranteed QoS class.
+var guaranteedResources = []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory}
+
+// buildMemcachedResources returns the resources of the memcached container. For
+// spec.qosClass Guaranteed, see containerResources.
+func buildMemcachedResources(mc *memcachedv1beta1.Memcached) corev1.ResourceRequirements {
+	return containerResources(mc, mc.Spec.Resources)
+}
+
+// containerResources returns a copy of resources, or empty requirements when nil.
+// For spec.qosClass Guaranteed, each CPU and memory value provided only as a
+// request or only as a limit is copied to the other side so requests equal limits.
+func containerResources(mc *memcachedv1beta1.Memcached, resources *corev1.ResourceRequirements) corev1.ResourceRequirements {
+	if resources == nil {
+		return corev1.ResourceRequirements{}
+	}
+	if mc.Spec.QoSClass != corev1.PodQOSGuaranteed {
+		return *resources
+	}
+
+	out := *resources.DeepCopy()
+	for _, name := range guaranteedResources {
+		request, hasRequest := out.Requests[name]
+		limit, hasLimit := out.Limits[name]
+		switch {
+		case hasRequest && !hasLimit:
+			if out.Limits == nil {
+				out.Limits = corev1.ResourceList{}
+			}
+			out.Limits[name] = request
+		case hasLimit && !hasRequest:
+			if out.Requests == nil {
+				out.Requests = corev1.ResourceList{}
+			}
+			out.Requests[name] = limit
+		}
+	}
+	return out
+}
+
+// entrypointVolumeName is the name used for the entrypoint script ConfigMap volume.
+const entrypointVolumeName = "memcached-entrypoint"
+
+// entrypointMountPath is the directory the entrypoint script is mounted into.
+const entrypointMountPath = "/etc/memcached/entrypoint"
+
+// entrypointScriptMode makes the mounted entrypoint script readable and executable.
+const entrypointScriptMode = int32(0o555)
+
+// isEntrypointConfigured reports whether both the entrypoint ConfigMap and the
+// script key are set.
+func isEntrypointConfigured(mc *memcachedv1beta1.Memcached) bool {
+	return mc.Spec.Memcached != nil &&
+		mc.Spec.Memcached.EntrypointConfigMapRef != nil &&
+		mc.Spec.Memcached.EntrypointPath != nil
+}
+
+// buildEntrypointVolume returns a ConfigMap Volume projecting the entrypoint script
+// key as an executable file, or nil if no entrypoint script is configured.
+func buildEntrypointVolume(mc *memcachedv1beta1.Memcached) *corev1.Volume {
+	if !isEntrypointConfigured(mc) {
+		return nil
+	}
+	mode := entrypointScriptMode
+	key := *mc.Spec.Memcached.EntrypointPath
+	return &corev1.Volume{
+		Name: entrypointVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: *mc.Spec.Memcached.EntrypointConfigMapRef,
+				Items:                []corev1.KeyToPath{{Key: key, Path: key}},
+				DefaultMode:          &mode,
+			},
+		},
+	}
+}
+
+// buildEntrypointVolumeMount returns a read-only VolumeMount of the entrypoint
+// script volume, or nil if no entrypoint script is configured.
+func buildEntrypointVolumeMount(mc *memcachedv1beta1.Memcached) *corev1.VolumeMount {
+	if !isEntrypointConfigured(mc) {
+		return nil
+	}
+	return &corev1.VolumeMount{
+		Name:      entrypointVolumeName,
+		MountPath: entrypointMountPath,
+		ReadOnly:  true,
+	}
+}
+
+// buildMemcachedEnv returns the environment variables of the memcached container:
+// POD_NAME, POD_NAMESPACE and POD_IP from the downward API, for log correlation and
+// so the kubelet can expand memcachedv1beta1.PodIPToken in the container args.
+func buildMemcachedEnv() []corev1.EnvVar {
+	fieldEnv := func(name, fieldPath string) corev1.EnvVar {
+		return corev1.EnvVar{
+			Name: name,
+			ValueFrom: &corev1.EnvVarSource{
+				FieldRef: &corev1.ObjectFieldSelector{APIVersion: "v1", FieldPath: fieldPath},
+			},
+		}
+	}
+	return []corev1.EnvVar{
+		fieldEnv(envPodName, "metadata.name"),
+		fieldEnv(envPodNamespace, "metadata.namespace"),
+		fieldEnv(envPodIP, "status.podIP"),
+	}
+}
+
 // buildExporterContainer returns a memcached-exporter sidecar container when monitoring is enabled,
 // or nil if monitoring is disabled or not configured.
 func buildExporterContainer(mc *memcachedv1beta1.Memcached) *corev1.Container {
@@ -204,15 +544,14 @@ func buildExporterContainer(mc *memcachedv1beta1.Memcached) *corev1.Container {
 		image = *mc.Spec.Monitoring.ExporterImage
 	}
 
-	var resources corev1.ResourceRequirements
-	if mc.Spec.Monitoring.ExporterResources != nil {
-		resources = *mc.Spec.Monitoring.ExporterResources
-	}
+	resources := containerResources(mc, mc.Spec.Monitoring.ExporterResources)
 
-	return &corev1.Container{
-		Name:      "exporter",
-		Image:     image,
-		Resources: resources,
+	container := &corev1.Container{
+		Name:            "exporter",
+		Image:           image,
+		ImagePullPolicy: imagePullPolicy(mc, image),
+		Args:            []string{"--memcached.address=" + sidecarMemcachedAddress(mc)},
+		Resources:       resources,
 		Ports: []corev1.ContainerPort{
 			{
 				Name:          "metrics",
@@ -221,11 +560,230 @@ func buildExporterContainer(mc *memcachedv1beta1.Memcached) *corev1.Container {
 			},
 		},
 	}
+
+	for _, group := range mc.Spec.Monitoring.DisabledMetricGroups {
+		container.Args = append(container.Args, "--no-memcached."+group)
+	}
+
+	if vm := buildUnixSocketVolumeMount(mc); vm != nil {
+		container.VolumeMounts = append(container.VolumeMounts, *vm)
+	}
+
+	container.ReadinessProbe = buildExporterReadinessProbe(mc)
+
+	// Serve /metrics over HTTPS using the exporter-toolkit web config file.
+	if mc.IsExporterTLSEnabled() {
+		container.Args = append(container.Args, "--web.config.file="+exporterTLSMountPath+"/"+exporterWebConfigFile)
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+			Name:      exporterTLSVolumeName,
+			MountPath: exporterTLSMountPath,
+			ReadOnly:  true,
+		})
+	}
+
+	return container
+}
+
+// sidecarMemcachedAddress returns the address the exporter and stats sidecars use
+// to reach memcached in the same Pod: spec.monitoring.exporterMemcachedAddress when
+// set, else the unix socket path when enabled, which disables the TCP listener,
+// else localhost on the memcached port.
+func sidecarMemcachedAddress(mc *memcachedv1beta1.Memcached) string {
+	if mc.Spec.Monitoring != nil && mc.Spec.Monitoring.ExporterMemcachedAddress != nil {
+		return *mc.Spec.Monitoring.ExporterMemcachedAddress
+	}
+	// A unix socket path is dialed directly by the sidecars' memcache clients.
+	if mc.IsUnixSocketEnabled() {
+		return mc.UnixSocketPath()
+	}
+	return fmt.Sprintf("localhost:%d", PortMemcached)
+}
+
+// buildExporterReadinessProbe returns an exec readiness probe that scrapes the
+// exporter's own /metrics endpoint and only succeeds while it reports memcached_up 1,
+// or nil unless spec.monitoring.exporterReadinessProbe is set. The self-signed
+// exporter certificate is not verified, since the probe only checks the gauge.
+func buildExporterReadinessProbe(mc *memcachedv1beta1.Memcached) *corev1.Probe {
+	if !mc.Spec.Monitoring.ExporterReadinessProbe {
+		return nil
+	}
+
+	return &corev1.Probe{
+		ProbeHandler: corev1.ProbeHandler{
+			Exec: &corev1.ExecAction{
+				Command: []string{"sh", "-c", exporterMetricsFetch(mc) + " | grep -q '^memcached_up 1'"},
+			},
+		},
+		InitialDelaySeconds: 5,
+		PeriodSeconds:       10,
+	}
+}
+
+// exporterMetricsFetch returns a wget command line printing the exporter's own
+// /metrics to stdout. The self-signed exporter certificate is not verified.
+func exporterMetricsFetch(mc *memcachedv1beta1.Memcached) string {
+	if mc.IsExporterTLSEnabled() {
+		return fmt.Sprintf("wget -qO- --no-check-certificate https://localhost:%d/metrics", PortMetrics)
+	}
+	return fmt.Sprintf("wget -qO- http://localhost:%d/metrics", PortMetrics)
+}
+
+// pushGatewayContainerName is the name of the Pushgateway push sidecar container.
+const pushGatewayContainerName = "pushgateway"
+
+// buildPushGatewayContainer returns a sidecar that pushes the exporter's metrics to
+// the Pushgateway every spec.monitoring.pushGateway.intervalSeconds, or nil unless
+// monitoring and the Pushgateway are both configured. It runs the exporter image,
+// whose busybox wget both fetches /metrics and POSTs them to
+// <url>/metrics/job/<jobName>/instance/<pod name>. A failed push is logged and
+// retried on the next tick. Groups of pods that are gone are deleted by
+// prunePushGatewayGroups.
+func buildPushGatewayContainer(mc *memcachedv1beta1.Memcached) *corev1.Container {
+	if !mc.IsMonitoringEnabled() || mc.Spec.Monitoring.PushGateway == nil {
+		return nil
+	}
+	pg := mc.Spec.Monitoring.PushGateway
+
+	image := memcachedv1beta1.DefaultExporterImage
+	if mc.Spec.Monitoring.ExporterImage != nil {
+		image = *mc.Spec.Monitoring.ExporterImage
+	}
+	job := pushGatewayJob(mc)
+	interval := memcachedv1beta1.DefaultPushGatewayIntervalSeconds
+	if pg.IntervalSeconds != nil {
+		interval = *pg.IntervalSeconds
+	}
+
+	// Stop pushing as soon as the pod is terminated, so a terminating pod does not
+	// recreate a group the operator already deleted. Sleeping in the background
+	// lets the trap run at once.
+	script := "trap 'exit 0' TERM\n" +
+		"while true; do\n" +
+		"  " + exporterMetricsFetch(mc) + " | wget -q -O /dev/null --header 'Content-Type: text/plain; version=0.0.4' " +
+		"--post-file /dev/stdin \"${PUSHGATEWAY_URL%/}/metrics/job/${PUSHGATEWAY_JOB}/instance/${POD_NAME}\" " +
+		"|| echo \"push to ${PUSHGATEWAY_URL} failed\" >&2\n" +
+		"  sleep \"${PUSH_INTERVAL_SECONDS}\" &\n" +
+		"  wait $!\n" +
+		"done"
+
+	return &corev1.Container{
+		Name:            pushGatewayContainerName,
+		Image:           image,
+		ImagePullPolicy: imagePullPolicy(mc, image),
+		Command:         []string{"sh", "-c", script},
+		Env: []corev1.EnvVar{
+			{Name: "PUSHGATEWAY_URL", Value: pg.URL},
+			{Name: "PUSHGATEWAY_JOB", Value: job},
+			{Name: "PUSH_INTERVAL_SECONDS", Value: strconv.Itoa(int(interval))},
+			{
+				Name: envPodName,
+				ValueFrom: &corev1.EnvVarSource{
+					FieldRef: &corev1.ObjectFieldSelector{APIVersion: "v1", FieldPath: "metadata.name"},
+				},
+			},
+		},
+	}
+}
+
+// statsPortName is the name used for the stats sidecar container and service port.
+const statsPortName = "stats"
+
+// buildStatsSidecarContainer returns the stats sidecar container when it is enabled,
+// or nil otherwise. The sidecar is told where memcached listens (see
+// sidecarMemcachedAddress) and which port to serve on via the MEMCACHED_ADDRESS and
+// STATS_PORT environment variables, and shares the unix socket volume when enabled.
+func buildStatsSidecarContainer(mc *memcachedv1beta1.Memcached) *corev1.Container {
+	if !mc.IsStatsSidecarEnabled() || mc.Spec.StatsSidecar.Image == nil {
+		return nil
+	}
+
+	image := *mc.Spec.StatsSidecar.Image
+	port := mc.StatsSidecarPort()
+	container := &corev1.Container{
+		Name:            "stats",
+		Image:           image,
+		ImagePullPolicy: imagePullPolicy(mc, image),
+		Env: []corev1.EnvVar{
+			{Name: "MEMCACHED_ADDRESS", Value: sidecarMemcachedAddress(mc)},
+			{Name: "STATS_PORT", Value: fmt.Sprintf("%d", port)},
+		},
+		Ports: []corev1.ContainerPort{
+			{
+				Name:          statsPortName,
+				ContainerPort: port,
+				Protocol:      corev1.ProtocolTCP,
+			},
+		},
+	}
+	if vm := buildUnixSocketVolumeMount(mc); vm != nil {
+		container.VolumeMounts = append(container.VolumeMounts, *vm)
+	}
+	return container
+}
+
+// AnnotationExporterWebConfig is the Pod template annotation key holding the exporter-toolkit
+// web config, projected into the exporter TLS volume via the downward API.
+const AnnotationExporterWebConfig = "memcached.c5c3.io/exporter-web-config"
+
+// exporterTLSVolumeName is the name used for the exporter TLS volume.
+const exporterTLSVolumeName = "exporter-tls"
+
+// exporterTLSMountPath is the path where the exporter TLS material is mounted in the exporter container.
+const exporterTLSMountPath = "/etc/memcached-exporter/tls"
+
+// exporterWebConfigFile is the file name of the exporter-toolkit web config inside exporterTLSMountPath.
+const exporterWebConfigFile = "web-config.yml"
+
+// exporterWebConfig is the exporter-toolkit web config enabling TLS with the mounted serving certificate.
+const exporterWebConfig = "tls_server_config:\n" +
+	"  cert_file: " + exporterTLSMountPath + "/tls.crt\n" +
+	"  key_file: " + exporterTLSMountPath + "/tls.key\n"
+
+// buildExporterTLSVolume returns a projected Volume combining the exporter serving certificate
+// Secret with the web config from the Pod annotations, or nil if exporter TLS is not enabled.
+func buildExporterTLSVolume(mc *memcachedv1beta1.Memcached) *corev1.Volume {
+	if !mc.IsExporterTLSEnabled() {
+		return nil
+	}
+	return &corev1.Volume{
+		Name: exporterTLSVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			Projected: &corev1.ProjectedVolumeSource{
+				Sources: []corev1.VolumeProjection{
+					{
+						Secret: &corev1.SecretProjection{
+							LocalObjectReference: mc.Spec.Monitoring.ExporterTLS.CertificateSecretRef,
+							Items: []corev1.KeyToPath{
+								{Key: "tls.crt", Path: "tls.crt"},
+								{Key: "tls.key", Path: "tls.key"},
+							},
+						},
+					},
+					{
+						DownwardAPI: &corev1.DownwardAPIProjection{
+							Items: []corev1.DownwardAPIVolumeFile{
+								{
+									Path: exporterWebConfigFile,
+									FieldRef: &corev1.ObjectFieldSelector{
+										FieldPath: "metadata.annotations['" + AnnotationExporterWebConfig + "']",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
 }
 
 // AnnotationSecretHash is the Pod template annotation key for the computed secret hash.
 const AnnotationSecretHash = "memcached.c5c3.io/secret-hash" //nolint:gosec // annotation key, not a credential
 
+// AnnotationSecurityHash is the Pod template annotation key for the fingerprint of all
+// referenced security Secrets, see computeSecurityHash.
+const AnnotationSecurityHash = "memcached.c5c3.io/security-hash" //nolint:gosec // annotation key, not a credential
+
 // AnnotationRestartTrigger is the Pod template annotation key for the manual restart trigger.
 const AnnotationRestartTrigger = "memcached.c5c3.io/restart-trigger"
 
@@ -245,7 +803,7 @@ func buildSASLVolume(mc *memcachedv1beta1.Memcached) *corev1.Volume {
 		Name: saslVolumeName,
 		VolumeSource: corev1.VolumeSource{
 			Secret: &corev1.SecretVolumeSource{
-				SecretName: mc.Spec.Security.SASL.CredentialsSecretRef.Name,
+				SecretName: mc.SASLCredentialsSecretName(),
 				Items: []corev1.KeyToPath{
 					{Key: "password-file", Path: "password-file"},
 				},
@@ -267,6 +825,115 @@ func buildSASLVolumeMount(mc *memcachedv1beta1.Memcached) *corev1.VolumeMount {
 	}
 }
 
+// readinessProbePeriodSeconds is the periodSeconds of the memcached readiness probe.
+const readinessProbePeriodSeconds = 5
+
+// minReadySeconds returns the Deployment's minReadySeconds. An explicit
+// spec.minReadySeconds always wins. Otherwise, when graceful shutdown or
+// multi-replica high availability is enabled, a new pod must stay ready for one
+// readiness probe period before it counts as available, so a pod that flaps right
+// after starting does not let the rollout move on.
+func minReadySeconds(mc *memcachedv1beta1.Memcached) int32 {
+	if mc.Spec.MinReadySeconds != nil {
+		return *mc.Spec.MinReadySeconds
+	}
+	multiReplicaHA := mc.Spec.HighAvailability != nil && (mc.IsAutoscalingEnabled() || mc.DesiredReplicas() > 1)
+	if mc.IsGracefulShutdownEnabled() || multiReplicaHA {
+		return readinessProbePeriodSeconds
+	}
+	return 0
+}
+
+// buildMemcachedProbeHandler returns the handler for the memcached liveness and
+// readiness probes. memcached opens no TCP listener while a unix socket is
+// configured, so the probes then check for the socket file instead of the port.
+// The TCP probes connect via the Pod IP, so they target the TLS port when TLS is
+// enabled and the plaintext listener is bound to loopback addresses only; the TLS
+// listener then binds to the Pod IP (see tlsListenArgs).
+func buildMemcachedProbeHandler(mc *memcachedv1beta1.Memcached) corev1.ProbeHandler {
+	if mc.IsUnixSocketEnabled() {
+		return corev1.ProbeHandler{
+			Exec: &corev1.ExecAction{
+				Command: []string{"test", "-S", mc.UnixSocketPath()},
+			},
+		}
+	}
+	port := "memcached"
+	if mc.IsTLSEnabled() && mc.IsPlaintextLoopbackOnly() {
+		port = tlsPortName
+	}
+	return corev1.ProbeHandler{
+		TCPSocket: &corev1.TCPSocketAction{
+			Port: intstr.FromString(port),
+		},
+	}
+}
+
+// unixSocketVolumeName is the name used for the emptyDir volume holding the memcached unix socket.
+const unixSocketVolumeName = "memcached-socket"
+
+// buildUnixSocketVolume returns an emptyDir Volume for the unix socket directory,
+// or nil if the unix socket is not enabled.
+func buildUnixSocketVolume(mc *memcachedv1beta1.Memcached) *corev1.Volume {
+	if !mc.IsUnixSocketEnabled() {
+		return nil
+	}
+	return &corev1.Volume{
+		Name: unixSocketVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			EmptyDir: &corev1.EmptyDirVolumeSource{},
+		},
+	}
+}
+
+// buildUnixSocketVolumeMount returns a VolumeMount of the unix socket volume at the
+// directory containing the socket, or nil if the unix socket is not enabled.
+// The same mount is shared by memcached and the exporter and stats sidecars.
+func buildUnixSocketVolumeMount(mc *memcachedv1beta1.Memcached) *corev1.VolumeMount {
+	if !mc.IsUnixSocketEnabled() {
+		return nil
+	}
+	return &corev1.VolumeMount{
+		Name:      unixSocketVolumeName,
+		MountPath: path.Dir(mc.UnixSocketPath()),
+	}
+}
+
+// tmpVolumeName is the name used for the memory-backed /tmp emptyDir volume.
+const tmpVolumeName = "tmp"
+
+// tmpMountPath is the path where the tmp volume is mounted in the memcached container.
+const tmpMountPath = "/tmp"
+
+// buildTmpVolume returns a memory-backed emptyDir Volume for /tmp, sized by
+// spec.memcached.tmpfsSizeLimit, or nil if the root filesystem is writable.
+func buildTmpVolume(mc *memcachedv1beta1.Memcached) *corev1.Volume {
+	if !mc.HasReadOnlyRootFilesystem() {
+		return nil
+	}
+	emptyDir := &corev1.EmptyDirVolumeSource{Medium: corev1.StorageMediumMemory}
+	if mc.Spec.Memcached != nil && mc.Spec.Memcached.TmpfsSizeLimit != nil {
+		sizeLimit := mc.Spec.Memcached.TmpfsSizeLimit.DeepCopy()
+		emptyDir.SizeLimit = &sizeLimit
+	}
+	return &corev1.Volume{
+		Name:         tmpVolumeName,
+		VolumeSource: corev1.VolumeSource{EmptyDir: emptyDir},
+	}
+}
+
+// buildTmpVolumeMount returns a VolumeMount of the tmp volume at /tmp, or nil if
+// the root filesystem is writable.
+func buildTmpVolumeMount(mc *memcachedv1beta1.Memcached) *corev1.VolumeMount {
+	if !mc.HasReadOnlyRootFilesystem() {
+		return nil
+	}
+	return &corev1.VolumeMount{
+		Name:      tmpVolumeName,
+		MountPath: tmpMountPath,
+	}
+}
+
 // tlsVolumeName is the name used for the TLS certificates volume.
 const tlsVolumeName = "tls-certificates"
 
@@ -277,9 +944,10 @@ const tlsMountPath = "/etc/memcached/tls"
 const tlsPortName = "memcached-tls"
 
 // Well-known port numbers used across Deployment, Service, NetworkPolicy, and ServiceMonitor.
+// PortMemcachedTLS is the default TLS port; spec.security.tls.port overrides it.
 const (
 	PortMemcached    = 11211
-	PortMemcachedTLS = 11212
+	PortMemcachedTLS = memcachedv1beta1.DefaultTLSPort
 	PortMetrics      = 9150
 )
 
@@ -320,13 +988,34 @@ func buildTLSVolumeMount(mc *memcachedv1beta1.Memcached) *corev1.VolumeMount {
 	}
 }
 
-// buildPodSecurityContext returns the PodSecurityContext from the Memcached CR,
-// or nil if no pod security context is configured.
+// buildPodSecurityContext returns the PodSecurityContext from the Memcached CR with
+// spec.security.sysctls merged in, or nil if neither is configured.
 func buildPodSecurityContext(mc *memcachedv1beta1.Memcached) *corev1.PodSecurityContext {
-	if mc.Spec.Security == nil || mc.Spec.Security.PodSecurityContext == nil {
+	if mc.Spec.Security == nil {
 		return nil
 	}
-	return mc.Spec.Security.PodSecurityContext
+	sec := mc.Spec.Security
+	if len(sec.Sysctls) == 0 {
+		return sec.PodSecurityContext
+	}
+
+	psc := &corev1.PodSecurityContext{}
+	if sec.PodSecurityContext != nil {
+		psc = sec.PodSecurityContext.DeepCopy()
+	}
+	// spec.security.sysctls takes precedence over same-named podSecurityContext sysctls.
+	overridden := make(map[string]bool, len(sec.Sysctls))
+	for _, s := range sec.Sysctls {
+		overridden[s.Name] = true
+	}
+	sysctls := make([]corev1.Sysctl, 0, len(psc.Sysctls)+len(sec.Sysctls))
+	for _, s := range psc.Sysctls {
+		if !overridden[s.Name] {
+			sysctls = append(sysctls, s)
+		}
+	}
+	psc.Sysctls = append(sysctls, sec.Sysctls...)
+	return psc
 }
 
 // buildContainerSecurityContext returns the container SecurityContext from the Memcached CR,
@@ -338,6 +1027,43 @@ func buildContainerSecurityContext(mc *memcachedv1beta1.Memcached) *corev1.Secur
 	return mc.Spec.Security.ContainerSecurityContext
 }
 
+// buildExporterSecurityContext returns spec.monitoring.exporterSecurityContext when
+// set, otherwise the shared container security context.
+func buildExporterSecurityContext(mc *memcachedv1beta1.Memcached, shared *corev1.SecurityContext) *corev1.SecurityContext {
+	if mc.Spec.Monitoring != nil && mc.Spec.Monitoring.ExporterSecurityContext != nil {
+		return mc.Spec.Monitoring.ExporterSecurityContext
+	}
+	return shared
+}
+
+// buildRollingUpdate returns the maxSurge and maxUnavailable values of the rolling
+// update strategy. Percentage fields are rendered as "<n>%" so Kubernetes scales
+// them with the replica count. Defaults are maxSurge=1 and maxUnavailable=0.
+func buildRollingUpdate(mc *memcachedv1beta1.Memcached) (intstr.IntOrString, intstr.IntOrString) {
+	maxSurge := intstr.FromInt32(1)
+	maxUnavailable := intstr.FromInt32(0)
+
+	ru := mc.Spec.RollingUpdate
+	if ru == nil {
+		return maxSurge, maxUnavailable
+	}
+
+	switch {
+	case ru.MaxSurgePercent != nil:
+		maxSurge = intstr.FromString(fmt.Sprintf("%d%%", *ru.MaxSurgePercent))
+	case ru.MaxSurge != nil:
+		maxSurge = intstr.FromInt32(*ru.MaxSurge)
+	}
+	switch {
+	case ru.MaxUnavailablePercent != nil:
+		maxUnavailable = intstr.FromString(fmt.Sprintf("%d%%", *ru.MaxUnavailablePercent))
+	case ru.MaxUnavailable != nil:
+		maxUnavailable = intstr.FromInt32(*ru.MaxUnavailable)
+	}
+
+	return maxSurge, maxUnavailable
+}
+
 // constructDeployment sets the desired state of the Deployment based on the Memcached CR spec.
 // It mutates dep in-place and is designed to be called from within controllerutil.CreateOrUpdate.
 // secretHash and restartTrigger are propagated as Pod template annotations to trigger rolling updates.
@@ -345,13 +1071,10 @@ func constructDeployment(mc *memcachedv1beta1.Memcached, dep *appsv1.Deployment,
 	labels := labelsForMemcached(mc.Name)
 
 	// Determine replicas: nil when HPA is active (let HPA control scaling),
-	// otherwise use spec value or default.
+	// otherwise the spec value (or maintenance replicas while read-only) or default.
 	var replicasPtr *int32
 	if !mc.IsAutoscalingEnabled() {
-		replicas := int32(1)
-		if mc.Spec.Replicas != nil {
-			replicas = *mc.Spec.Replicas
-		}
+		replicas := mc.DesiredReplicas()
 		replicasPtr = &replicas
 	}
 	image := memcachedv1beta1.DefaultImage
@@ -376,15 +1099,17 @@ func constructDeployment(mc *memcachedv1beta1.Memcached, dep *appsv1.Deployment,
 
 	args := buildMemcachedArgs(mc.Spec.Memcached, saslSpec, tlsSpec)
 
-	var resources corev1.ResourceRequirements
-	if mc.Spec.Resources != nil {
-		resources = *mc.Spec.Resources
-	}
+	resources := buildMemcachedResources(mc)
 
-	maxSurge := intstr.FromInt32(1)
-	maxUnavailable := intstr.FromInt32(0)
+	maxSurge, maxUnavailable := buildRollingUpdate(mc)
 
 	affinity := buildAntiAffinity(mc)
+	if podAffinity := buildClientAffinity(mc); podAffinity != nil {
+		if affinity == nil {
+			affinity = &corev1.Affinity{}
+		}
+		affinity.PodAffinity = podAffinity
+	}
 	topologySpreadConstraints := buildTopologySpreadConstraints(mc)
 	lifecycle, terminationGracePeriodSeconds := buildGracefulShutdown(mc)
 	podSecurityContext := buildPodSecurityContext(mc)
@@ -397,6 +1122,15 @@ func constructDeployment(mc *memcachedv1beta1.Memcached, dep *appsv1.Deployment,
 	if vm := buildTLSVolumeMount(mc); vm != nil {
 		volumeMounts = append(volumeMounts, *vm)
 	}
+	if vm := buildUnixSocketVolumeMount(mc); vm != nil {
+		volumeMounts = append(volumeMounts, *vm)
+	}
+	if vm := buildEntrypointVolumeMount(mc); vm != nil {
+		volumeMounts = append(volumeMounts, *vm)
+	}
+	if vm := buildTmpVolumeMount(mc); vm != nil {
+		volumeMounts = append(volumeMounts, *vm)
+	}
 
 	ports := []corev1.ContainerPort{
 		{
@@ -408,7 +1142,7 @@ func constructDeployment(mc *memcachedv1beta1.Memcached, dep *appsv1.Deployment,
 	if tlsSpec != nil && tlsSpec.Enabled {
 		ports = append(ports, corev1.ContainerPort{
 			Name:          tlsPortName,
-			ContainerPort: PortMemcachedTLS,
+			ContainerPort: mc.TLSPort(),
 			Protocol:      corev1.ProtocolTCP,
 		})
 	}
@@ -416,37 +1150,40 @@ func constructDeployment(mc *memcachedv1beta1.Memcached, dep *appsv1.Deployment,
 	memcachedContainer := corev1.Container{
 		Name:            "memcached",
 		Image:           image,
+		ImagePullPolicy: imagePullPolicy(mc, image),
+		Command:         buildMemcachedCommand(mc),
 		Args:            args,
+		Env:             buildMemcachedEnv(),
 		Resources:       resources,
 		Lifecycle:       lifecycle,
 		SecurityContext: containerSecurityContext,
 		VolumeMounts:    volumeMounts,
 		Ports:           ports,
 		LivenessProbe: &corev1.Probe{
-			ProbeHandler: corev1.ProbeHandler{
-				TCPSocket: &corev1.TCPSocketAction{
-					Port: intstr.FromString("memcached"),
-				},
-			},
+			ProbeHandler:        buildMemcachedProbeHandler(mc),
 			InitialDelaySeconds: 10,
 			PeriodSeconds:       10,
 		},
 		ReadinessProbe: &corev1.Probe{
-			ProbeHandler: corev1.ProbeHandler{
-				TCPSocket: &corev1.TCPSocketAction{
-					Port: intstr.FromString("memcached"),
-				},
-			},
+			ProbeHandler:        buildMemcachedProbeHandler(mc),
 			InitialDelaySeconds: 5,
-			PeriodSeconds:       5,
+			PeriodSeconds:       readinessProbePeriodSeconds,
 		},
 	}
 
 	containers := []corev1.Container{memcachedContainer}
 	if exporterContainer := buildExporterContainer(mc); exporterContainer != nil {
-		exporterContainer.SecurityContext = containerSecurityContext
+		exporterContainer.SecurityContext = buildExporterSecurityContext(mc, containerSecurityContext)
 		containers = append(containers, *exporterContainer)
 	}
+	if pushContainer := buildPushGatewayContainer(mc); pushContainer != nil {
+		pushContainer.SecurityContext = buildExporterSecurityContext(mc, containerSecurityContext)
+		containers = append(containers, *pushContainer)
+	}
+	if statsContainer := buildStatsSidecarContainer(mc); statsContainer != nil {
+		statsContainer.SecurityContext = containerSecurityContext
+		containers = append(containers, *statsContainer)
+	}
 
 	var volumes []corev1.Volume
 	if v := buildSASLVolume(mc); v != nil {
@@ -455,12 +1192,53 @@ func constructDeployment(mc *memcachedv1beta1.Memcached, dep *appsv1.Deployment,
 	if v := buildTLSVolume(mc); v != nil {
 		volumes = append(volumes, *v)
 	}
+	if v := buildExporterTLSVolume(mc); v != nil {
+		volumes = append(volumes, *v)
+	}
+	if v := buildUnixSocketVolume(mc); v != nil {
+		volumes = append(volumes, *v)
+	}
+	if v := buildEntrypointVolume(mc); v != nil {
+		volumes = append(volumes, *v)
+	}
+	if v := buildTmpVolume(mc); v != nil {
+		volumes = append(volumes, *v)
+	}
 
 	podAnnotations := buildPodAnnotations(secretHash, restartTrigger)
+	if mc.IsExporterTLSEnabled() {
+		if podAnnotations == nil {
+			podAnnotations = make(map[string]string)
+		}
+		podAnnotations[AnnotationExporterWebConfig] = exporterWebConfig
+	}
+	if scrape := buildScrapeAnnotations(mc); scrape != nil {
+		if podAnnotations == nil {
+			podAnnotations = make(map[string]string)
+		}
+		maps.Copy(podAnnotations, scrape)
+	}
+	if otel := buildOtelResourceAnnotations(mc, image); otel != nil {
+		if podAnnotations == nil {
+			podAnnotations = make(map[string]string)
+		}
+		maps.Copy(podAnnotations, otel)
+	}
+
+	automountServiceAccountToken := false
+	var schedulerName string
+	if mc.Spec.SchedulerName != nil {
+		schedulerName = *mc.Spec.SchedulerName
+	}
+	revisionHistoryLimit := mc.RevisionHistoryLimit()
 
-	dep.Labels = versionedLabels
+	dep.Labels = withPropagatedLabels(mc, versionedLabels)
+	dep.Annotations = applyReadOnlyAnnotation(mc, mergePropagatedAnnotations(mc, dep.Annotations))
 	dep.Spec = appsv1.DeploymentSpec{
-		Replicas: replicasPtr,
+		Replicas:             replicasPtr,
+		MinReadySeconds:      minReadySeconds(mc),
+		RevisionHistoryLimit: &revisionHistoryLimit,
+		Paused:               mc.Spec.SuspendRollout,
 		Selector: &metav1.LabelSelector{
 			MatchLabels: labels,
 		},
@@ -476,11 +1254,21 @@ func constructDeployment(mc *memcachedv1beta1.Memcached, dep *appsv1.Deployment,
 				Labels:      versionedLabels,
 				Annotations: podAnnotations,
 			},
+			// Neither memcached nor its sidecars talk to the Kubernetes API or need
+			// host networking; HostNetwork is left false so port 11211 never binds on
+			// the node, and any out-of-band edit to either field is reverted.
+			// RestartPolicy is set explicitly because Deployments only accept Always.
 			Spec: corev1.PodSpec{
+				AutomountServiceAccountToken:  &automountServiceAccountToken,
+				HostNetwork:                   false,
+				RestartPolicy:                 corev1.RestartPolicyAlways,
 				Affinity:                      affinity,
 				TopologySpreadConstraints:     topologySpreadConstraints,
 				TerminationGracePeriodSeconds: terminationGracePeriodSeconds,
 				SecurityContext:               podSecurityContext,
+				RuntimeClassName:              mc.Spec.RuntimeClassName,
+				SchedulerName:                 schedulerName,
+				Overhead:                      mc.Spec.PodOverhead,
 				Containers:                    containers,
 				Volumes:                       volumes,
 			},
@@ -503,3 +1291,109 @@ func buildPodAnnotations(secretHash, restartTrigger string) map[string]string {
 	}
 	return annotations
 }
+
+// setSecurityHashAnnotation stamps securityHash on the Pod template of dep. It is a
+// no-op for an empty hash, so instances without security Secrets keep their template.
+func setSecurityHashAnnotation(dep *appsv1.Deployment, securityHash string) {
+	if securityHash == "" {
+		return
+	}
+	if dep.Spec.Template.Annotations == nil {
+		dep.Spec.Template.Annotations = make(map[string]string)
+	}
+	dep.Spec.Template.Annotations[AnnotationSecurityHash] = securityHash
+}
+
+// Prometheus annotation-based discovery keys set by spec.monitoring.scrapeAnnotations.
+const (
+	annotationPrometheusScrape = "prometheus.io/scrape"
+	annotationPrometheusPort   = "prometheus.io/port"
+	annotationPrometheusPath   = "prometheus.io/path"
+	annotationPrometheusScheme = "prometheus.io/scheme"
+)
+
+// buildScrapeAnnotations returns the prometheus.io/* Pod template annotations pointing
+// at the exporter, or nil unless monitoring and spec.monitoring.scrapeAnnotations are enabled.
+func buildScrapeAnnotations(mc *memcachedv1beta1.Memcached) map[string]string {
+	if !mc.IsMonitoringEnabled() || !mc.Spec.Monitoring.ScrapeAnnotations {
+		return nil
+	}
+	annotations := map[string]string{
+		annotationPrometheusScrape: "true",
+		annotationPrometheusPort:   strconv.Itoa(PortMetrics),
+		annotationPrometheusPath:   "/metrics",
+	}
+	if mc.IsExporterTLSEnabled() {
+		annotations[annotationPrometheusScheme] = "https"
+	}
+	return annotations
+}
+
+// OpenTelemetry resource attribute keys set by spec.otelResourceAttributes.
+const (
+	annotationOtelServiceName      = "resource.opentelemetry.io/service.name"
+	annotationOtelServiceNamespace = "resource.opentelemetry.io/service.namespace"
+	annotationOtelServiceVersion   = "resource.opentelemetry.io/service.version"
+)
+
+// buildOtelResourceAnnotations returns the resource.opentelemetry.io/* Pod template
+// annotations derived from the CR name, namespace and image tag, or nil unless
+// spec.otelResourceAttributes is enabled. service.version matches the
+// app.kubernetes.io/version label and is omitted for untagged images.
+func buildOtelResourceAnnotations(mc *memcachedv1beta1.Memcached, image string) map[string]string {
+	if !mc.Spec.OtelResourceAttributes {
+		return nil
+	}
+	annotations := map[string]string{
+		annotationOtelServiceName:      mc.Name,
+		annotationOtelServiceNamespace: mc.Namespace,
+	}
+	if v := imageVersion(image); v != "" {
+		annotations[annotationOtelServiceVersion] = v
+	}
+	return annotations
+}
+
+// diffDeploymentFields returns the names of the user-facing Deployment fields
+// that differ between existing and desired: replicas, and the image, args, and
+// resources of each container (keyed by container name). Containers present in
+// only one of the two are reported as "containers[<name>]".
+func diffDeploymentFields(existing, desired *appsv1.Deployment) []string {
+	var changed []string
+
+	if !equality.Semantic.DeepEqual(existing.Spec.Replicas, desired.Spec.Replicas) {
+		changed = append(changed, "replicas")
+	}
+
+	existingContainers := make(map[string]corev1.Container, len(existing.Spec.Template.Spec.Containers))
+	for _, c := range existing.Spec.Template.Spec.Containers {
+		existingContainers[c.Name] = c
+	}
+
+	for _, want := range desired.Spec.Template.Spec.Containers {
+		got, ok := existingContainers[want.Name]
+		if !ok {
+			changed = append(changed, fmt.Sprintf("containers[%s]", want.Name))
+			continue
+		}
+		delete(existingContainers, want.Name)
+
+		if got.Image != want.Image {
+			changed = append(changed, fmt.Sprintf("containers[%s].image", want.Name))
+		}
+		if !equality.Semantic.DeepEqual(got.Args, want.Args) {
+			changed = append(changed, fmt.Sprintf("containers[%s].args", want.Name))
+		}
+		if !equality.Semantic.DeepEqual(got.Resources, want.Resources) {
+			changed = append(changed, fmt.Sprintf("containers[%s].resources", want.Name))
+		}
+	}
+
+	removed := make([]string, 0, len(existingContainers))
+	for name := range existingContainers {
+		removed = append(removed, fmt.Sprintf("containers[%s]", name))
+	}
+	sort.Strings(removed)
+
+	return append(changed, removed...)
+}
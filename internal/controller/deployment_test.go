@@ -2,12 +2,15 @@
 package controller
 
 import (
+	"fmt"
 	"reflect"
+	"slices"
 	"strings"
 	"testing"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -147,6 +150,15 @@ func TestBuildMemcachedArgs(t *testing.T) {
 				"-m", "64", "-c", "1024", "-t", "4", "-I", "1m", "-vv",
 			},
 		},
+		{
+			name: "verbosity 3 produces -vvv flag",
+			config: &memcachedv1beta1.MemcachedConfig{
+				Verbosity: 3,
+			},
+			expected: []string{
+				"-m", "64", "-c", "1024", "-t", "4", "-I", "1m", "-vvv",
+			},
+		},
 		{
 			name: "extra args appended after standard flags",
 			config: &memcachedv1beta1.MemcachedConfig{
@@ -654,6 +666,98 @@ func TestBuildAntiAffinity_InstanceScopedLabels(t *testing.T) {
 	}
 }
 
+func TestBuildClientAffinity(t *testing.T) {
+	selector := metav1.LabelSelector{MatchLabels: map[string]string{"app": "api"}}
+	tests := []struct {
+		name       string
+		affinity   *memcachedv1beta1.ClientAffinitySpec
+		wantNil    bool
+		wantWeight int32
+	}{
+		{name: "unset", wantNil: true},
+		{name: "explicit weight", affinity: &memcachedv1beta1.ClientAffinitySpec{PodSelector: selector, Weight: 40}, wantWeight: 40},
+		{name: "weight defaults to 100", affinity: &memcachedv1beta1.ClientAffinitySpec{PodSelector: selector}, wantWeight: 100},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &memcachedv1beta1.Memcached{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-cache", Namespace: "default"},
+				Spec: memcachedv1beta1.MemcachedSpec{
+					HighAvailability: &memcachedv1beta1.HighAvailabilitySpec{ClientAffinity: tt.affinity},
+				},
+			}
+
+			podAffinity := buildClientAffinity(mc)
+			if tt.wantNil {
+				if podAffinity != nil {
+					t.Errorf("expected nil PodAffinity, got %+v", podAffinity)
+				}
+				return
+			}
+			if podAffinity == nil {
+				t.Fatal("expected non-nil PodAffinity")
+			}
+			if len(podAffinity.RequiredDuringSchedulingIgnoredDuringExecution) != 0 {
+				t.Error("client affinity must only be preferred, never required")
+			}
+			preferred := podAffinity.PreferredDuringSchedulingIgnoredDuringExecution
+			if len(preferred) != 1 {
+				t.Fatalf("expected 1 preferred term, got %d", len(preferred))
+			}
+			if preferred[0].Weight != tt.wantWeight {
+				t.Errorf("weight = %d, want %d", preferred[0].Weight, tt.wantWeight)
+			}
+			term := preferred[0].PodAffinityTerm
+			if term.TopologyKey != "kubernetes.io/hostname" {
+				t.Errorf("topologyKey = %q, want kubernetes.io/hostname", term.TopologyKey)
+			}
+			if !reflect.DeepEqual(term.LabelSelector, &selector) {
+				t.Errorf("labelSelector = %+v, want %+v", term.LabelSelector, selector)
+			}
+		})
+	}
+}
+
+func TestConstructDeployment_ClientAffinityWithAntiAffinity(t *testing.T) {
+	mc := &memcachedv1beta1.Memcached{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-cache", Namespace: "default"},
+		Spec: memcachedv1beta1.MemcachedSpec{
+			HighAvailability: &memcachedv1beta1.HighAvailabilitySpec{
+				AntiAffinityPreset: antiAffinityPresetPtr(memcachedv1beta1.AntiAffinityPresetSoft),
+				ClientAffinity: &memcachedv1beta1.ClientAffinitySpec{
+					PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "api"}},
+					Weight:      30,
+				},
+			},
+		},
+	}
+	dep := &appsv1.Deployment{}
+
+	constructDeployment(mc, dep, "", "")
+
+	affinity := dep.Spec.Template.Spec.Affinity
+	if affinity == nil || affinity.PodAntiAffinity == nil || affinity.PodAffinity == nil {
+		t.Fatalf("expected both pod affinity and anti-affinity, got %+v", affinity)
+	}
+	anti := affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution
+	if len(anti) != 1 || anti[0].PodAffinityTerm.LabelSelector.MatchLabels["app.kubernetes.io/instance"] != "my-cache" {
+		t.Errorf("anti-affinity terms = %+v, want the instance-scoped soft preset", anti)
+	}
+	pref := affinity.PodAffinity.PreferredDuringSchedulingIgnoredDuringExecution
+	if len(pref) != 1 || pref[0].Weight != 30 || pref[0].PodAffinityTerm.LabelSelector.MatchLabels["app"] != "api" {
+		t.Errorf("pod affinity terms = %+v, want a weight-30 term selecting app=api", pref)
+	}
+
+	// Client affinity alone still renders an Affinity without anti-affinity.
+	mc.Spec.HighAvailability.AntiAffinityPreset = nil
+	constructDeployment(mc, dep, "", "")
+	affinity = dep.Spec.Template.Spec.Affinity
+	if affinity == nil || affinity.PodAffinity == nil || affinity.PodAntiAffinity != nil {
+		t.Errorf("expected only pod affinity, got %+v", affinity)
+	}
+}
+
 func TestConstructDeployment_AntiAffinity(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -828,6 +932,66 @@ func TestBuildTopologySpreadConstraints_ReturnsNil(t *testing.T) {
 	}
 }
 
+func TestBuildTopologySpreadConstraints_SpreadPerRevision(t *testing.T) {
+	selector := &metav1.LabelSelector{MatchLabels: map[string]string{"app.kubernetes.io/name": "memcached"}}
+	newConstraints := func() []corev1.TopologySpreadConstraint {
+		return []corev1.TopologySpreadConstraint{
+			{MaxSkew: 1, TopologyKey: testZoneTopology, WhenUnsatisfiable: corev1.DoNotSchedule, LabelSelector: selector},
+			{
+				MaxSkew:           1,
+				TopologyKey:       testHostnameTopology,
+				WhenUnsatisfiable: corev1.ScheduleAnyway,
+				LabelSelector:     selector,
+				MatchLabelKeys:    []string{"pod-template-hash"},
+			},
+			zoneSpreadConstraint(),
+		}
+	}
+
+	t.Run("disabled leaves constraints untouched", func(t *testing.T) {
+		mc := &memcachedv1beta1.Memcached{
+			Spec: memcachedv1beta1.MemcachedSpec{
+				HighAvailability: &memcachedv1beta1.HighAvailabilitySpec{TopologySpreadConstraints: newConstraints()},
+			},
+		}
+
+		got := buildTopologySpreadConstraints(mc)
+
+		if !reflect.DeepEqual(got, newConstraints()) {
+			t.Errorf("constraints = %+v, want them unchanged", got)
+		}
+	})
+
+	t.Run("enabled injects pod-template-hash", func(t *testing.T) {
+		mc := &memcachedv1beta1.Memcached{
+			Spec: memcachedv1beta1.MemcachedSpec{
+				HighAvailability: &memcachedv1beta1.HighAvailabilitySpec{
+					TopologySpreadConstraints: newConstraints(),
+					SpreadPerRevision:         true,
+				},
+			},
+		}
+
+		got := buildTopologySpreadConstraints(mc)
+
+		if len(got) != 3 {
+			t.Fatalf("expected 3 constraints, got %d", len(got))
+		}
+		if want := []string{"pod-template-hash"}; !reflect.DeepEqual(got[0].MatchLabelKeys, want) {
+			t.Errorf("first constraint matchLabelKeys = %v, want %v", got[0].MatchLabelKeys, want)
+		}
+		if want := []string{"pod-template-hash"}; !reflect.DeepEqual(got[1].MatchLabelKeys, want) {
+			t.Errorf("second constraint matchLabelKeys = %v, want the key once", got[1].MatchLabelKeys)
+		}
+		if got[2].MatchLabelKeys != nil {
+			t.Errorf("constraint without labelSelector got matchLabelKeys %v, want nil", got[2].MatchLabelKeys)
+		}
+		if !reflect.DeepEqual(mc.Spec.HighAvailability.TopologySpreadConstraints, newConstraints()) {
+			t.Error("spec.highAvailability.topologySpreadConstraints was mutated")
+		}
+	})
+}
+
 func TestConstructDeployment_TopologySpreadConstraints(t *testing.T) {
 	mc := &memcachedv1beta1.Memcached{
 		ObjectMeta: metav1.ObjectMeta{Name: "tsc-test", Namespace: "default"},
@@ -935,7 +1099,7 @@ func TestBuildGracefulShutdown_EnabledWithDefaults(t *testing.T) {
 			HighAvailability: &memcachedv1beta1.HighAvailabilitySpec{
 				GracefulShutdown: &memcachedv1beta1.GracefulShutdownSpec{
 					Enabled:                       true,
-					PreStopDelaySeconds:           10,
+					PreStopDelaySeconds:           15,
 					TerminationGracePeriodSeconds: 30,
 				},
 			},
@@ -954,7 +1118,7 @@ func TestBuildGracefulShutdown_EnabledWithDefaults(t *testing.T) {
 	if lifecycle.PreStop.Exec == nil {
 		t.Fatal("expected Exec handler on PreStop")
 	}
-	expectedCmd := []string{"sleep", "10"}
+	expectedCmd := []string{"sleep", "15"}
 	if len(lifecycle.PreStop.Exec.Command) != len(expectedCmd) {
 		t.Fatalf("expected command %v, got %v", expectedCmd, lifecycle.PreStop.Exec.Command)
 	}
@@ -1029,7 +1193,7 @@ func TestBuildGracefulShutdown_ZeroValuesUseDefaults(t *testing.T) {
 		t.Fatal("expected non-nil Lifecycle")
 		return
 	}
-	expectedCmd := []string{"sleep", "10"}
+	expectedCmd := []string{"sleep", "15"}
 	if len(lifecycle.PreStop.Exec.Command) != len(expectedCmd) {
 		t.Fatalf("expected command %v, got %v", expectedCmd, lifecycle.PreStop.Exec.Command)
 	}
@@ -1382,6 +1546,89 @@ func TestConstructDeployment_MonitoringDisabled(t *testing.T) {
 	}
 }
 
+func TestConstructDeployment_ExporterTLS(t *testing.T) {
+	mc := &memcachedv1beta1.Memcached{
+		ObjectMeta: metav1.ObjectMeta{Name: "exp-tls", Namespace: "default"},
+		Spec: memcachedv1beta1.MemcachedSpec{
+			Monitoring: &memcachedv1beta1.MonitoringSpec{
+				Enabled: true,
+				ExporterTLS: &memcachedv1beta1.ExporterTLSSpec{
+					Enabled:              true,
+					CertificateSecretRef: corev1.LocalObjectReference{Name: "exporter-cert"},
+				},
+			},
+		},
+	}
+	dep := &appsv1.Deployment{}
+
+	constructDeployment(mc, dep, "", "")
+
+	podSpec := dep.Spec.Template.Spec
+	exporter := podSpec.Containers[1]
+	if !slices.Contains(exporter.Args, "--web.config.file=/etc/memcached-exporter/tls/web-config.yml") {
+		t.Errorf("exporter args = %v, want --web.config.file flag", exporter.Args)
+	}
+	if len(exporter.VolumeMounts) != 1 || exporter.VolumeMounts[0].Name != exporterTLSVolumeName ||
+		exporter.VolumeMounts[0].MountPath != exporterTLSMountPath || !exporter.VolumeMounts[0].ReadOnly {
+		t.Errorf("unexpected exporter volume mounts: %v", exporter.VolumeMounts)
+	}
+
+	var vol *corev1.Volume
+	for i := range podSpec.Volumes {
+		if podSpec.Volumes[i].Name == exporterTLSVolumeName {
+			vol = &podSpec.Volumes[i]
+		}
+	}
+	if vol == nil || vol.Projected == nil {
+		t.Fatalf("expected projected %q volume, got %v", exporterTLSVolumeName, podSpec.Volumes)
+	}
+	if len(vol.Projected.Sources) != 2 {
+		t.Fatalf("expected 2 projected sources, got %d", len(vol.Projected.Sources))
+	}
+	if got := vol.Projected.Sources[0].Secret.Name; got != "exporter-cert" {
+		t.Errorf("projected secret name = %q, want %q", got, "exporter-cert")
+	}
+
+	webConfig := dep.Spec.Template.Annotations[AnnotationExporterWebConfig]
+	if !strings.Contains(webConfig, "cert_file: /etc/memcached-exporter/tls/tls.crt") ||
+		!strings.Contains(webConfig, "key_file: /etc/memcached-exporter/tls/tls.key") {
+		t.Errorf("unexpected web config annotation: %q", webConfig)
+	}
+}
+
+func TestConstructDeployment_ExporterTLSDisabled(t *testing.T) {
+	mc := &memcachedv1beta1.Memcached{
+		ObjectMeta: metav1.ObjectMeta{Name: "exp-plain", Namespace: "default"},
+		Spec: memcachedv1beta1.MemcachedSpec{
+			Monitoring: &memcachedv1beta1.MonitoringSpec{
+				Enabled:     true,
+				ExporterTLS: &memcachedv1beta1.ExporterTLSSpec{Enabled: false},
+			},
+		},
+	}
+	dep := &appsv1.Deployment{}
+
+	constructDeployment(mc, dep, "", "")
+
+	exporter := dep.Spec.Template.Spec.Containers[1]
+	for _, arg := range exporter.Args {
+		if strings.HasPrefix(arg, "--web.config.file") {
+			t.Errorf("unexpected exporter TLS arg %q", arg)
+		}
+	}
+	if len(exporter.VolumeMounts) != 0 {
+		t.Errorf("expected no exporter TLS mounts, got %v", exporter.VolumeMounts)
+	}
+	for _, v := range dep.Spec.Template.Spec.Volumes {
+		if v.Name == exporterTLSVolumeName {
+			t.Errorf("unexpected %q volume", exporterTLSVolumeName)
+		}
+	}
+	if _, ok := dep.Spec.Template.Annotations[AnnotationExporterWebConfig]; ok {
+		t.Error("unexpected exporter web config annotation")
+	}
+}
+
 // --- Security Context Tests ---
 
 func TestBuildPodSecurityContext_WithValue(t *testing.T) {
@@ -1412,6 +1659,48 @@ func TestBuildPodSecurityContext_WithValue(t *testing.T) {
 	}
 }
 
+func TestBuildPodSecurityContext_Sysctls(t *testing.T) {
+	fsGroup := int64(1000)
+	mc := &memcachedv1beta1.Memcached{
+		Spec: memcachedv1beta1.MemcachedSpec{
+			Security: &memcachedv1beta1.SecuritySpec{
+				PodSecurityContext: &corev1.PodSecurityContext{
+					FSGroup: &fsGroup,
+					Sysctls: []corev1.Sysctl{
+						{Name: "net.ipv4.tcp_syncookies", Value: "1"},
+						{Name: "net.ipv4.tcp_keepalive_time", Value: "7200"},
+					},
+				},
+				Sysctls: []corev1.Sysctl{{Name: "net.ipv4.tcp_keepalive_time", Value: "300"}},
+			},
+		},
+	}
+
+	got := buildPodSecurityContext(mc)
+
+	want := []corev1.Sysctl{
+		{Name: "net.ipv4.tcp_syncookies", Value: "1"},
+		{Name: "net.ipv4.tcp_keepalive_time", Value: "300"},
+	}
+	if !reflect.DeepEqual(got.Sysctls, want) {
+		t.Errorf("Sysctls = %v, want %v", got.Sysctls, want)
+	}
+	if got.FSGroup == nil || *got.FSGroup != 1000 {
+		t.Errorf("expected FSGroup=1000, got %v", got.FSGroup)
+	}
+	if len(mc.Spec.Security.PodSecurityContext.Sysctls) != 2 ||
+		mc.Spec.Security.PodSecurityContext.Sysctls[1].Value != "7200" {
+		t.Error("buildPodSecurityContext must not mutate the CR's podSecurityContext")
+	}
+
+	// Sysctls alone produce a pod security context.
+	mc.Spec.Security.PodSecurityContext = nil
+	got = buildPodSecurityContext(mc)
+	if got == nil || len(got.Sysctls) != 1 {
+		t.Errorf("expected a PodSecurityContext with one sysctl, got %+v", got)
+	}
+}
+
 func TestBuildPodSecurityContext_ReturnsNil(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -1644,6 +1933,30 @@ func TestBuildSASLVolume_Enabled(t *testing.T) {
 	}
 }
 
+func TestBuildSASLVolume_NameTemplate(t *testing.T) {
+	tmpl := "{{ .Name }}-sasl"
+	mc := &memcachedv1beta1.Memcached{
+		ObjectMeta: metav1.ObjectMeta{Name: "sasl-vol", Namespace: "default"},
+		Spec: memcachedv1beta1.MemcachedSpec{
+			Security: &memcachedv1beta1.SecuritySpec{
+				SASL: &memcachedv1beta1.SASLSpec{
+					Enabled:                       true,
+					CredentialsSecretNameTemplate: &tmpl,
+				},
+			},
+		},
+	}
+
+	vol := buildSASLVolume(mc)
+
+	if vol == nil || vol.Secret == nil {
+		t.Fatal("expected Secret volume")
+	}
+	if vol.Secret.SecretName != "sasl-vol-sasl" {
+		t.Errorf("secretName = %q, want %q", vol.Secret.SecretName, "sasl-vol-sasl")
+	}
+}
+
 func TestBuildSASLVolume_ReturnsNil(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -2053,6 +2366,47 @@ func TestConstructDeployment_SASLWithGracefulShutdown(t *testing.T) {
 	}
 }
 
+func TestConstructDeployment_ExporterSecurityContext(t *testing.T) {
+	sharedUser := int64(1000)
+	exporterUser := int64(65534)
+	readOnly := true
+	mc := &memcachedv1beta1.Memcached{
+		ObjectMeta: metav1.ObjectMeta{Name: "sec-exp", Namespace: "default"},
+		Spec: memcachedv1beta1.MemcachedSpec{
+			Security: &memcachedv1beta1.SecuritySpec{
+				ContainerSecurityContext: &corev1.SecurityContext{RunAsUser: &sharedUser},
+			},
+			Monitoring: &memcachedv1beta1.MonitoringSpec{
+				Enabled: true,
+				ExporterSecurityContext: &corev1.SecurityContext{
+					RunAsUser:              &exporterUser,
+					ReadOnlyRootFilesystem: &readOnly,
+				},
+			},
+			StatsSidecar: &memcachedv1beta1.StatsSidecarSpec{Enabled: true, Image: stringPtr("stats:1.0")},
+		},
+	}
+	dep := &appsv1.Deployment{}
+
+	constructDeployment(mc, dep, "", "")
+
+	byName := make(map[string]*corev1.SecurityContext)
+	for _, c := range dep.Spec.Template.Spec.Containers {
+		byName[c.Name] = c.SecurityContext
+	}
+
+	if sc := byName["memcached"]; sc == nil || sc.RunAsUser == nil || *sc.RunAsUser != sharedUser {
+		t.Errorf("memcached securityContext = %+v, want the shared context", sc)
+	}
+	if sc := byName[testExporterContainer]; sc == nil || sc.RunAsUser == nil || *sc.RunAsUser != exporterUser ||
+		sc.ReadOnlyRootFilesystem == nil || !*sc.ReadOnlyRootFilesystem {
+		t.Errorf("exporter securityContext = %+v, want the exporter context", sc)
+	}
+	if sc := byName["stats"]; sc == nil || sc.RunAsUser == nil || *sc.RunAsUser != sharedUser {
+		t.Errorf("stats securityContext = %+v, want the shared context", sc)
+	}
+}
+
 func TestConstructDeployment_SASLWithSecurityContexts(t *testing.T) {
 	runAsNonRoot := true
 	runAsUser := int64(1000)
@@ -2083,9 +2437,9 @@ func TestConstructDeployment_SASLWithSecurityContexts(t *testing.T) {
 
 	container := dep.Spec.Template.Spec.Containers[0]
 
-	// SASL: container has volume mount.
-	if len(container.VolumeMounts) != 1 {
-		t.Fatalf("expected 1 volumeMount, got %d", len(container.VolumeMounts))
+	// SASL: container has volume mount, plus /tmp for the read-only root filesystem.
+	if len(container.VolumeMounts) != 2 {
+		t.Fatalf("expected 2 volumeMounts, got %d", len(container.VolumeMounts))
 	}
 	if container.VolumeMounts[0].Name != saslVolumeName {
 		t.Errorf("volumeMount name = %q, want %q", container.VolumeMounts[0].Name, saslVolumeName)
@@ -2126,9 +2480,9 @@ func TestConstructDeployment_SASLWithSecurityContexts(t *testing.T) {
 		t.Error("expected container ReadOnlyRootFilesystem=true")
 	}
 
-	// SASL: pod has volume.
-	if len(dep.Spec.Template.Spec.Volumes) != 1 {
-		t.Fatalf("expected 1 volume, got %d", len(dep.Spec.Template.Spec.Volumes))
+	// SASL: pod has volume, plus /tmp for the read-only root filesystem.
+	if len(dep.Spec.Template.Spec.Volumes) != 2 {
+		t.Fatalf("expected 2 volumes, got %d", len(dep.Spec.Template.Spec.Volumes))
 	}
 	if dep.Spec.Template.Spec.Volumes[0].Secret.SecretName != testSASLSecretName {
 		t.Errorf("volume secretName = %q, want %q", dep.Spec.Template.Spec.Volumes[0].Secret.SecretName, testSASLSecretName)
@@ -2315,6 +2669,7 @@ func TestBuildMemcachedArgs_TLSEnabled(t *testing.T) {
 
 	expected := []string{
 		"-m", "64", "-c", "1024", "-t", "4", "-I", "1m",
+		"-l", "notls:*:11211", "-l", "*:11212",
 		"-Z",
 		"-o", "ssl_chain_cert=/etc/memcached/tls/tls.crt",
 		"-o", "ssl_key=/etc/memcached/tls/tls.key",
@@ -2373,10 +2728,12 @@ func TestBuildMemcachedArgs_TLSWithClientCert(t *testing.T) {
 
 	expected := []string{
 		"-m", "64", "-c", "1024", "-t", "4", "-I", "1m",
+		"-l", "notls:*:11211", "-l", "*:11212",
 		"-Z",
 		"-o", "ssl_chain_cert=/etc/memcached/tls/tls.crt",
 		"-o", "ssl_key=/etc/memcached/tls/tls.key",
 		"-o", "ssl_ca_cert=/etc/memcached/tls/ca.crt",
+		"-o", "ssl_verify_mode=2",
 	}
 	if len(got) != len(expected) {
 		t.Fatalf("buildMemcachedArgs() returned %d args, want %d\ngot:  %v\nwant: %v",
@@ -2409,6 +2766,7 @@ func TestBuildMemcachedArgs_TLSWithSASL(t *testing.T) {
 	// Order: standard flags, SASL -Y, TLS -Z/ssl flags.
 	expected := []string{
 		"-m", "64", "-c", "1024", "-t", "4", "-I", "1m",
+		"-l", "notls:*:11211", "-l", "*:11212",
 		"-Y", "/etc/memcached/sasl/password-file",
 		"-Z",
 		"-o", "ssl_chain_cert=/etc/memcached/tls/tls.crt",
@@ -2443,6 +2801,7 @@ func TestBuildMemcachedArgs_TLSWithVerbosityAndExtraArgs(t *testing.T) {
 	// Order: standard flags, verbosity, TLS flags, extra args.
 	expected := []string{
 		"-m", "64", "-c", "1024", "-t", "4", "-I", "1m",
+		"-l", "notls:*:11211", "-l", "*:11212",
 		"-v",
 		"-Z",
 		"-o", "ssl_chain_cert=/etc/memcached/tls/tls.crt",
@@ -2722,6 +3081,35 @@ func TestConstructDeployment_TLSPort(t *testing.T) {
 	}
 }
 
+func TestConstructDeployment_CustomTLSPort(t *testing.T) {
+	mc := &memcachedv1beta1.Memcached{
+		ObjectMeta: metav1.ObjectMeta{Name: "tls-custom-port", Namespace: "default"},
+		Spec: memcachedv1beta1.MemcachedSpec{
+			Security: &memcachedv1beta1.SecuritySpec{
+				TLS: &memcachedv1beta1.TLSSpec{
+					Enabled:              true,
+					CertificateSecretRef: corev1.LocalObjectReference{Name: "my-tls-secret"},
+					Port:                 int32Ptr(12345),
+				},
+			},
+		},
+	}
+	dep := &appsv1.Deployment{}
+
+	constructDeployment(mc, dep, "", "")
+
+	ports := dep.Spec.Template.Spec.Containers[0].Ports
+	if len(ports) != 2 {
+		t.Fatalf("expected 2 ports, got %d", len(ports))
+	}
+	if ports[0].ContainerPort != 11211 {
+		t.Errorf("port[0] = %d, want 11211", ports[0].ContainerPort)
+	}
+	if ports[1].Name != tlsPortName || ports[1].ContainerPort != 12345 {
+		t.Errorf("port[1] = %s:%d, want %s:12345", ports[1].Name, ports[1].ContainerPort, tlsPortName)
+	}
+}
+
 func TestConstructDeployment_TLSWithMonitoringAndSecurityContexts(t *testing.T) {
 	runAsNonRoot := true
 	readOnly := true
@@ -2758,9 +3146,9 @@ func TestConstructDeployment_TLSWithMonitoringAndSecurityContexts(t *testing.T)
 
 	mcContainer := dep.Spec.Template.Spec.Containers[0]
 
-	// TLS: container has volume mount.
-	if len(mcContainer.VolumeMounts) != 1 {
-		t.Fatalf("expected 1 volumeMount on memcached, got %d", len(mcContainer.VolumeMounts))
+	// TLS: container has volume mount, plus /tmp for the read-only root filesystem.
+	if len(mcContainer.VolumeMounts) != 2 {
+		t.Fatalf("expected 2 volumeMounts on memcached, got %d", len(mcContainer.VolumeMounts))
 	}
 	if mcContainer.VolumeMounts[0].Name != tlsVolumeName {
 		t.Errorf("memcached volumeMount name = %q, want %q", mcContainer.VolumeMounts[0].Name, tlsVolumeName)
@@ -2771,9 +3159,9 @@ func TestConstructDeployment_TLSWithMonitoringAndSecurityContexts(t *testing.T)
 		t.Fatalf("expected 2 ports on memcached, got %d", len(mcContainer.Ports))
 	}
 
-	// Pod has TLS volume.
-	if len(dep.Spec.Template.Spec.Volumes) != 1 {
-		t.Fatalf("expected 1 volume, got %d", len(dep.Spec.Template.Spec.Volumes))
+	// Pod has TLS and /tmp volumes.
+	if len(dep.Spec.Template.Spec.Volumes) != 2 {
+		t.Fatalf("expected 2 volumes, got %d", len(dep.Spec.Template.Spec.Volumes))
 	}
 
 	// Pod security context applied.
@@ -2934,12 +3322,14 @@ func TestConstructDeployment_KitchenSink_Args(t *testing.T) {
 
 	expectedArgs := []string{
 		"-m", "256", "-c", "2048", "-t", "8", "-I", "2m",
+		"-l", "notls:*:11211", "-l", "*:11212",
 		"-vv",
 		"-Y", saslMountPath + "/password-file",
 		"-Z",
 		"-o", "ssl_chain_cert=/etc/memcached/tls/tls.crt",
 		"-o", "ssl_key=/etc/memcached/tls/tls.key",
 		"-o", "ssl_ca_cert=/etc/memcached/tls/ca.crt",
+		"-o", "ssl_verify_mode=2",
 		"--max-reqs-per-event", "20",
 	}
 	if len(mc.Args) != len(expectedArgs) {
@@ -3108,8 +3498,8 @@ func TestConstructDeployment_KitchenSink_Volumes(t *testing.T) {
 	mc := dep.Spec.Template.Spec.Containers[0]
 
 	// Volume mounts.
-	if len(mc.VolumeMounts) != 2 {
-		t.Fatalf("expected 2 volumeMounts, got %d", len(mc.VolumeMounts))
+	if len(mc.VolumeMounts) != 3 {
+		t.Fatalf("expected 3 volumeMounts, got %d", len(mc.VolumeMounts))
 	}
 	if mc.VolumeMounts[0].Name != saslVolumeName || mc.VolumeMounts[0].MountPath != saslMountPath {
 		t.Errorf("volumeMount[0] = {Name:%q MountPath:%q}, want {Name:%q MountPath:%q}",
@@ -3124,11 +3514,15 @@ func TestConstructDeployment_KitchenSink_Volumes(t *testing.T) {
 	if !mc.VolumeMounts[1].ReadOnly {
 		t.Error("expected TLS volumeMount readOnly=true")
 	}
+	if mc.VolumeMounts[2].Name != tmpVolumeName || mc.VolumeMounts[2].MountPath != tmpMountPath {
+		t.Errorf("volumeMount[2] = {Name:%q MountPath:%q}, want {Name:%q MountPath:%q}",
+			mc.VolumeMounts[2].Name, mc.VolumeMounts[2].MountPath, tmpVolumeName, tmpMountPath)
+	}
 
 	// Volumes.
 	volumes := dep.Spec.Template.Spec.Volumes
-	if len(volumes) != 2 {
-		t.Fatalf("expected 2 volumes (SASL + TLS), got %d", len(volumes))
+	if len(volumes) != 3 {
+		t.Fatalf("expected 3 volumes (SASL + TLS + tmp), got %d", len(volumes))
 	}
 	if volumes[0].Name != saslVolumeName || volumes[0].Secret == nil || volumes[0].Secret.SecretName != testSASLSecret {
 		t.Errorf("SASL volume = %+v, want name=%s secret=my-sasl-secret", volumes[0], saslVolumeName)
@@ -3481,3 +3875,1793 @@ func TestConstructDeployment_HPAReplicas(t *testing.T) {
 		})
 	}
 }
+
+// --- Deployment Diff Tests ---
+
+func TestDiffDeploymentFields(t *testing.T) {
+	base := func() *appsv1.Deployment {
+		return &appsv1.Deployment{
+			Spec: appsv1.DeploymentSpec{
+				Replicas: int32Ptr(1),
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{
+							{
+								Name:  testPortName,
+								Image: "memcached:1.6",
+								Args:  []string{"-m", "64"},
+								Resources: corev1.ResourceRequirements{
+									Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("128Mi")},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		mutate func(dep *appsv1.Deployment)
+		want   []string
+	}{
+		{
+			name:   "no changes",
+			mutate: func(*appsv1.Deployment) {},
+			want:   nil,
+		},
+		{
+			name:   "replica change",
+			mutate: func(dep *appsv1.Deployment) { dep.Spec.Replicas = int32Ptr(3) },
+			want:   []string{"replicas"},
+		},
+		{
+			name: "args change",
+			mutate: func(dep *appsv1.Deployment) {
+				dep.Spec.Template.Spec.Containers[0].Args = []string{"-m", "128"}
+			},
+			want: []string{"containers[memcached].args"},
+		},
+		{
+			name: "image and resources change",
+			mutate: func(dep *appsv1.Deployment) {
+				dep.Spec.Template.Spec.Containers[0].Image = "memcached:1.7"
+				dep.Spec.Template.Spec.Containers[0].Resources.Limits[corev1.ResourceMemory] = resource.MustParse("256Mi")
+			},
+			want: []string{"containers[memcached].image", "containers[memcached].resources"},
+		},
+		{
+			name: "semantically equal quantity",
+			mutate: func(dep *appsv1.Deployment) {
+				dep.Spec.Template.Spec.Containers[0].Resources.Limits[corev1.ResourceMemory] = resource.MustParse("131072Ki")
+			},
+			want: nil,
+		},
+		{
+			name: "container added",
+			mutate: func(dep *appsv1.Deployment) {
+				dep.Spec.Template.Spec.Containers = append(dep.Spec.Template.Spec.Containers,
+					corev1.Container{Name: testExporterContainer})
+			},
+			want: []string{"containers[exporter]"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			existing := base()
+			desired := base()
+			tt.mutate(desired)
+
+			got := diffDeploymentFields(existing, desired)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("diffDeploymentFields() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiffDeploymentFields_ContainerRemoved(t *testing.T) {
+	existing := &appsv1.Deployment{}
+	existing.Spec.Template.Spec.Containers = []corev1.Container{{Name: testPortName}, {Name: testExporterContainer}}
+	desired := &appsv1.Deployment{}
+	desired.Spec.Template.Spec.Containers = []corev1.Container{{Name: testPortName}}
+
+	got := diffDeploymentFields(existing, desired)
+	want := []string{"containers[exporter]"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("diffDeploymentFields() = %v, want %v", got, want)
+	}
+}
+
+func TestBuildExporterContainer_MemcachedAddress(t *testing.T) {
+	tests := []struct {
+		name    string
+		address *string
+		socket  *memcachedv1beta1.UnixSocketSpec
+		want    string
+	}{
+		{name: "default tracks memcached port", address: nil, want: fmt.Sprintf("--memcached.address=localhost:%d", PortMemcached)},
+		{name: "explicit override", address: stringPtr("127.0.0.1:21211"), want: "--memcached.address=127.0.0.1:21211"},
+		{
+			name:   "unix socket",
+			socket: &memcachedv1beta1.UnixSocketSpec{Enabled: true},
+			want:   "--memcached.address=" + memcachedv1beta1.DefaultUnixSocketPath,
+		},
+		{
+			name:    "explicit override wins over unix socket",
+			address: stringPtr("127.0.0.1:21211"),
+			socket:  &memcachedv1beta1.UnixSocketSpec{Enabled: true},
+			want:    "--memcached.address=127.0.0.1:21211",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &memcachedv1beta1.Memcached{
+				Spec: memcachedv1beta1.MemcachedSpec{
+					Memcached: &memcachedv1beta1.MemcachedConfig{UnixSocket: tt.socket},
+					Monitoring: &memcachedv1beta1.MonitoringSpec{
+						Enabled:                  true,
+						ExporterMemcachedAddress: tt.address,
+					},
+				},
+			}
+
+			container := buildExporterContainer(mc)
+
+			if !slices.Contains(container.Args, tt.want) {
+				t.Errorf("exporter args = %v, want %q", container.Args, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildExporterContainer_DisabledMetricGroups(t *testing.T) {
+	tests := []struct {
+		name     string
+		groups   []string
+		wantArgs []string
+	}{
+		{name: "none", groups: nil, wantArgs: []string{"--memcached.address=localhost:11211"}},
+		{
+			name:     "slabs and items",
+			groups:   []string{"slabs", "items"},
+			wantArgs: []string{"--memcached.address=localhost:11211", "--no-memcached.slabs", "--no-memcached.items"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &memcachedv1beta1.Memcached{
+				Spec: memcachedv1beta1.MemcachedSpec{
+					Monitoring: &memcachedv1beta1.MonitoringSpec{
+						Enabled:              true,
+						DisabledMetricGroups: tt.groups,
+					},
+				},
+			}
+
+			container := buildExporterContainer(mc)
+
+			if !reflect.DeepEqual(container.Args, tt.wantArgs) {
+				t.Errorf("exporter args = %v, want %v", container.Args, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestBuildExporterContainer_ReadinessProbe(t *testing.T) {
+	tests := []struct {
+		name        string
+		probe       bool
+		exporterTLS bool
+		wantNil     bool
+		wantURL     string
+	}{
+		{name: "disabled", probe: false, wantNil: true},
+		{name: "enabled", probe: true, wantURL: "http://localhost:9150/metrics"},
+		{name: "enabled with exporter TLS", probe: true, exporterTLS: true, wantURL: "https://localhost:9150/metrics"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &memcachedv1beta1.Memcached{
+				Spec: memcachedv1beta1.MemcachedSpec{
+					Monitoring: &memcachedv1beta1.MonitoringSpec{
+						Enabled:                true,
+						ExporterReadinessProbe: tt.probe,
+					},
+				},
+			}
+			if tt.exporterTLS {
+				mc.Spec.Monitoring.ExporterTLS = &memcachedv1beta1.ExporterTLSSpec{
+					Enabled:              true,
+					CertificateSecretRef: corev1.LocalObjectReference{Name: "exporter-tls"},
+				}
+			}
+
+			probe := buildExporterContainer(mc).ReadinessProbe
+
+			if tt.wantNil {
+				if probe != nil {
+					t.Errorf("expected no exporter readiness probe, got %+v", probe)
+				}
+				return
+			}
+			if probe == nil || probe.Exec == nil {
+				t.Fatalf("expected an exec readiness probe, got %+v", probe)
+			}
+			cmd := strings.Join(probe.Exec.Command, " ")
+			if !strings.Contains(cmd, tt.wantURL) {
+				t.Errorf("probe command %q should fetch %s", cmd, tt.wantURL)
+			}
+			if !strings.Contains(cmd, "grep -q '^memcached_up 1'") {
+				t.Errorf("probe command %q should require memcached_up 1", cmd)
+			}
+		})
+	}
+}
+
+func TestBuildPushGatewayContainer(t *testing.T) {
+	envOf := func(c *corev1.Container) map[string]string {
+		env := make(map[string]string, len(c.Env))
+		for _, e := range c.Env {
+			env[e.Name] = e.Value
+		}
+		return env
+	}
+	newMC := func(monitoring *memcachedv1beta1.MonitoringSpec) *memcachedv1beta1.Memcached {
+		return &memcachedv1beta1.Memcached{
+			ObjectMeta: metav1.ObjectMeta{Name: "cache", Namespace: "default"},
+			Spec:       memcachedv1beta1.MemcachedSpec{Monitoring: monitoring},
+		}
+	}
+
+	t.Run("absent without pushGateway", func(t *testing.T) {
+		if c := buildPushGatewayContainer(newMC(&memcachedv1beta1.MonitoringSpec{Enabled: true})); c != nil {
+			t.Errorf("expected no container, got %+v", c)
+		}
+	})
+
+	t.Run("absent while monitoring is disabled", func(t *testing.T) {
+		mc := newMC(&memcachedv1beta1.MonitoringSpec{
+			PushGateway: &memcachedv1beta1.PushGatewaySpec{URL: "http://pushgateway:9091"},
+		})
+		if c := buildPushGatewayContainer(mc); c != nil {
+			t.Errorf("expected no container, got %+v", c)
+		}
+	})
+
+	t.Run("defaults job name and interval", func(t *testing.T) {
+		mc := newMC(&memcachedv1beta1.MonitoringSpec{
+			Enabled:     true,
+			PushGateway: &memcachedv1beta1.PushGatewaySpec{URL: "http://pushgateway:9091"},
+		})
+		c := buildPushGatewayContainer(mc)
+		if c == nil {
+			t.Fatal("expected a push container")
+		}
+		if c.Name != pushGatewayContainerName || c.Image != memcachedv1beta1.DefaultExporterImage {
+			t.Errorf("container = %s (%s), want %s (%s)", c.Name, c.Image, pushGatewayContainerName, memcachedv1beta1.DefaultExporterImage)
+		}
+		env := envOf(c)
+		want := map[string]string{
+			"PUSHGATEWAY_URL":       "http://pushgateway:9091",
+			"PUSHGATEWAY_JOB":       "cache",
+			"PUSH_INTERVAL_SECONDS": "30",
+		}
+		for k, v := range want {
+			if env[k] != v {
+				t.Errorf("env %s = %q, want %q", k, env[k], v)
+			}
+		}
+		script := c.Command[len(c.Command)-1]
+		for _, part := range []string{
+			"wget -qO- http://localhost:9150/metrics",
+			"--post-file /dev/stdin",
+			"/metrics/job/${PUSHGATEWAY_JOB}/instance/${POD_NAME}",
+			`sleep "${PUSH_INTERVAL_SECONDS}"`,
+			"trap 'exit 0' TERM",
+		} {
+			if !strings.Contains(script, part) {
+				t.Errorf("script %q does not contain %q", script, part)
+			}
+		}
+	})
+
+	t.Run("explicit job name, interval, and exporter TLS", func(t *testing.T) {
+		mc := newMC(&memcachedv1beta1.MonitoringSpec{
+			Enabled:       true,
+			ExporterImage: stringPtr("registry.example.com/memcached-exporter:v1"),
+			ExporterTLS: &memcachedv1beta1.ExporterTLSSpec{
+				Enabled:              true,
+				CertificateSecretRef: corev1.LocalObjectReference{Name: "exporter-tls"},
+			},
+			PushGateway: &memcachedv1beta1.PushGatewaySpec{
+				URL:             "https://pushgateway:9091",
+				JobName:         "batch-cache",
+				IntervalSeconds: int32Ptr(120),
+			},
+		})
+		c := buildPushGatewayContainer(mc)
+		if c == nil {
+			t.Fatal("expected a push container")
+		}
+		if c.Image != "registry.example.com/memcached-exporter:v1" {
+			t.Errorf("image = %q, want the exporter image", c.Image)
+		}
+		env := envOf(c)
+		if env["PUSHGATEWAY_JOB"] != "batch-cache" || env["PUSH_INTERVAL_SECONDS"] != "120" {
+			t.Errorf("env = %v, want job batch-cache and interval 120", env)
+		}
+		if script := c.Command[len(c.Command)-1]; !strings.Contains(script, "https://localhost:9150/metrics") {
+			t.Errorf("script %q does not fetch metrics over HTTPS", script)
+		}
+	})
+
+	t.Run("wired into the Deployment", func(t *testing.T) {
+		mc := newMC(&memcachedv1beta1.MonitoringSpec{
+			Enabled:     true,
+			PushGateway: &memcachedv1beta1.PushGatewaySpec{URL: "http://pushgateway:9091"},
+		})
+		dep := &appsv1.Deployment{}
+		constructDeployment(mc, dep, "", "")
+
+		var names []string
+		for _, c := range dep.Spec.Template.Spec.Containers {
+			names = append(names, c.Name)
+		}
+		if want := []string{"memcached", "exporter", pushGatewayContainerName}; !reflect.DeepEqual(names, want) {
+			t.Errorf("containers = %v, want %v", names, want)
+		}
+	})
+}
+
+func TestConstructDeployment_ExporterReadinessProbe(t *testing.T) {
+	mc := &memcachedv1beta1.Memcached{
+		ObjectMeta: metav1.ObjectMeta{Name: "probe", Namespace: "default"},
+		Spec: memcachedv1beta1.MemcachedSpec{
+			Monitoring: &memcachedv1beta1.MonitoringSpec{Enabled: true, ExporterReadinessProbe: true},
+		},
+	}
+	dep := &appsv1.Deployment{}
+
+	constructDeployment(mc, dep, "", "")
+
+	containers := dep.Spec.Template.Spec.Containers
+	if len(containers) != 2 || containers[1].Name != "exporter" {
+		t.Fatalf("expected memcached and exporter containers, got %d", len(containers))
+	}
+	if containers[1].ReadinessProbe == nil {
+		t.Error("exporter container should have a readiness probe")
+	}
+	if containers[0].ReadinessProbe == nil || containers[0].ReadinessProbe.TCPSocket == nil {
+		t.Error("memcached container should keep its TCP readiness probe")
+	}
+}
+
+func TestBuildRollingUpdate(t *testing.T) {
+	tests := []struct {
+		name               string
+		rollingUpdate      *memcachedv1beta1.RollingUpdateSpec
+		wantMaxSurge       intstr.IntOrString
+		wantMaxUnavailable intstr.IntOrString
+	}{
+		{
+			name:               "defaults",
+			rollingUpdate:      nil,
+			wantMaxSurge:       intstr.FromInt32(1),
+			wantMaxUnavailable: intstr.FromInt32(0),
+		},
+		{
+			name:               "absolute values",
+			rollingUpdate:      &memcachedv1beta1.RollingUpdateSpec{MaxSurge: int32Ptr(3), MaxUnavailable: int32Ptr(2)},
+			wantMaxSurge:       intstr.FromInt32(3),
+			wantMaxUnavailable: intstr.FromInt32(2),
+		},
+		{
+			name:               "percentages",
+			rollingUpdate:      &memcachedv1beta1.RollingUpdateSpec{MaxSurgePercent: int32Ptr(25), MaxUnavailablePercent: int32Ptr(10)},
+			wantMaxSurge:       intstr.FromString("25%"),
+			wantMaxUnavailable: intstr.FromString("10%"),
+		},
+		{
+			name:               "only maxUnavailablePercent keeps default surge",
+			rollingUpdate:      &memcachedv1beta1.RollingUpdateSpec{MaxUnavailablePercent: int32Ptr(20)},
+			wantMaxSurge:       intstr.FromInt32(1),
+			wantMaxUnavailable: intstr.FromString("20%"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &memcachedv1beta1.Memcached{
+				ObjectMeta: metav1.ObjectMeta{Name: "ru", Namespace: "default"},
+				Spec:       memcachedv1beta1.MemcachedSpec{RollingUpdate: tt.rollingUpdate},
+			}
+			dep := &appsv1.Deployment{}
+
+			constructDeployment(mc, dep, "", "")
+
+			ru := dep.Spec.Strategy.RollingUpdate
+			if *ru.MaxSurge != tt.wantMaxSurge {
+				t.Errorf("maxSurge = %v, want %v", ru.MaxSurge.String(), tt.wantMaxSurge.String())
+			}
+			if *ru.MaxUnavailable != tt.wantMaxUnavailable {
+				t.Errorf("maxUnavailable = %v, want %v", ru.MaxUnavailable.String(), tt.wantMaxUnavailable.String())
+			}
+		})
+	}
+}
+
+func TestBuildMemcachedArgs_IdleTimeout(t *testing.T) {
+	t.Run("omitted when unset", func(t *testing.T) {
+		got := buildMemcachedArgs(&memcachedv1beta1.MemcachedConfig{}, nil, nil)
+		for _, arg := range got {
+			if strings.HasPrefix(arg, "idle_timeout") {
+				t.Errorf("unexpected %q in args %v", arg, got)
+			}
+		}
+	})
+
+	t.Run("grouped before TLS options and extra args", func(t *testing.T) {
+		config := &memcachedv1beta1.MemcachedConfig{
+			IdleTimeoutSeconds: int32Ptr(300),
+			ExtraArgs:          []string{"-o", "modern"},
+		}
+		tls := &memcachedv1beta1.TLSSpec{
+			Enabled:              true,
+			CertificateSecretRef: corev1.LocalObjectReference{Name: testTLSSecret},
+		}
+
+		got := buildMemcachedArgs(config, nil, tls)
+
+		expected := []string{
+			"-m", "64", "-c", "1024", "-t", "4", "-I", "1m",
+			"-l", "notls:*:11211", "-l", "*:11212",
+			"-o", "idle_timeout=300",
+			"-Z",
+			"-o", "ssl_chain_cert=/etc/memcached/tls/tls.crt",
+			"-o", "ssl_key=/etc/memcached/tls/tls.key",
+			"-o", "modern",
+		}
+		if !reflect.DeepEqual(got, expected) {
+			t.Errorf("buildMemcachedArgs() =\n%v\nwant:\n%v", got, expected)
+		}
+	})
+}
+
+func TestBuildMemcachedArgs_SlabRebalancing(t *testing.T) {
+	tests := []struct {
+		name     string
+		reassign *bool
+		automove *int32
+		want     []string
+	}{
+		{name: "unset", want: nil},
+		{name: "reassign enabled", reassign: boolPtr(true), want: []string{"-o", "slab_reassign"}},
+		{name: "reassign disabled", reassign: boolPtr(false), want: []string{"-o", "no_slab_reassign"}},
+		{name: "automove only", automove: int32Ptr(0), want: []string{"-o", "slab_automove=0"}},
+		{
+			name:     "reassign and aggressive automove",
+			reassign: boolPtr(true),
+			automove: int32Ptr(2),
+			want:     []string{"-o", "slab_reassign", "-o", "slab_automove=2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := &memcachedv1beta1.MemcachedConfig{
+				IdleTimeoutSeconds: int32Ptr(60),
+				SlabReassign:       tt.reassign,
+				SlabAutomove:       tt.automove,
+				ExtraArgs:          []string{"-o", "modern"},
+			}
+
+			got := buildMemcachedArgs(config, nil, nil)
+
+			// Slab options follow idle_timeout in the "-o" group, ahead of extra args.
+			expected := append([]string{
+				"-m", "64", "-c", "1024", "-t", "4", "-I", "1m",
+				"-o", "idle_timeout=60",
+			}, tt.want...)
+			expected = append(expected, "-o", "modern")
+			if !reflect.DeepEqual(got, expected) {
+				t.Errorf("buildMemcachedArgs() =\n%v\nwant:\n%v", got, expected)
+			}
+		})
+	}
+}
+
+func TestBuildMemcachedArgs_ListenAddresses(t *testing.T) {
+	tests := []struct {
+		name      string
+		addresses []string
+		wantArgs  []string
+	}{
+		{name: "single address", addresses: []string{"127.0.0.1"}, wantArgs: []string{"-l", "127.0.0.1"}},
+		{
+			name:      "multiple addresses",
+			addresses: []string{"127.0.0.1", "$(POD_IP)"},
+			wantArgs:  []string{"-l", "127.0.0.1", "-l", "$(POD_IP)"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := buildMemcachedArgs(&memcachedv1beta1.MemcachedConfig{ListenAddresses: tt.addresses}, nil, nil)
+
+			// Listen flags follow the standard flags.
+			want := append([]string{"-m", "64", "-c", "1024", "-t", "4", "-I", "1m"}, tt.wantArgs...)
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("buildMemcachedArgs() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestBuildMemcachedArgs_TLSListeners(t *testing.T) {
+	tests := []struct {
+		name      string
+		addresses []string
+		port      *int32
+		wantArgs  []string
+	}{
+		{
+			name:     "all interfaces",
+			wantArgs: []string{"-l", "notls:*:11211", "-l", "*:11212"},
+		},
+		{
+			name:     "custom TLS port",
+			port:     int32Ptr(11443),
+			wantArgs: []string{"-l", "notls:*:11211", "-l", "*:11443"},
+		},
+		{
+			name:      "loopback only binds TLS to the Pod IP",
+			addresses: []string{"127.0.0.1"},
+			port:      int32Ptr(11443),
+			wantArgs:  []string{"-l", "notls:127.0.0.1:11211", "-l", "$(POD_IP):11443"},
+		},
+		{
+			name:      "loopback and Pod IP",
+			addresses: []string{"127.0.0.1", "$(POD_IP)"},
+			wantArgs:  []string{"-l", "notls:127.0.0.1:11211", "-l", "notls:$(POD_IP):11211", "-l", "$(POD_IP):11212"},
+		},
+		{
+			name:      "explicit addresses",
+			addresses: []string{"$(POD_IP)"},
+			port:      int32Ptr(11443),
+			wantArgs:  []string{"-l", "notls:$(POD_IP):11211", "-l", "$(POD_IP):11443"},
+		},
+		{
+			name:      "IPv6 literal",
+			addresses: []string{"::"},
+			wantArgs:  []string{"-l", "notls:[::]:11211", "-l", "[::]:11212"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tls := &memcachedv1beta1.TLSSpec{
+				Enabled:              true,
+				CertificateSecretRef: corev1.LocalObjectReference{Name: testTLSSecret},
+				Port:                 tt.port,
+			}
+			got := buildMemcachedArgs(&memcachedv1beta1.MemcachedConfig{ListenAddresses: tt.addresses}, nil, tls)
+
+			want := append([]string{"-m", "64", "-c", "1024", "-t", "4", "-I", "1m"}, tt.wantArgs...)
+			if !reflect.DeepEqual(got[:len(want)], want) {
+				t.Errorf("buildMemcachedArgs() = %v, want prefix %v", got, want)
+			}
+		})
+	}
+
+	t.Run("unix socket keeps the listen addresses unchanged", func(t *testing.T) {
+		config := &memcachedv1beta1.MemcachedConfig{
+			ListenAddresses: []string{"127.0.0.1"},
+			UnixSocket:      &memcachedv1beta1.UnixSocketSpec{Enabled: true},
+		}
+		tls := &memcachedv1beta1.TLSSpec{
+			Enabled:              true,
+			CertificateSecretRef: corev1.LocalObjectReference{Name: testTLSSecret},
+		}
+		got := buildMemcachedArgs(config, nil, tls)
+		if !slices.Contains(got, "127.0.0.1") || slices.ContainsFunc(got, func(a string) bool { return strings.HasPrefix(a, "notls:") }) {
+			t.Errorf("buildMemcachedArgs() = %v, want the plain -l 127.0.0.1 listener", got)
+		}
+	})
+}
+
+func TestBuildMemcachedArgs_UnixSocket(t *testing.T) {
+	tests := []struct {
+		name     string
+		socket   *memcachedv1beta1.UnixSocketSpec
+		wantArgs []string
+	}{
+		{name: "nil", socket: nil, wantArgs: nil},
+		{name: "disabled", socket: &memcachedv1beta1.UnixSocketSpec{Path: stringPtr("/tmp/mc.sock")}, wantArgs: nil},
+		{
+			name:     "enabled with default path",
+			socket:   &memcachedv1beta1.UnixSocketSpec{Enabled: true},
+			wantArgs: []string{"-s", memcachedv1beta1.DefaultUnixSocketPath},
+		},
+		{
+			name:     "enabled with custom path",
+			socket:   &memcachedv1beta1.UnixSocketSpec{Enabled: true, Path: stringPtr("/run/mc/mc.sock")},
+			wantArgs: []string{"-s", "/run/mc/mc.sock"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := buildMemcachedArgs(&memcachedv1beta1.MemcachedConfig{UnixSocket: tt.socket}, nil, nil)
+
+			want := append([]string{"-m", "64", "-c", "1024", "-t", "4", "-I", "1m"}, tt.wantArgs...)
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("buildMemcachedArgs() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestConstructDeployment_UnixSocket(t *testing.T) {
+	t.Run("disabled adds no socket volume", func(t *testing.T) {
+		mc := &memcachedv1beta1.Memcached{
+			ObjectMeta: metav1.ObjectMeta{Name: "cache", Namespace: "default"},
+			Spec: memcachedv1beta1.MemcachedSpec{
+				Monitoring: &memcachedv1beta1.MonitoringSpec{Enabled: true},
+			},
+		}
+		dep := &appsv1.Deployment{}
+		constructDeployment(mc, dep, "", "")
+
+		for _, v := range dep.Spec.Template.Spec.Volumes {
+			if v.Name == unixSocketVolumeName {
+				t.Errorf("unexpected volume %q", v.Name)
+			}
+		}
+		for _, c := range dep.Spec.Template.Spec.Containers {
+			for _, vm := range c.VolumeMounts {
+				if vm.Name == unixSocketVolumeName {
+					t.Errorf("container %q: unexpected mount %q", c.Name, vm.Name)
+				}
+			}
+		}
+	})
+
+	t.Run("enabled shares the socket directory with the exporter", func(t *testing.T) {
+		mc := &memcachedv1beta1.Memcached{
+			ObjectMeta: metav1.ObjectMeta{Name: "cache", Namespace: "default"},
+			Spec: memcachedv1beta1.MemcachedSpec{
+				Memcached: &memcachedv1beta1.MemcachedConfig{
+					UnixSocket: &memcachedv1beta1.UnixSocketSpec{Enabled: true, Path: stringPtr("/run/mc/mc.sock")},
+				},
+				Monitoring: &memcachedv1beta1.MonitoringSpec{Enabled: true},
+			},
+		}
+		dep := &appsv1.Deployment{}
+		constructDeployment(mc, dep, "", "")
+
+		var volume *corev1.Volume
+		for i := range dep.Spec.Template.Spec.Volumes {
+			if dep.Spec.Template.Spec.Volumes[i].Name == unixSocketVolumeName {
+				volume = &dep.Spec.Template.Spec.Volumes[i]
+			}
+		}
+		if volume == nil || volume.EmptyDir == nil {
+			t.Fatalf("expected an emptyDir volume %q, got %+v", unixSocketVolumeName, dep.Spec.Template.Spec.Volumes)
+		}
+
+		containers := dep.Spec.Template.Spec.Containers
+		if len(containers) != 2 {
+			t.Fatalf("expected memcached and exporter containers, got %d", len(containers))
+		}
+		for _, c := range containers {
+			found := false
+			for _, vm := range c.VolumeMounts {
+				if vm.Name == unixSocketVolumeName {
+					found = true
+					if vm.MountPath != "/run/mc" {
+						t.Errorf("container %q: mountPath = %q, want %q", c.Name, vm.MountPath, "/run/mc")
+					}
+				}
+			}
+			if !found {
+				t.Errorf("container %q: missing %q mount", c.Name, unixSocketVolumeName)
+			}
+		}
+	})
+}
+
+func TestConstructDeployment_TmpVolume(t *testing.T) {
+	readOnlyRoot := &memcachedv1beta1.SecuritySpec{
+		ContainerSecurityContext: &corev1.SecurityContext{ReadOnlyRootFilesystem: boolPtr(true)},
+	}
+	sizeLimit := resource.MustParse("32Mi")
+
+	tests := []struct {
+		name          string
+		config        *memcachedv1beta1.MemcachedConfig
+		security      *memcachedv1beta1.SecuritySpec
+		wantVolume    bool
+		wantSizeLimit *resource.Quantity
+	}{
+		{name: "writable root filesystem adds no tmp volume"},
+		{
+			name:     "readOnlyRootFilesystem false adds no tmp volume",
+			config:   &memcachedv1beta1.MemcachedConfig{TmpfsSizeLimit: &sizeLimit},
+			security: &memcachedv1beta1.SecuritySpec{ContainerSecurityContext: &corev1.SecurityContext{ReadOnlyRootFilesystem: boolPtr(false)}},
+		},
+		{name: "read-only root filesystem without size limit", security: readOnlyRoot, wantVolume: true},
+		{
+			name:          "read-only root filesystem with size limit",
+			config:        &memcachedv1beta1.MemcachedConfig{TmpfsSizeLimit: &sizeLimit},
+			security:      readOnlyRoot,
+			wantVolume:    true,
+			wantSizeLimit: &sizeLimit,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &memcachedv1beta1.Memcached{
+				ObjectMeta: metav1.ObjectMeta{Name: "cache", Namespace: "default"},
+				Spec:       memcachedv1beta1.MemcachedSpec{Memcached: tt.config, Security: tt.security},
+			}
+			dep := &appsv1.Deployment{}
+			constructDeployment(mc, dep, "", "")
+
+			var volume *corev1.Volume
+			for i := range dep.Spec.Template.Spec.Volumes {
+				if dep.Spec.Template.Spec.Volumes[i].Name == tmpVolumeName {
+					volume = &dep.Spec.Template.Spec.Volumes[i]
+				}
+			}
+			var mount *corev1.VolumeMount
+			for i, vm := range dep.Spec.Template.Spec.Containers[0].VolumeMounts {
+				if vm.Name == tmpVolumeName {
+					mount = &dep.Spec.Template.Spec.Containers[0].VolumeMounts[i]
+				}
+			}
+
+			if !tt.wantVolume {
+				if volume != nil || mount != nil {
+					t.Errorf("unexpected tmp volume %+v or mount %+v", volume, mount)
+				}
+				return
+			}
+			if volume == nil || volume.EmptyDir == nil {
+				t.Fatalf("expected an emptyDir volume %q, got %+v", tmpVolumeName, dep.Spec.Template.Spec.Volumes)
+			}
+			if volume.EmptyDir.Medium != corev1.StorageMediumMemory {
+				t.Errorf("medium = %q, want %q", volume.EmptyDir.Medium, corev1.StorageMediumMemory)
+			}
+			switch {
+			case tt.wantSizeLimit == nil && volume.EmptyDir.SizeLimit != nil:
+				t.Errorf("sizeLimit = %s, want unset", volume.EmptyDir.SizeLimit)
+			case tt.wantSizeLimit != nil && (volume.EmptyDir.SizeLimit == nil || volume.EmptyDir.SizeLimit.Cmp(*tt.wantSizeLimit) != 0):
+				t.Errorf("sizeLimit = %v, want %s", volume.EmptyDir.SizeLimit, tt.wantSizeLimit)
+			}
+			if mount == nil || mount.MountPath != "/tmp" {
+				t.Errorf("expected memcached container to mount %q at /tmp, got %+v", tmpVolumeName, mount)
+			}
+		})
+	}
+}
+
+func TestConstructDeployment_UnixSocketProbes(t *testing.T) {
+	t.Run("TCP probes without a unix socket", func(t *testing.T) {
+		mc := &memcachedv1beta1.Memcached{
+			ObjectMeta: metav1.ObjectMeta{Name: "cache", Namespace: "default"},
+		}
+		dep := &appsv1.Deployment{}
+		constructDeployment(mc, dep, "", "")
+
+		c := dep.Spec.Template.Spec.Containers[0]
+		for name, probe := range map[string]*corev1.Probe{"liveness": c.LivenessProbe, "readiness": c.ReadinessProbe} {
+			if probe.TCPSocket == nil || probe.TCPSocket.Port != intstr.FromString("memcached") {
+				t.Errorf("%s probe = %+v, want TCP socket on the memcached port", name, probe.ProbeHandler)
+			}
+			if probe.Exec != nil {
+				t.Errorf("%s probe: unexpected exec handler %v", name, probe.Exec.Command)
+			}
+		}
+	})
+
+	t.Run("socket-only switches to exec socket checks", func(t *testing.T) {
+		mc := &memcachedv1beta1.Memcached{
+			ObjectMeta: metav1.ObjectMeta{Name: "cache", Namespace: "default"},
+			Spec: memcachedv1beta1.MemcachedSpec{
+				Memcached: &memcachedv1beta1.MemcachedConfig{
+					UnixSocket: &memcachedv1beta1.UnixSocketSpec{Enabled: true, Path: stringPtr("/run/mc/mc.sock")},
+				},
+			},
+		}
+		dep := &appsv1.Deployment{}
+		constructDeployment(mc, dep, "", "")
+
+		c := dep.Spec.Template.Spec.Containers[0]
+		want := []string{"test", "-S", "/run/mc/mc.sock"}
+		for name, probe := range map[string]*corev1.Probe{"liveness": c.LivenessProbe, "readiness": c.ReadinessProbe} {
+			if probe.TCPSocket != nil {
+				t.Errorf("%s probe: unexpected TCP socket handler", name)
+			}
+			if probe.Exec == nil || !reflect.DeepEqual(probe.Exec.Command, want) {
+				t.Errorf("%s probe = %+v, want exec %v", name, probe.ProbeHandler, want)
+			}
+		}
+	})
+}
+
+func TestBuildMemcachedProbeHandler_ListenerSelection(t *testing.T) {
+	tls := &memcachedv1beta1.SecuritySpec{
+		TLS: &memcachedv1beta1.TLSSpec{
+			Enabled:              true,
+			CertificateSecretRef: corev1.LocalObjectReference{Name: "memcached-tls"},
+		},
+	}
+	tests := []struct {
+		name      string
+		addresses []string
+		security  *memcachedv1beta1.SecuritySpec
+		wantPort  string
+	}{
+		{name: "plaintext only", wantPort: "memcached"},
+		{name: "plaintext only on loopback", addresses: []string{"127.0.0.1"}, wantPort: "memcached"},
+		{name: "plaintext and TLS", addresses: []string{memcachedv1beta1.PodIPToken}, security: tls, wantPort: "memcached"},
+		{name: "plaintext and TLS on all interfaces", security: tls, wantPort: "memcached"},
+		{name: "TLS only via the Pod IP", addresses: []string{"127.0.0.1", "::1"}, security: tls, wantPort: tlsPortName},
+		{name: "TLS only with localhost", addresses: []string{"localhost"}, security: tls, wantPort: tlsPortName},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &memcachedv1beta1.Memcached{
+				ObjectMeta: metav1.ObjectMeta{Name: "cache", Namespace: "default"},
+				Spec: memcachedv1beta1.MemcachedSpec{
+					Memcached: &memcachedv1beta1.MemcachedConfig{ListenAddresses: tt.addresses},
+					Security:  tt.security,
+				},
+			}
+			handler := buildMemcachedProbeHandler(mc)
+			if handler.TCPSocket == nil {
+				t.Fatalf("probe handler = %+v, want a TCP socket", handler)
+			}
+			if got := handler.TCPSocket.Port; got != intstr.FromString(tt.wantPort) {
+				t.Errorf("probe port = %v, want %q", got.String(), tt.wantPort)
+			}
+		})
+	}
+}
+
+func TestConstructDeployment_MinReadySeconds(t *testing.T) {
+	soft := memcachedv1beta1.AntiAffinityPresetSoft
+	gracefulShutdown := &memcachedv1beta1.GracefulShutdownSpec{
+		Enabled:                       true,
+		PreStopDelaySeconds:           10,
+		TerminationGracePeriodSeconds: 30,
+	}
+	tests := []struct {
+		name string
+		spec memcachedv1beta1.MemcachedSpec
+		want int32
+	}{
+		{name: "defaults to 0 without HA", spec: memcachedv1beta1.MemcachedSpec{Replicas: int32Ptr(3)}, want: 0},
+		{
+			name: "derived from the readiness probe with multi-replica HA",
+			spec: memcachedv1beta1.MemcachedSpec{
+				Replicas:         int32Ptr(3),
+				HighAvailability: &memcachedv1beta1.HighAvailabilitySpec{AntiAffinityPreset: &soft},
+			},
+			want: readinessProbePeriodSeconds,
+		},
+		{
+			name: "0 with HA and a single replica",
+			spec: memcachedv1beta1.MemcachedSpec{
+				Replicas:         int32Ptr(1),
+				HighAvailability: &memcachedv1beta1.HighAvailabilitySpec{AntiAffinityPreset: &soft},
+			},
+			want: 0,
+		},
+		{
+			name: "derived from the readiness probe with graceful shutdown",
+			spec: memcachedv1beta1.MemcachedSpec{
+				Replicas:         int32Ptr(1),
+				HighAvailability: &memcachedv1beta1.HighAvailabilitySpec{GracefulShutdown: gracefulShutdown},
+			},
+			want: readinessProbePeriodSeconds,
+		},
+		{
+			name: "explicit value wins over the derived default",
+			spec: memcachedv1beta1.MemcachedSpec{
+				Replicas:         int32Ptr(3),
+				MinReadySeconds:  int32Ptr(30),
+				HighAvailability: &memcachedv1beta1.HighAvailabilitySpec{GracefulShutdown: gracefulShutdown},
+			},
+			want: 30,
+		},
+		{
+			name: "explicit zero disables the derived default",
+			spec: memcachedv1beta1.MemcachedSpec{
+				Replicas:         int32Ptr(3),
+				MinReadySeconds:  int32Ptr(0),
+				HighAvailability: &memcachedv1beta1.HighAvailabilitySpec{AntiAffinityPreset: &soft},
+			},
+			want: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &memcachedv1beta1.Memcached{
+				ObjectMeta: metav1.ObjectMeta{Name: "cache", Namespace: "default"},
+				Spec:       tt.spec,
+			}
+			dep := &appsv1.Deployment{}
+			constructDeployment(mc, dep, "", "")
+
+			if dep.Spec.MinReadySeconds != tt.want {
+				t.Errorf("minReadySeconds = %d, want %d", dep.Spec.MinReadySeconds, tt.want)
+			}
+			if got := dep.Spec.Template.Spec.Containers[0].ReadinessProbe.PeriodSeconds; got != readinessProbePeriodSeconds {
+				t.Errorf("readiness probe periodSeconds = %d, want %d", got, readinessProbePeriodSeconds)
+			}
+		})
+	}
+}
+
+func TestConstructDeployment_EphemeralStorage(t *testing.T) {
+	resources := corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{
+			corev1.ResourceMemory:           resource.MustParse("128Mi"),
+			corev1.ResourceEphemeralStorage: resource.MustParse("10Gi"),
+		},
+		Limits: corev1.ResourceList{
+			corev1.ResourceMemory:           resource.MustParse("256Mi"),
+			corev1.ResourceEphemeralStorage: resource.MustParse("12Gi"),
+		},
+	}
+	mc := &memcachedv1beta1.Memcached{
+		ObjectMeta: metav1.ObjectMeta{Name: "cache", Namespace: "default"},
+		Spec:       memcachedv1beta1.MemcachedSpec{Resources: &resources},
+	}
+	dep := &appsv1.Deployment{}
+	constructDeployment(mc, dep, "", "")
+
+	got := dep.Spec.Template.Spec.Containers[0].Resources
+	if q := got.Requests[corev1.ResourceEphemeralStorage]; q.Cmp(resource.MustParse("10Gi")) != 0 {
+		t.Errorf("ephemeral-storage request = %s, want 10Gi", q.String())
+	}
+	if q := got.Limits[corev1.ResourceEphemeralStorage]; q.Cmp(resource.MustParse("12Gi")) != 0 {
+		t.Errorf("ephemeral-storage limit = %s, want 12Gi", q.String())
+	}
+	if !reflect.DeepEqual(got, resources) {
+		t.Errorf("container resources = %v, want %v", got, resources)
+	}
+}
+
+func TestConstructDeployment_PodOverhead(t *testing.T) {
+	t.Run("unset leaves runtime class and overhead empty", func(t *testing.T) {
+		mc := &memcachedv1beta1.Memcached{
+			ObjectMeta: metav1.ObjectMeta{Name: "cache", Namespace: "default"},
+		}
+		dep := &appsv1.Deployment{}
+		constructDeployment(mc, dep, "", "")
+
+		podSpec := dep.Spec.Template.Spec
+		if podSpec.RuntimeClassName != nil || podSpec.Overhead != nil {
+			t.Errorf("runtimeClassName = %v, overhead = %v, want both unset", podSpec.RuntimeClassName, podSpec.Overhead)
+		}
+	})
+
+	t.Run("overhead coexists with container resources", func(t *testing.T) {
+		overhead := corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("250m"),
+			corev1.ResourceMemory: resource.MustParse("120Mi"),
+		}
+		resources := corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("128Mi")},
+			Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("256Mi")},
+		}
+		mc := &memcachedv1beta1.Memcached{
+			ObjectMeta: metav1.ObjectMeta{Name: "cache", Namespace: "default"},
+			Spec: memcachedv1beta1.MemcachedSpec{
+				Resources:        &resources,
+				RuntimeClassName: stringPtr("kata"),
+				PodOverhead:      overhead,
+			},
+		}
+		dep := &appsv1.Deployment{}
+		constructDeployment(mc, dep, "", "")
+
+		podSpec := dep.Spec.Template.Spec
+		if podSpec.RuntimeClassName == nil || *podSpec.RuntimeClassName != "kata" {
+			t.Errorf("runtimeClassName = %v, want kata", podSpec.RuntimeClassName)
+		}
+		if !reflect.DeepEqual(podSpec.Overhead, overhead) {
+			t.Errorf("overhead = %v, want %v", podSpec.Overhead, overhead)
+		}
+		// The overhead is pod-level; container requests and limits stay as configured.
+		if !reflect.DeepEqual(podSpec.Containers[0].Resources, resources) {
+			t.Errorf("container resources = %v, want %v", podSpec.Containers[0].Resources, resources)
+		}
+	})
+}
+
+func TestBuildMemcachedResources_QoSClass(t *testing.T) {
+	tests := []struct {
+		name      string
+		qosClass  corev1.PodQOSClass
+		resources *corev1.ResourceRequirements
+		want      corev1.ResourceRequirements
+	}{
+		{name: "unset resources", qosClass: corev1.PodQOSGuaranteed, want: corev1.ResourceRequirements{}},
+		{
+			name:     "burstable keeps requests only",
+			qosClass: corev1.PodQOSBurstable,
+			resources: &corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m"), corev1.ResourceMemory: resource.MustParse("128Mi")},
+			},
+			want: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m"), corev1.ResourceMemory: resource.MustParse("128Mi")},
+			},
+		},
+		{
+			name:     "guaranteed copies requests to limits",
+			qosClass: corev1.PodQOSGuaranteed,
+			resources: &corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m"), corev1.ResourceMemory: resource.MustParse("128Mi")},
+			},
+			want: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m"), corev1.ResourceMemory: resource.MustParse("128Mi")},
+				Limits:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m"), corev1.ResourceMemory: resource.MustParse("128Mi")},
+			},
+		},
+		{
+			name:     "guaranteed fills each side from whichever is provided",
+			qosClass: corev1.PodQOSGuaranteed,
+			resources: &corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+				Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("256Mi")},
+			},
+			want: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1"), corev1.ResourceMemory: resource.MustParse("256Mi")},
+				Limits:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1"), corev1.ResourceMemory: resource.MustParse("256Mi")},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &memcachedv1beta1.Memcached{
+				ObjectMeta: metav1.ObjectMeta{Name: "cache", Namespace: "default"},
+				Spec:       memcachedv1beta1.MemcachedSpec{Resources: tt.resources, QoSClass: tt.qosClass},
+			}
+			var before *corev1.ResourceRequirements
+			if tt.resources != nil {
+				before = tt.resources.DeepCopy()
+			}
+
+			dep := &appsv1.Deployment{}
+			constructDeployment(mc, dep, "", "")
+
+			if got := dep.Spec.Template.Spec.Containers[0].Resources; !equality.Semantic.DeepEqual(got, tt.want) {
+				t.Errorf("resources = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(mc.Spec.Resources, before) {
+				t.Errorf("spec.resources was mutated: %v, want %v", mc.Spec.Resources, before)
+			}
+		})
+	}
+}
+
+func TestBuildExporterContainer_GuaranteedQoSClass(t *testing.T) {
+	mc := &memcachedv1beta1.Memcached{
+		ObjectMeta: metav1.ObjectMeta{Name: "cache", Namespace: "default"},
+		Spec: memcachedv1beta1.MemcachedSpec{
+			QoSClass: corev1.PodQOSGuaranteed,
+			Monitoring: &memcachedv1beta1.MonitoringSpec{
+				Enabled: true,
+				ExporterResources: &corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("50m"), corev1.ResourceMemory: resource.MustParse("32Mi")},
+				},
+			},
+		},
+	}
+
+	c := buildExporterContainer(mc)
+	if c == nil {
+		t.Fatal("expected exporter container")
+	}
+	want := corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("50m"), corev1.ResourceMemory: resource.MustParse("32Mi")}
+	if !equality.Semantic.DeepEqual(c.Resources.Limits, want) {
+		t.Errorf("exporter limits = %v, want %v", c.Resources.Limits, want)
+	}
+	if _, ok := mc.Spec.Monitoring.ExporterResources.Limits[corev1.ResourceCPU]; ok {
+		t.Error("spec.monitoring.exporterResources was mutated")
+	}
+}
+
+func TestConstructDeployment_SchedulerName(t *testing.T) {
+	t.Run("unset keeps the default scheduler", func(t *testing.T) {
+		mc := &memcachedv1beta1.Memcached{
+			ObjectMeta: metav1.ObjectMeta{Name: "cache", Namespace: "default"},
+		}
+		dep := &appsv1.Deployment{}
+		constructDeployment(mc, dep, "", "")
+
+		if got := dep.Spec.Template.Spec.SchedulerName; got != "" {
+			t.Errorf("schedulerName = %q, want empty", got)
+		}
+	})
+
+	t.Run("set", func(t *testing.T) {
+		mc := &memcachedv1beta1.Memcached{
+			ObjectMeta: metav1.ObjectMeta{Name: "cache", Namespace: "default"},
+			Spec:       memcachedv1beta1.MemcachedSpec{SchedulerName: stringPtr("volcano")},
+		}
+		dep := &appsv1.Deployment{}
+		constructDeployment(mc, dep, "", "")
+
+		if got := dep.Spec.Template.Spec.SchedulerName; got != "volcano" {
+			t.Errorf("schedulerName = %q, want volcano", got)
+		}
+	})
+}
+
+func TestConstructDeployment_Command(t *testing.T) {
+	t.Run("default keeps the image entrypoint", func(t *testing.T) {
+		mc := &memcachedv1beta1.Memcached{
+			ObjectMeta: metav1.ObjectMeta{Name: "cache", Namespace: "default"},
+		}
+		dep := &appsv1.Deployment{}
+		constructDeployment(mc, dep, "", "")
+
+		c := dep.Spec.Template.Spec.Containers[0]
+		if c.Command != nil {
+			t.Errorf("Command = %v, want nil", c.Command)
+		}
+		if len(c.Args) == 0 {
+			t.Error("expected operator-generated args")
+		}
+	})
+
+	t.Run("command override keeps the generated args", func(t *testing.T) {
+		mc := &memcachedv1beta1.Memcached{
+			ObjectMeta: metav1.ObjectMeta{Name: "cache", Namespace: "default"},
+			Spec: memcachedv1beta1.MemcachedSpec{
+				Memcached: &memcachedv1beta1.MemcachedConfig{
+					Command: []string{"/entrypoint.sh", "memcached"},
+				},
+			},
+		}
+		dep := &appsv1.Deployment{}
+		constructDeployment(mc, dep, "", "")
+
+		c := dep.Spec.Template.Spec.Containers[0]
+		if want := []string{"/entrypoint.sh", "memcached"}; !reflect.DeepEqual(c.Command, want) {
+			t.Errorf("Command = %v, want %v", c.Command, want)
+		}
+		wantArgs := buildMemcachedArgs(mc.Spec.Memcached, nil, nil)
+		if !reflect.DeepEqual(c.Args, wantArgs) {
+			t.Errorf("Args = %v, want %v", c.Args, wantArgs)
+		}
+	})
+}
+
+func TestConstructDeployment_EntrypointConfigMap(t *testing.T) {
+	mc := &memcachedv1beta1.Memcached{
+		ObjectMeta: metav1.ObjectMeta{Name: "cache", Namespace: "default"},
+		Spec: memcachedv1beta1.MemcachedSpec{
+			Memcached: &memcachedv1beta1.MemcachedConfig{
+				EntrypointConfigMapRef: &corev1.LocalObjectReference{Name: "memcached-entrypoint"},
+				EntrypointPath:         stringPtr("wrapper.sh"),
+			},
+		},
+	}
+	dep := &appsv1.Deployment{}
+	constructDeployment(mc, dep, "", "")
+
+	podSpec := dep.Spec.Template.Spec
+	c := podSpec.Containers[0]
+	if want := []string{"/etc/memcached/entrypoint/wrapper.sh"}; !reflect.DeepEqual(c.Command, want) {
+		t.Errorf("Command = %v, want %v", c.Command, want)
+	}
+	wantArgs := buildMemcachedArgs(mc.Spec.Memcached, nil, nil)
+	if !reflect.DeepEqual(c.Args, wantArgs) {
+		t.Errorf("Args = %v, want %v", c.Args, wantArgs)
+	}
+
+	var mount *corev1.VolumeMount
+	for i := range c.VolumeMounts {
+		if c.VolumeMounts[i].Name == "memcached-entrypoint" {
+			mount = &c.VolumeMounts[i]
+		}
+	}
+	if mount == nil {
+		t.Fatalf("expected memcached-entrypoint volume mount, got %v", c.VolumeMounts)
+	}
+	if mount.MountPath != "/etc/memcached/entrypoint" || !mount.ReadOnly {
+		t.Errorf("mount = %+v, want read-only at /etc/memcached/entrypoint", mount)
+	}
+
+	var vol *corev1.Volume
+	for i := range podSpec.Volumes {
+		if podSpec.Volumes[i].Name == "memcached-entrypoint" {
+			vol = &podSpec.Volumes[i]
+		}
+	}
+	if vol == nil || vol.ConfigMap == nil {
+		t.Fatalf("expected memcached-entrypoint ConfigMap volume, got %v", podSpec.Volumes)
+	}
+	if vol.ConfigMap.Name != "memcached-entrypoint" {
+		t.Errorf("ConfigMap name = %q, want memcached-entrypoint", vol.ConfigMap.Name)
+	}
+	if want := []corev1.KeyToPath{{Key: "wrapper.sh", Path: "wrapper.sh"}}; !reflect.DeepEqual(vol.ConfigMap.Items, want) {
+		t.Errorf("items = %v, want %v", vol.ConfigMap.Items, want)
+	}
+	if vol.ConfigMap.DefaultMode == nil || *vol.ConfigMap.DefaultMode != 0o555 {
+		t.Errorf("defaultMode = %v, want 0555", vol.ConfigMap.DefaultMode)
+	}
+
+	t.Run("unset adds no volume", func(t *testing.T) {
+		mc := &memcachedv1beta1.Memcached{
+			ObjectMeta: metav1.ObjectMeta{Name: "cache", Namespace: "default"},
+		}
+		dep := &appsv1.Deployment{}
+		constructDeployment(mc, dep, "", "")
+
+		for _, v := range dep.Spec.Template.Spec.Volumes {
+			if v.Name == "memcached-entrypoint" {
+				t.Error("unexpected memcached-entrypoint volume")
+			}
+		}
+	})
+}
+
+func TestBuildMemcachedEnv_DownwardAPI(t *testing.T) {
+	env := buildMemcachedEnv()
+
+	want := map[string]string{
+		"POD_NAME":      "metadata.name",
+		"POD_NAMESPACE": "metadata.namespace",
+		"POD_IP":        "status.podIP",
+	}
+	if len(env) != len(want) {
+		t.Fatalf("env = %v, want %d entries", env, len(want))
+	}
+	seen := make(map[string]bool)
+	for _, e := range env {
+		if seen[e.Name] {
+			t.Errorf("env %s is duplicated", e.Name)
+		}
+		seen[e.Name] = true
+		fieldPath, ok := want[e.Name]
+		if !ok {
+			t.Errorf("unexpected env %s", e.Name)
+			continue
+		}
+		if e.ValueFrom == nil || e.ValueFrom.FieldRef == nil || e.ValueFrom.FieldRef.FieldPath != fieldPath {
+			t.Errorf("env %s = %+v, want fieldRef %s", e.Name, e, fieldPath)
+		}
+	}
+}
+
+func TestConstructDeployment_ListenAddressPodIPWiring(t *testing.T) {
+	mc := &memcachedv1beta1.Memcached{
+		ObjectMeta: metav1.ObjectMeta{Name: "listen", Namespace: "default"},
+		Spec: memcachedv1beta1.MemcachedSpec{
+			Memcached: &memcachedv1beta1.MemcachedConfig{
+				ListenAddresses: []string{"127.0.0.1", memcachedv1beta1.PodIPToken},
+			},
+		},
+	}
+	dep := &appsv1.Deployment{}
+
+	constructDeployment(mc, dep, "", "")
+
+	container := dep.Spec.Template.Spec.Containers[0]
+	if !slices.ContainsFunc(container.Env, func(e corev1.EnvVar) bool { return e.Name == envPodIP }) {
+		t.Errorf("env = %v, want %s", container.Env, envPodIP)
+	}
+	if !slices.Contains(container.Args, "$(POD_IP)") {
+		t.Errorf("args = %v, want $(POD_IP) listen address", container.Args)
+	}
+}
+
+func TestImageVersion(t *testing.T) {
+	tests := []struct {
+		name  string
+		image string
+		want  string
+	}{
+		{name: "versioned tag", image: "memcached:1.6.29", want: "1.6.29"},
+		{name: "latest", image: "memcached:latest", want: "latest"},
+		{name: "untagged", image: "memcached", want: ""},
+		{name: "digest", image: "memcached@sha256:" + strings.Repeat("a", 64), want: imageVersionUnknown},
+		{name: "tag and digest", image: "memcached:1.6.29@sha256:" + strings.Repeat("a", 64), want: "1.6.29"},
+		{name: "registry port", image: "registry.io:5000/memcached:1.6.29", want: "1.6.29"},
+		{name: "registry port untagged", image: "registry.io:5000/memcached", want: ""},
+		{name: "tag too long for a label", image: "memcached:" + strings.Repeat("1", 64), want: imageVersionUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := imageVersion(tt.image); got != tt.want {
+				t.Errorf("imageVersion(%q) = %q, want %q", tt.image, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConstructDeployment_VersionLabel(t *testing.T) {
+	tests := []struct {
+		name  string
+		image string
+		want  string
+	}{
+		{name: "versioned tag", image: "memcached:1.6.29", want: "1.6.29"},
+		{name: "latest", image: "memcached:latest", want: "latest"},
+		{name: "digest", image: "memcached@sha256:" + strings.Repeat("b", 64), want: "unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &memcachedv1beta1.Memcached{
+				ObjectMeta: metav1.ObjectMeta{Name: "cache", Namespace: "default"},
+				Spec:       memcachedv1beta1.MemcachedSpec{Image: stringPtr(tt.image)},
+			}
+			dep := &appsv1.Deployment{}
+			constructDeployment(mc, dep, "", "")
+
+			if got := dep.Labels["app.kubernetes.io/version"]; got != tt.want {
+				t.Errorf("Deployment version label = %q, want %q", got, tt.want)
+			}
+			if got := dep.Spec.Template.Labels["app.kubernetes.io/version"]; got != tt.want {
+				t.Errorf("Pod template version label = %q, want %q", got, tt.want)
+			}
+			if _, ok := dep.Spec.Selector.MatchLabels["app.kubernetes.io/version"]; ok {
+				t.Error("selector must not include the version label")
+			}
+		})
+	}
+}
+
+func TestImagePullPolicy(t *testing.T) {
+	never := corev1.PullNever
+	tests := []struct {
+		name     string
+		image    string
+		override *corev1.PullPolicy
+		want     corev1.PullPolicy
+	}{
+		{name: "versioned tag", image: "memcached:1.6.28", want: corev1.PullIfNotPresent},
+		{name: "latest tag", image: "memcached:latest", want: corev1.PullAlways},
+		{name: "untagged", image: "memcached", want: corev1.PullAlways},
+		{name: "registry port without tag", image: "registry.example.com:5000/memcached", want: corev1.PullAlways},
+		{name: "registry port with tag", image: "registry.example.com:5000/memcached:1.6", want: corev1.PullIfNotPresent},
+		{name: "digest", image: "memcached@sha256:" + strings.Repeat("a", 64), want: corev1.PullIfNotPresent},
+		{name: "explicit override", image: "memcached:1.6.28", override: &never, want: corev1.PullNever},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &memcachedv1beta1.Memcached{
+				Spec: memcachedv1beta1.MemcachedSpec{Image: &tt.image, ImagePullPolicy: tt.override},
+			}
+			if got := imagePullPolicy(mc, tt.image); got != tt.want {
+				t.Errorf("imagePullPolicy(%q) = %q, want %q", tt.image, got, tt.want)
+			}
+
+			dep := &appsv1.Deployment{}
+			constructDeployment(mc, dep, "", "")
+			if got := dep.Spec.Template.Spec.Containers[0].ImagePullPolicy; got != tt.want {
+				t.Errorf("memcached container imagePullPolicy = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConstructDeployment_StatsSidecar(t *testing.T) {
+	statsImage := "example.com/memcached-stats:1.0"
+	runAsNonRoot := true
+	tests := []struct {
+		name           string
+		sidecar        *memcachedv1beta1.StatsSidecarSpec
+		monitoring     bool
+		wantContainers []string
+		wantPort       int32
+	}{
+		{name: "not configured", wantContainers: []string{"memcached"}},
+		{
+			name:           "disabled",
+			sidecar:        &memcachedv1beta1.StatsSidecarSpec{Enabled: false, Image: &statsImage},
+			wantContainers: []string{"memcached"},
+		},
+		{
+			name:           "enabled with default port",
+			sidecar:        &memcachedv1beta1.StatsSidecarSpec{Enabled: true, Image: &statsImage},
+			wantContainers: []string{"memcached", "stats"},
+			wantPort:       memcachedv1beta1.DefaultStatsSidecarPort,
+		},
+		{
+			name:           "enabled with exporter",
+			sidecar:        &memcachedv1beta1.StatsSidecarSpec{Enabled: true, Image: &statsImage, Port: int32Ptr(8081)},
+			monitoring:     true,
+			wantContainers: []string{"memcached", "exporter", "stats"},
+			wantPort:       8081,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &memcachedv1beta1.Memcached{
+				ObjectMeta: metav1.ObjectMeta{Name: "stats", Namespace: "default"},
+				Spec: memcachedv1beta1.MemcachedSpec{
+					StatsSidecar: tt.sidecar,
+					Monitoring:   &memcachedv1beta1.MonitoringSpec{Enabled: tt.monitoring},
+					Security: &memcachedv1beta1.SecuritySpec{
+						ContainerSecurityContext: &corev1.SecurityContext{RunAsNonRoot: &runAsNonRoot},
+					},
+				},
+			}
+			dep := &appsv1.Deployment{}
+
+			constructDeployment(mc, dep, "", "")
+
+			containers := dep.Spec.Template.Spec.Containers
+			var names []string
+			for _, c := range containers {
+				names = append(names, c.Name)
+			}
+			if !reflect.DeepEqual(names, tt.wantContainers) {
+				t.Fatalf("containers = %v, want %v", names, tt.wantContainers)
+			}
+			if tt.wantPort == 0 {
+				return
+			}
+
+			stats := containers[len(containers)-1]
+			if stats.Image != statsImage {
+				t.Errorf("image = %q, want %q", stats.Image, statsImage)
+			}
+			if len(stats.Ports) != 1 || stats.Ports[0].Name != "stats" || stats.Ports[0].ContainerPort != tt.wantPort {
+				t.Errorf("ports = %v, want stats:%d", stats.Ports, tt.wantPort)
+			}
+			wantEnv := []corev1.EnvVar{
+				{Name: "MEMCACHED_ADDRESS", Value: "localhost:11211"},
+				{Name: "STATS_PORT", Value: fmt.Sprintf("%d", tt.wantPort)},
+			}
+			if !reflect.DeepEqual(stats.Env, wantEnv) {
+				t.Errorf("env = %v, want %v", stats.Env, wantEnv)
+			}
+			if stats.SecurityContext == nil || stats.SecurityContext.RunAsNonRoot == nil || !*stats.SecurityContext.RunAsNonRoot {
+				t.Errorf("securityContext = %+v, want the container security context", stats.SecurityContext)
+			}
+		})
+	}
+}
+
+func TestBuildStatsSidecarContainer_MemcachedAddress(t *testing.T) {
+	socketPath := "/run/mc/memcached.sock"
+	exporterAddr := "10.0.0.1:11211"
+	tests := []struct {
+		name        string
+		memcached   *memcachedv1beta1.MemcachedConfig
+		monitoring  *memcachedv1beta1.MonitoringSpec
+		wantAddress string
+		wantMount   bool
+	}{
+		{name: "default", wantAddress: "localhost:11211"},
+		{
+			name: "unix socket",
+			memcached: &memcachedv1beta1.MemcachedConfig{
+				UnixSocket: &memcachedv1beta1.UnixSocketSpec{Enabled: true, Path: &socketPath},
+			},
+			wantAddress: socketPath,
+			wantMount:   true,
+		},
+		{
+			name:        "exporter memcached address",
+			memcached:   &memcachedv1beta1.MemcachedConfig{ListenAddresses: []string{memcachedv1beta1.PodIPToken}},
+			monitoring:  &memcachedv1beta1.MonitoringSpec{ExporterMemcachedAddress: &exporterAddr},
+			wantAddress: exporterAddr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &memcachedv1beta1.Memcached{
+				ObjectMeta: metav1.ObjectMeta{Name: "stats", Namespace: "default"},
+				Spec: memcachedv1beta1.MemcachedSpec{
+					Memcached:    tt.memcached,
+					Monitoring:   tt.monitoring,
+					StatsSidecar: &memcachedv1beta1.StatsSidecarSpec{Enabled: true, Image: stringPtr("stats:1.0")},
+				},
+			}
+
+			container := buildStatsSidecarContainer(mc)
+			if container == nil {
+				t.Fatal("expected a stats sidecar container")
+			}
+			if got := container.Env[0]; got.Name != "MEMCACHED_ADDRESS" || got.Value != tt.wantAddress {
+				t.Errorf("env[0] = %v, want MEMCACHED_ADDRESS=%s", got, tt.wantAddress)
+			}
+
+			var mounted bool
+			for _, vm := range container.VolumeMounts {
+				if vm.Name == unixSocketVolumeName && vm.MountPath == "/run/mc" {
+					mounted = true
+				}
+			}
+			if mounted != tt.wantMount {
+				t.Errorf("unix socket mounted = %v, want %v (mounts %v)", mounted, tt.wantMount, container.VolumeMounts)
+			}
+
+			// The exporter resolves the address the same way.
+			mc.Spec.Monitoring = tt.monitoring
+			if mc.Spec.Monitoring == nil {
+				mc.Spec.Monitoring = &memcachedv1beta1.MonitoringSpec{}
+			}
+			mc.Spec.Monitoring.Enabled = true
+			if exporter := buildExporterContainer(mc); exporter.Args[0] != "--memcached.address="+tt.wantAddress {
+				t.Errorf("exporter args[0] = %q, want --memcached.address=%s", exporter.Args[0], tt.wantAddress)
+			}
+		})
+	}
+}
+
+func TestConstructDeployment_PodSafetyDefaults(t *testing.T) {
+	mc := &memcachedv1beta1.Memcached{
+		ObjectMeta: metav1.ObjectMeta{Name: "safety", Namespace: "default"},
+	}
+	dep := &appsv1.Deployment{}
+
+	constructDeployment(mc, dep, "", "")
+
+	podSpec := dep.Spec.Template.Spec
+	if podSpec.HostNetwork {
+		t.Error("hostNetwork should be unset")
+	}
+	if podSpec.AutomountServiceAccountToken == nil || *podSpec.AutomountServiceAccountToken {
+		t.Errorf("automountServiceAccountToken = %v, want false", podSpec.AutomountServiceAccountToken)
+	}
+	if podSpec.RestartPolicy != corev1.RestartPolicyAlways {
+		t.Errorf("restartPolicy = %q, want Always", podSpec.RestartPolicy)
+	}
+
+	// Out-of-band edits to the Deployment are reverted on the next reconcile.
+	automount := true
+	dep.Spec.Template.Spec.HostNetwork = true
+	dep.Spec.Template.Spec.AutomountServiceAccountToken = &automount
+	dep.Spec.Template.Spec.RestartPolicy = corev1.RestartPolicyNever
+
+	constructDeployment(mc, dep, "", "")
+
+	if dep.Spec.Template.Spec.HostNetwork {
+		t.Error("hostNetwork should be reset to false")
+	}
+	if *dep.Spec.Template.Spec.AutomountServiceAccountToken {
+		t.Error("automountServiceAccountToken should be reset to false")
+	}
+	if dep.Spec.Template.Spec.RestartPolicy != corev1.RestartPolicyAlways {
+		t.Errorf("restartPolicy = %q, want it reset to Always", dep.Spec.Template.Spec.RestartPolicy)
+	}
+}
+
+func TestConstructDeployment_RevisionHistoryLimit(t *testing.T) {
+	tests := []struct {
+		name  string
+		limit *int32
+		want  int32
+	}{
+		{name: "unset uses the default", limit: nil, want: memcachedv1beta1.DefaultRevisionHistoryLimit},
+		{name: "custom limit", limit: int32Ptr(3), want: 3},
+		{name: "zero keeps no history", limit: int32Ptr(0), want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &memcachedv1beta1.Memcached{
+				ObjectMeta: metav1.ObjectMeta{Name: "history", Namespace: "default"},
+				Spec:       memcachedv1beta1.MemcachedSpec{RevisionHistoryLimit: tt.limit},
+			}
+			dep := &appsv1.Deployment{}
+
+			constructDeployment(mc, dep, "", "")
+
+			if dep.Spec.RevisionHistoryLimit == nil || *dep.Spec.RevisionHistoryLimit != tt.want {
+				t.Errorf("RevisionHistoryLimit = %v, want %d", dep.Spec.RevisionHistoryLimit, tt.want)
+			}
+		})
+	}
+}
+
+func TestConstructDeployment_ScrapeAnnotations(t *testing.T) {
+	tests := []struct {
+		name       string
+		monitoring *memcachedv1beta1.MonitoringSpec
+		want       map[string]string
+	}{
+		{name: "monitoring disabled", monitoring: nil, want: nil},
+		{
+			name:       "scrape annotations disabled",
+			monitoring: &memcachedv1beta1.MonitoringSpec{Enabled: true},
+			want:       nil,
+		},
+		{
+			name:       "scrape annotations ignored without monitoring",
+			monitoring: &memcachedv1beta1.MonitoringSpec{Enabled: false, ScrapeAnnotations: true},
+			want:       nil,
+		},
+		{
+			name:       "scrape annotations enabled",
+			monitoring: &memcachedv1beta1.MonitoringSpec{Enabled: true, ScrapeAnnotations: true},
+			want: map[string]string{
+				"prometheus.io/scrape": "true",
+				"prometheus.io/port":   "9150",
+				"prometheus.io/path":   "/metrics",
+			},
+		},
+		{
+			name: "exporter TLS adds the https scheme",
+			monitoring: &memcachedv1beta1.MonitoringSpec{
+				Enabled:           true,
+				ScrapeAnnotations: true,
+				ExporterTLS: &memcachedv1beta1.ExporterTLSSpec{
+					Enabled:              true,
+					CertificateSecretRef: corev1.LocalObjectReference{Name: "exporter-tls"},
+				},
+			},
+			want: map[string]string{
+				"prometheus.io/scrape": "true",
+				"prometheus.io/port":   "9150",
+				"prometheus.io/path":   "/metrics",
+				"prometheus.io/scheme": "https",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &memcachedv1beta1.Memcached{
+				ObjectMeta: metav1.ObjectMeta{Name: "scrape", Namespace: "default"},
+				Spec:       memcachedv1beta1.MemcachedSpec{Monitoring: tt.monitoring},
+			}
+			dep := &appsv1.Deployment{}
+
+			constructDeployment(mc, dep, "", "")
+
+			got := make(map[string]string)
+			for k, v := range dep.Spec.Template.Annotations {
+				if strings.HasPrefix(k, "prometheus.io/") {
+					got[k] = v
+				}
+			}
+			if len(tt.want) == 0 {
+				if len(got) != 0 {
+					t.Errorf("unexpected prometheus.io annotations: %v", got)
+				}
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("prometheus.io annotations = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConstructDeployment_OtelResourceAttributes(t *testing.T) {
+	tests := []struct {
+		name    string
+		enabled bool
+		image   *string
+		want    map[string]string
+	}{
+		{name: "disabled", enabled: false, want: nil},
+		{
+			name:    "enabled with default image",
+			enabled: true,
+			want: map[string]string{
+				"resource.opentelemetry.io/service.name":      "cache",
+				"resource.opentelemetry.io/service.namespace": "team-a",
+				"resource.opentelemetry.io/service.version":   "1.6",
+			},
+		},
+		{
+			name:    "version follows the image tag",
+			enabled: true,
+			image:   stringPtr("registry.example.com:5000/memcached:1.6.34"),
+			want: map[string]string{
+				"resource.opentelemetry.io/service.name":      "cache",
+				"resource.opentelemetry.io/service.namespace": "team-a",
+				"resource.opentelemetry.io/service.version":   "1.6.34",
+			},
+		},
+		{
+			name:    "untagged image omits the version",
+			enabled: true,
+			image:   stringPtr("memcached"),
+			want: map[string]string{
+				"resource.opentelemetry.io/service.name":      "cache",
+				"resource.opentelemetry.io/service.namespace": "team-a",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &memcachedv1beta1.Memcached{
+				ObjectMeta: metav1.ObjectMeta{Name: "cache", Namespace: "team-a"},
+				Spec: memcachedv1beta1.MemcachedSpec{
+					Image:                  tt.image,
+					OtelResourceAttributes: tt.enabled,
+				},
+			}
+			dep := &appsv1.Deployment{}
+
+			constructDeployment(mc, dep, "", "")
+
+			got := make(map[string]string)
+			for k, v := range dep.Spec.Template.Annotations {
+				if strings.HasPrefix(k, "resource.opentelemetry.io/") {
+					got[k] = v
+				}
+			}
+			if len(tt.want) == 0 {
+				if len(got) != 0 {
+					t.Errorf("unexpected resource.opentelemetry.io annotations: %v", got)
+				}
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("resource.opentelemetry.io annotations = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSelectorMatchesLabels(t *testing.T) {
+	managed := labelsForMemcached("cache")
+	tests := []struct {
+		name string
+		sel  *metav1.LabelSelector
+		want bool
+	}{
+		{name: "nil selector", sel: nil, want: false},
+		{name: "empty selector", sel: &metav1.LabelSelector{}, want: false},
+		{name: "managed selector", sel: &metav1.LabelSelector{MatchLabels: managed}, want: true},
+		{
+			name: "subset of managed labels",
+			sel:  &metav1.LabelSelector{MatchLabels: map[string]string{"app.kubernetes.io/instance": "cache"}},
+			want: true,
+		},
+		{
+			name: "foreign label",
+			sel:  &metav1.LabelSelector{MatchLabels: map[string]string{"app": "legacy"}},
+			want: false,
+		},
+		{
+			name: "matching expression",
+			sel: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{
+				Key: "app.kubernetes.io/name", Operator: metav1.LabelSelectorOpIn, Values: []string{"memcached"},
+			}}},
+			want: true,
+		},
+		{
+			name: "invalid operator",
+			sel: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{
+				Key: "app.kubernetes.io/name", Operator: "Bogus",
+			}}},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := selectorMatchesLabels(tt.sel, managed); got != tt.want {
+				t.Errorf("selectorMatchesLabels() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+// Package controller implements the reconciliation logic for the memcached-operator.
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	memcachedv1beta1 "github.com/c5c3/memcached-operator/api/v1beta1"
+)
+
+// AnnotationReadyEndpoints is the Service annotation key recording how many ready
+// endpoints the EndpointSlices of the Service currently publish.
+const AnnotationReadyEndpoints = "memcached.c5c3.io/ready-endpoints"
+
+// isEndpointReady reports whether ep is ready. An endpoint without a ready
+// condition is ready, as defined by the EndpointSlice API.
+func isEndpointReady(ep discoveryv1.Endpoint) bool {
+	return ep.Conditions.Ready == nil || *ep.Conditions.Ready
+}
+
+// countReadyEndpoints returns the number of distinct ready endpoints across slices.
+// Endpoints are keyed by their target Pod so that a dual-stack Pod, which appears
+// once per address family, is only counted once.
+func countReadyEndpoints(slices []discoveryv1.EndpointSlice) int {
+	ready := make(map[string]struct{})
+	for i := range slices {
+		for _, ep := range slices[i].Endpoints {
+			if !isEndpointReady(ep) {
+				continue
+			}
+			key := ""
+			switch {
+			case ep.TargetRef != nil:
+				key = ep.TargetRef.Namespace + "/" + ep.TargetRef.Name
+			case len(ep.Addresses) > 0:
+				key = ep.Addresses[0]
+			default:
+				continue
+			}
+			ready[key] = struct{}{}
+		}
+	}
+	return len(ready)
+}
+
+// readyEndpointAddresses returns the sorted, deduplicated addresses of the ready
+// endpoints across slices, or nil when there are none. Unlike countReadyEndpoints,
+// both addresses of a dual-stack Pod are returned.
+func readyEndpointAddresses(slices []discoveryv1.EndpointSlice) []string {
+	seen := make(map[string]struct{})
+	var addrs []string
+	for i := range slices {
+		for _, ep := range slices[i].Endpoints {
+			if !isEndpointReady(ep) {
+				continue
+			}
+			for _, addr := range ep.Addresses {
+				if _, ok := seen[addr]; ok {
+					continue
+				}
+				seen[addr] = struct{}{}
+				addrs = append(addrs, addr)
+			}
+		}
+	}
+	sort.Strings(addrs)
+	return addrs
+}
+
+// setReadyEndpointsAnnotation stamps the ready endpoint count onto svc.
+func setReadyEndpointsAnnotation(svc *corev1.Service, count int) {
+	if svc.Annotations == nil {
+		svc.Annotations = make(map[string]string)
+	}
+	svc.Annotations[AnnotationReadyEndpoints] = strconv.Itoa(count)
+}
+
+// listServiceEndpointSlices lists the EndpointSlices of the Service owned by mc.
+func (r *MemcachedReconciler) listServiceEndpointSlices(ctx context.Context, mc *memcachedv1beta1.Memcached) ([]discoveryv1.EndpointSlice, error) {
+	slices := &discoveryv1.EndpointSliceList{}
+	if err := r.List(ctx, slices,
+		client.InNamespace(mc.Namespace),
+		client.MatchingLabels{discoveryv1.LabelServiceName: mc.Name},
+	); err != nil {
+		return nil, fmt.Errorf("listing EndpointSlices: %w", err)
+	}
+	return slices.Items, nil
+}
+
+// isOperatorManaged reports whether obj carries the managed-by label the operator
+// sets on its resources. The EndpointSlice controller copies it from the Service.
+func isOperatorManaged(obj client.Object) bool {
+	return obj.GetLabels()[LabelManagedBy] == ManagedByOperator
+}
+
+// mapEndpointSliceToMemcached maps an EndpointSlice event to a reconcile.Request for
+// the Memcached CR named after the Service the slice belongs to.
+func mapEndpointSliceToMemcached() handler.MapFunc {
+	return func(_ context.Context, obj client.Object) []reconcile.Request {
+		svcName := obj.GetLabels()[discoveryv1.LabelServiceName]
+		if svcName == "" {
+			return nil
+		}
+		return []reconcile.Request{{
+			NamespacedName: types.NamespacedName{Name: svcName, Namespace: obj.GetNamespace()},
+		}}
+	}
+}
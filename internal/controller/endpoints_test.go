@@ -0,0 +1,167 @@
+// Package controller implements the reconciliation logic for the memcached-operator.
+package controller
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	memcachedv1beta1 "github.com/c5c3/memcached-operator/api/v1beta1"
+)
+
+func boolPtr(b bool) *bool { return &b }
+
+func endpoint(pod, address string, ready *bool) discoveryv1.Endpoint {
+	ep := discoveryv1.Endpoint{
+		Addresses:  []string{address},
+		Conditions: discoveryv1.EndpointConditions{Ready: ready},
+	}
+	if pod != "" {
+		ep.TargetRef = &corev1.ObjectReference{Kind: "Pod", Namespace: "default", Name: pod}
+	}
+	return ep
+}
+
+func TestCountReadyEndpoints(t *testing.T) {
+	tests := []struct {
+		name   string
+		slices []discoveryv1.EndpointSlice
+		want   int
+	}{
+		{name: "no slices", slices: nil, want: 0},
+		{
+			name: "skips not-ready endpoints",
+			slices: []discoveryv1.EndpointSlice{{Endpoints: []discoveryv1.Endpoint{
+				endpoint("cache-0", "10.0.0.1", boolPtr(true)),
+				endpoint("cache-1", "10.0.0.2", boolPtr(false)),
+				endpoint("cache-2", "10.0.0.3", nil),
+			}}},
+			want: 2,
+		},
+		{
+			name: "counts dual-stack pods once",
+			slices: []discoveryv1.EndpointSlice{
+				{AddressType: discoveryv1.AddressTypeIPv4, Endpoints: []discoveryv1.Endpoint{endpoint("cache-0", "10.0.0.1", boolPtr(true))}},
+				{AddressType: discoveryv1.AddressTypeIPv6, Endpoints: []discoveryv1.Endpoint{endpoint("cache-0", "fd00::1", boolPtr(true))}},
+			},
+			want: 1,
+		},
+		{
+			name: "falls back to the address without a target",
+			slices: []discoveryv1.EndpointSlice{{Endpoints: []discoveryv1.Endpoint{
+				endpoint("", "10.0.0.1", boolPtr(true)),
+				endpoint("", "10.0.0.2", boolPtr(true)),
+			}}},
+			want: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := countReadyEndpoints(tt.slices); got != tt.want {
+				t.Errorf("countReadyEndpoints() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadyEndpointAddresses(t *testing.T) {
+	tests := []struct {
+		name   string
+		slices []discoveryv1.EndpointSlice
+		want   []string
+	}{
+		{name: "no slices", slices: nil, want: nil},
+		{
+			name: "skips not-ready endpoints and sorts",
+			slices: []discoveryv1.EndpointSlice{{Endpoints: []discoveryv1.Endpoint{
+				endpoint("cache-0", "10.0.0.3", boolPtr(true)),
+				endpoint("cache-1", "10.0.0.2", boolPtr(false)),
+				endpoint("cache-2", "10.0.0.1", nil),
+			}}},
+			want: []string{"10.0.0.1", "10.0.0.3"},
+		},
+		{
+			name: "lists both addresses of dual-stack pods",
+			slices: []discoveryv1.EndpointSlice{
+				{AddressType: discoveryv1.AddressTypeIPv4, Endpoints: []discoveryv1.Endpoint{endpoint("cache-0", "10.0.0.1", boolPtr(true))}},
+				{AddressType: discoveryv1.AddressTypeIPv6, Endpoints: []discoveryv1.Endpoint{endpoint("cache-0", "fd00::1", boolPtr(true))}},
+			},
+			want: []string{"10.0.0.1", "fd00::1"},
+		},
+		{
+			name: "deduplicates addresses across slices",
+			slices: []discoveryv1.EndpointSlice{
+				{Endpoints: []discoveryv1.Endpoint{endpoint("cache-0", "10.0.0.1", boolPtr(true))}},
+				{Endpoints: []discoveryv1.Endpoint{endpoint("cache-0", "10.0.0.1", boolPtr(true))}},
+			},
+			want: []string{"10.0.0.1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := readyEndpointAddresses(tt.slices); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("readyEndpointAddresses() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMapEndpointSliceToMemcached(t *testing.T) {
+	slice := &discoveryv1.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cache-abcde",
+			Namespace: "prod",
+			Labels:    map[string]string{discoveryv1.LabelServiceName: "cache"},
+		},
+	}
+
+	reqs := mapEndpointSliceToMemcached()(context.Background(), slice)
+	if len(reqs) != 1 || reqs[0].Name != "cache" || reqs[0].Namespace != "prod" {
+		t.Errorf("requests = %v, want [prod/cache]", reqs)
+	}
+
+	slice.Labels = nil
+	if reqs := mapEndpointSliceToMemcached()(context.Background(), slice); reqs != nil {
+		t.Errorf("requests = %v, want none without the service-name label", reqs)
+	}
+}
+
+func TestReconcileService_ReadyEndpointsAnnotation(t *testing.T) {
+	mc := &memcachedv1beta1.Memcached{
+		ObjectMeta: metav1.ObjectMeta{Name: testInstanceName, Namespace: testDefaultNamespace, UID: "uid-1"},
+	}
+	slice := &discoveryv1.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      testInstanceName + "-abcde",
+			Namespace: testDefaultNamespace,
+			Labels:    map[string]string{discoveryv1.LabelServiceName: testInstanceName},
+		},
+		AddressType: discoveryv1.AddressTypeIPv4,
+		Endpoints: []discoveryv1.Endpoint{
+			endpoint("cache-0", "10.0.0.1", boolPtr(true)),
+			endpoint("cache-1", "10.0.0.2", boolPtr(true)),
+			endpoint("cache-2", "10.0.0.3", boolPtr(false)),
+		},
+	}
+	c := newFakeClient(mc, slice)
+	r := newTestReconciler(c)
+
+	if err := r.reconcileService(context.Background(), mc); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	svc := &corev1.Service{}
+	if err := c.Get(context.Background(), client.ObjectKey{Name: testInstanceName, Namespace: testDefaultNamespace}, svc); err != nil {
+		t.Fatalf("failed to get service: %v", err)
+	}
+	if got := svc.Annotations[AnnotationReadyEndpoints]; got != "2" {
+		t.Errorf("%s = %q, want %q", AnnotationReadyEndpoints, got, "2")
+	}
+}
@@ -0,0 +1,49 @@
+package controller
+
+import (
+	memcachedv1beta1 "github.com/c5c3/memcached-operator/api/v1beta1"
+)
+
+// Feature label values of the memcached_operator_feature_enabled gauge.
+const (
+	FeatureTLS           = "tls"
+	FeatureSASL          = "sasl"
+	FeatureMonitoring    = "monitoring"
+	FeatureHA            = "ha"
+	FeaturePDB           = "pdb"
+	FeatureAutoscaling   = "autoscaling"
+	FeatureNetworkPolicy = "networkpolicy"
+	FeatureCanary        = "canary"
+)
+
+// enabledFeatures returns the features enabled on mc, as recorded in the
+// memcached_operator_feature_enabled gauge. HA counts instances whose replicas
+// are spread by an anti-affinity preset or topology spread constraints.
+func enabledFeatures(mc *memcachedv1beta1.Memcached) []string {
+	var features []string
+	if mc.IsTLSEnabled() {
+		features = append(features, FeatureTLS)
+	}
+	if mc.IsSASLEnabled() {
+		features = append(features, FeatureSASL)
+	}
+	if mc.IsMonitoringEnabled() {
+		features = append(features, FeatureMonitoring)
+	}
+	if ha := mc.Spec.HighAvailability; ha != nil && (ha.AntiAffinityPreset != nil || len(ha.TopologySpreadConstraints) > 0) {
+		features = append(features, FeatureHA)
+	}
+	if mc.IsPDBEnabled() {
+		features = append(features, FeaturePDB)
+	}
+	if mc.IsAutoscalingEnabled() {
+		features = append(features, FeatureAutoscaling)
+	}
+	if mc.IsNetworkPolicyEnabled() {
+		features = append(features, FeatureNetworkPolicy)
+	}
+	if mc.IsCanaryEnabled() {
+		features = append(features, FeatureCanary)
+	}
+	return features
+}
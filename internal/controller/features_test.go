@@ -0,0 +1,134 @@
+package controller
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	memcachedv1beta1 "github.com/c5c3/memcached-operator/api/v1beta1"
+)
+
+// featureGaugeValue returns the memcached_operator_feature_enabled value for feature.
+func featureGaugeValue(t *testing.T, feature string) float64 {
+	t.Helper()
+	gatherer, ok := ctrlmetrics.Registry.(prometheus.Gatherer)
+	if !ok {
+		t.Fatal("controller-runtime registry does not implement prometheus.Gatherer")
+	}
+	families, err := gatherer.Gather()
+	if err != nil {
+		t.Fatalf("failed to gather metrics: %v", err)
+	}
+	for _, f := range families {
+		if f.GetName() != "memcached_operator_feature_enabled" {
+			continue
+		}
+		for _, m := range f.GetMetric() {
+			for _, l := range m.GetLabel() {
+				if l.GetName() == "feature" && l.GetValue() == feature {
+					return m.GetGauge().GetValue()
+				}
+			}
+		}
+	}
+	return 0
+}
+
+func TestEnabledFeatures(t *testing.T) {
+	tests := []struct {
+		name string
+		spec memcachedv1beta1.MemcachedSpec
+		want []string
+	}{
+		{name: "defaults"},
+		{
+			name: "TLS and monitoring",
+			spec: memcachedv1beta1.MemcachedSpec{
+				Security:   &memcachedv1beta1.SecuritySpec{TLS: &memcachedv1beta1.TLSSpec{Enabled: true}},
+				Monitoring: &memcachedv1beta1.MonitoringSpec{Enabled: true},
+			},
+			want: []string{FeatureTLS, FeatureMonitoring},
+		},
+		{
+			name: "HA with PDB",
+			spec: memcachedv1beta1.MemcachedSpec{HighAvailability: &memcachedv1beta1.HighAvailabilitySpec{
+				TopologySpreadConstraints: []corev1.TopologySpreadConstraint{{TopologyKey: corev1.LabelTopologyZone}},
+				PodDisruptionBudget:       &memcachedv1beta1.PDBSpec{Enabled: true},
+			}},
+			want: []string{FeatureHA, FeaturePDB},
+		},
+		{
+			name: "HA section without spreading",
+			spec: memcachedv1beta1.MemcachedSpec{HighAvailability: &memcachedv1beta1.HighAvailabilitySpec{}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := enabledFeatures(&memcachedv1beta1.Memcached{Spec: tt.spec})
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("enabledFeatures() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReconcile_RecordsFeatureGauges(t *testing.T) {
+	mc := &memcachedv1beta1.Memcached{
+		ObjectMeta: metav1.ObjectMeta{Name: "features", Namespace: testDefaultNamespace},
+		Spec: memcachedv1beta1.MemcachedSpec{
+			Security: &memcachedv1beta1.SecuritySpec{TLS: &memcachedv1beta1.TLSSpec{
+				Enabled:              true,
+				CertificateSecretRef: corev1.LocalObjectReference{Name: "features-tls"},
+			}},
+			Monitoring: &memcachedv1beta1.MonitoringSpec{Enabled: true},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(testSchemeWithMonitoring()).WithObjects(mc).
+		WithStatusSubresource(&memcachedv1beta1.Memcached{}).Build()
+	r := newTestReconcilerWithMonitoring(c)
+	req := ctrl.Request{NamespacedName: client.ObjectKeyFromObject(mc)}
+
+	tlsBefore := featureGaugeValue(t, FeatureTLS)
+	monitoringBefore := featureGaugeValue(t, FeatureMonitoring)
+
+	// Reconciling twice must not count the instance twice.
+	for range 2 {
+		if _, err := r.Reconcile(context.Background(), req); err != nil {
+			t.Fatalf("Reconcile: %v", err)
+		}
+	}
+	if got := featureGaugeValue(t, FeatureTLS); got != tlsBefore+1 {
+		t.Errorf("tls gauge = %v, want %v", got, tlsBefore+1)
+	}
+	if got := featureGaugeValue(t, FeatureMonitoring); got != monitoringBefore+1 {
+		t.Errorf("monitoring gauge = %v, want %v", got, monitoringBefore+1)
+	}
+
+	fetched := &memcachedv1beta1.Memcached{}
+	if err := c.Get(context.Background(), req.NamespacedName, fetched); err != nil {
+		t.Fatalf("get Memcached: %v", err)
+	}
+	fetched.Spec.Security = nil
+	fetched.Spec.Monitoring.Enabled = false
+	if err := c.Update(context.Background(), fetched); err != nil {
+		t.Fatalf("update Memcached: %v", err)
+	}
+	if _, err := r.Reconcile(context.Background(), req); err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+	if got := featureGaugeValue(t, FeatureTLS); got != tlsBefore {
+		t.Errorf("tls gauge after disabling = %v, want %v", got, tlsBefore)
+	}
+	if got := featureGaugeValue(t, FeatureMonitoring); got != monitoringBefore {
+		t.Errorf("monitoring gauge after disabling = %v, want %v", got, monitoringBefore)
+	}
+}
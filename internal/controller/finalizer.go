@@ -0,0 +1,199 @@
+package controller
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	memcachedv1beta1 "github.com/c5c3/memcached-operator/api/v1beta1"
+	"github.com/c5c3/memcached-operator/internal/metrics"
+)
+
+// FinalizerFinalStats is added to every Memcached CR so the operator can record
+// the cache's last counters in a FinalStats Event before the CR is deleted.
+const FinalizerFinalStats = "memcached.c5c3.io/final-stats"
+
+// finalStatsTimeout bounds the stats query made while finalizing, so an
+// unreachable pod never holds up deletion for long.
+const finalStatsTimeout = 2 * time.Second
+
+// ensureFinalizer adds FinalizerFinalStats to mc unless it is already present or
+// mc is being deleted, in which case the API server would reject the addition.
+// The finalizer is written with a metadata-only merge patch so the write never
+// carries the spec.
+func (r *MemcachedReconciler) ensureFinalizer(ctx context.Context, mc *memcachedv1beta1.Memcached) error {
+	if !mc.DeletionTimestamp.IsZero() || controllerutil.ContainsFinalizer(mc, FinalizerFinalStats) {
+		return nil
+	}
+	patch := client.MergeFrom(mc.DeepCopy())
+	controllerutil.AddFinalizer(mc, FinalizerFinalStats)
+	if err := r.Patch(ctx, mc, patch); err != nil {
+		return fmt.Errorf("adding finalizer: %w", err)
+	}
+	return nil
+}
+
+// finalize records the FinalStats Event on a Memcached CR marked for deletion,
+// deletes its Pushgateway groups, reports status.phase Terminating, then removes
+// FinalizerFinalStats so deletion can complete. Recording the stats and deleting
+// the groups are best effort and never block the finalizer removal. The phase stays visible while finalizers of other controllers remain.
+func (r *MemcachedReconciler) finalize(ctx context.Context, mc *memcachedv1beta1.Memcached) error {
+	r.recordFinalStats(ctx, mc)
+	r.prunePushGatewayGroups(ctx, mc)
+
+	if mc.Status.Phase != PhaseTerminating {
+		mc.Status.Phase = PhaseTerminating
+		if err := r.Status().Update(ctx, mc); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("updating Memcached status: %w", err)
+		}
+	}
+
+	patch := client.MergeFrom(mc.DeepCopy())
+	controllerutil.RemoveFinalizer(mc, FinalizerFinalStats)
+	if err := r.Patch(ctx, mc, patch); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("removing finalizer: %w", err)
+	}
+
+	metrics.ResetInstanceMetrics(mc.Name, mc.Namespace)
+	r.appliedGenerations.Delete(client.ObjectKeyFromObject(mc))
+	return nil
+}
+
+// recordFinalStats queries the plaintext stats of a ready memcached pod and
+// emits a Normal FinalStats Event summarizing its key counters. It logs and
+// returns without an Event when the stats cannot be read.
+func (r *MemcachedReconciler) recordFinalStats(ctx context.Context, mc *memcachedv1beta1.Memcached) {
+	if r.Recorder == nil {
+		return
+	}
+	logger := log.FromContext(ctx)
+
+	if reason := finalStatsUnavailable(mc); reason != "" {
+		logger.Info("Skipping final stats", "reason", reason)
+		return
+	}
+
+	pods, err := r.listMemcachedPods(ctx, mc)
+	if err != nil {
+		logger.Info("Skipping final stats", "reason", err.Error())
+		return
+	}
+	pod := firstReadyPod(pods)
+	if pod == nil {
+		logger.Info("Skipping final stats", "reason", "no ready pod")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, finalStatsTimeout)
+	defer cancel()
+	stats, err := r.queryStats(ctx, net.JoinHostPort(pod.Status.PodIP, strconv.Itoa(int(PortMemcached))))
+	if err != nil {
+		logger.Info("Skipping final stats", "pod", pod.Name, "reason", err.Error())
+		return
+	}
+
+	r.Recorder.Eventf(mc, nil, corev1.EventTypeNormal, "FinalStats", "Finalize", "%s", summarizeStats(pod.Name, stats))
+}
+
+// finalStatsUnavailable returns why the plaintext text-protocol stats query
+// cannot reach mc, or an empty string if it can.
+func finalStatsUnavailable(mc *memcachedv1beta1.Memcached) string {
+	switch {
+	case mc.Spec.Security != nil && mc.Spec.Security.SASL != nil && mc.Spec.Security.SASL.Enabled:
+		return "SASL requires the binary protocol"
+	case mc.Spec.Security != nil && mc.Spec.Security.TLS != nil && mc.Spec.Security.TLS.Enabled:
+		return "TLS is enabled"
+	case mc.IsUnixSocketEnabled():
+		return "memcached listens on a unix socket only"
+	case mc.IsPlaintextLoopbackOnly():
+		return "memcached listens on loopback only"
+	}
+	return ""
+}
+
+// firstReadyPod returns the first pod that is ready, not terminating and has an
+// IP, or nil if there is none.
+func firstReadyPod(pods []corev1.Pod) *corev1.Pod {
+	for i := range pods {
+		pod := &pods[i]
+		if pod.DeletionTimestamp != nil || pod.Status.PodIP == "" {
+			continue
+		}
+		for _, c := range pod.Status.Conditions {
+			if c.Type == corev1.PodReady && c.Status == corev1.ConditionTrue {
+				return pod
+			}
+		}
+	}
+	return nil
+}
+
+// queryStats sends the text-protocol "stats" command to address and returns the
+// reported values keyed by stat name.
+func (r *MemcachedReconciler) queryStats(ctx context.Context, address string) (map[string]string, error) {
+	dial := r.StatsDialer
+	if dial == nil {
+		dial = (&net.Dialer{}).DialContext
+	}
+	conn, err := dial(ctx, "tcp", address)
+	if err != nil {
+		return nil, fmt.Errorf("connecting to %s: %w", address, err)
+	}
+	defer func() { _ = conn.Close() }()
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	if _, err := conn.Write([]byte("stats\r\n")); err != nil {
+		return nil, fmt.Errorf("sending stats: %w", err)
+	}
+
+	stats := make(map[string]string)
+	scanner := bufio.NewScanner(conn)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "END" {
+			return stats, nil
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 3 || fields[0] != "STAT" {
+			return nil, fmt.Errorf("unexpected stats response %q", line)
+		}
+		stats[fields[1]] = fields[2]
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading stats: %w", err)
+	}
+	return nil, fmt.Errorf("stats response ended without END")
+}
+
+// summarizeStats formats the key counters of a stats response for the
+// FinalStats Event. Missing counters are reported as "n/a".
+func summarizeStats(podName string, stats map[string]string) string {
+	value := func(key string) string {
+		if v, ok := stats[key]; ok {
+			return v
+		}
+		return "n/a"
+	}
+
+	hitRate := "n/a"
+	hits, errHits := strconv.ParseUint(stats["get_hits"], 10, 64)
+	misses, errMisses := strconv.ParseUint(stats["get_misses"], 10, 64)
+	if errHits == nil && errMisses == nil && hits+misses > 0 {
+		hitRate = fmt.Sprintf("%.1f%%", float64(hits)*100/float64(hits+misses))
+	}
+
+	return fmt.Sprintf("Final stats from pod %s: hitRate=%s getHits=%s getMisses=%s evictions=%s currItems=%s bytes=%s uptime=%ss",
+		podName, hitRate, value("get_hits"), value("get_misses"), value("evictions"),
+		value("curr_items"), value("bytes"), value("uptime"))
+}
@@ -0,0 +1,276 @@
+package controller
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	memcachedv1beta1 "github.com/c5c3/memcached-operator/api/v1beta1"
+)
+
+// fakeStatsDialer returns a StatsDialer whose connection answers a "stats"
+// command with the given response.
+func fakeStatsDialer(response string) func(context.Context, string, string) (net.Conn, error) {
+	return func(context.Context, string, string) (net.Conn, error) {
+		clientConn, serverConn := net.Pipe()
+		go func() {
+			defer func() { _ = serverConn.Close() }()
+			line, err := bufio.NewReader(serverConn).ReadString('\n')
+			if err != nil || line != "stats\r\n" {
+				return
+			}
+			_, _ = serverConn.Write([]byte(response))
+		}()
+		return clientConn, nil
+	}
+}
+
+func readyMemcachedPod(name, ip string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testDefaultNamespace, Labels: labelsForMemcached(testInstanceName)},
+		Status: corev1.PodStatus{
+			PodIP:      ip,
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+		},
+	}
+}
+
+func TestReconcile_AddsFinalizer(t *testing.T) {
+	mc := &memcachedv1beta1.Memcached{ObjectMeta: metav1.ObjectMeta{Name: testInstanceName, Namespace: testDefaultNamespace}}
+	c := fake.NewClientBuilder().WithScheme(testSchemeWithMonitoring()).WithObjects(mc).
+		WithStatusSubresource(&memcachedv1beta1.Memcached{}).Build()
+	r := newTestReconcilerWithMonitoring(c)
+
+	if _, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(mc)}); err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+
+	fetched := &memcachedv1beta1.Memcached{}
+	if err := c.Get(context.Background(), client.ObjectKeyFromObject(mc), fetched); err != nil {
+		t.Fatalf("get Memcached: %v", err)
+	}
+	if !controllerutil.ContainsFinalizer(fetched, FinalizerFinalStats) {
+		t.Errorf("finalizers = %v, want %q", fetched.Finalizers, FinalizerFinalStats)
+	}
+}
+
+func TestReconcile_FinalizesDeletedMemcached(t *testing.T) {
+	tests := []struct {
+		name      string
+		pods      []client.Object
+		labels    map[string]string
+		wantEvent string
+	}{
+		{name: "no pods"},
+		{name: "no ready pod", pods: []client.Object{&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "mc-0", Namespace: testDefaultNamespace, Labels: labelsForMemcached(testInstanceName)},
+		}}},
+		{
+			name:      "ready pod",
+			pods:      []client.Object{readyMemcachedPod("mc-0", "10.0.0.1")},
+			wantEvent: "Normal FinalStats Final stats from pod mc-0: hitRate=75.0%",
+		},
+		{
+			name:      "ignored CR",
+			pods:      []client.Object{readyMemcachedPod("mc-0", "10.0.0.1")},
+			labels:    map[string]string{LabelIgnore: "true"},
+			wantEvent: "Normal FinalStats Final stats from pod mc-0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := metav1.Now()
+			mc := &memcachedv1beta1.Memcached{ObjectMeta: metav1.ObjectMeta{
+				Name:              testInstanceName,
+				Namespace:         testDefaultNamespace,
+				Labels:            tt.labels,
+				Finalizers:        []string{FinalizerFinalStats},
+				DeletionTimestamp: &now,
+			}}
+			c := fake.NewClientBuilder().WithScheme(testScheme()).WithObjects(append(tt.pods, mc)...).Build()
+			recorder := events.NewFakeRecorder(10)
+			r := newTestReconcilerWithRecorder(c, recorder)
+			r.StatsDialer = fakeStatsDialer("STAT get_hits 30\r\nSTAT get_misses 10\r\nSTAT evictions 2\r\nEND\r\n")
+
+			if _, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(mc)}); err != nil {
+				t.Fatalf("Reconcile: %v", err)
+			}
+
+			err := c.Get(context.Background(), client.ObjectKeyFromObject(mc), &memcachedv1beta1.Memcached{})
+			if !apierrors.IsNotFound(err) {
+				t.Errorf("expected the Memcached to be deleted once the finalizer is removed, got err=%v", err)
+			}
+
+			select {
+			case event := <-recorder.Events:
+				if tt.wantEvent == "" || !strings.HasPrefix(event, tt.wantEvent) {
+					t.Errorf("event = %q, want prefix %q", event, tt.wantEvent)
+				}
+			default:
+				if tt.wantEvent != "" {
+					t.Errorf("expected event %q, got none", tt.wantEvent)
+				}
+			}
+		})
+	}
+}
+
+func TestReconcile_DeletedMemcachedReportsTerminating(t *testing.T) {
+	now := metav1.Now()
+	mc := &memcachedv1beta1.Memcached{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      testInstanceName,
+			Namespace: testDefaultNamespace,
+			// A second finalizer keeps the CR around after the operator's is removed.
+			Finalizers:        []string{FinalizerFinalStats, "example.com/other"},
+			DeletionTimestamp: &now,
+		},
+		Status: memcachedv1beta1.MemcachedStatus{Phase: PhaseRunning},
+	}
+	c := fake.NewClientBuilder().WithScheme(testScheme()).WithObjects(mc).
+		WithStatusSubresource(&memcachedv1beta1.Memcached{}).Build()
+	r := newTestReconcilerWithRecorder(c, events.NewFakeRecorder(10))
+
+	if _, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(mc)}); err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+
+	fetched := &memcachedv1beta1.Memcached{}
+	if err := c.Get(context.Background(), client.ObjectKeyFromObject(mc), fetched); err != nil {
+		t.Fatalf("get Memcached: %v", err)
+	}
+	if fetched.Status.Phase != PhaseTerminating {
+		t.Errorf("status.phase = %q, want %q", fetched.Status.Phase, PhaseTerminating)
+	}
+	if controllerutil.ContainsFinalizer(fetched, FinalizerFinalStats) {
+		t.Errorf("finalizers = %v, want %q removed", fetched.Finalizers, FinalizerFinalStats)
+	}
+}
+
+func TestRecordFinalStats_DialFailure(t *testing.T) {
+	mc := &memcachedv1beta1.Memcached{ObjectMeta: metav1.ObjectMeta{Name: testInstanceName, Namespace: testDefaultNamespace}}
+	recorder := events.NewFakeRecorder(10)
+	r := newTestReconcilerWithRecorder(newFakeClient(mc, readyMemcachedPod("mc-0", "10.0.0.1")), recorder)
+	r.StatsDialer = func(context.Context, string, string) (net.Conn, error) {
+		return nil, errors.New("connection refused")
+	}
+
+	r.recordFinalStats(context.Background(), mc)
+
+	select {
+	case event := <-recorder.Events:
+		t.Errorf("unexpected event %q", event)
+	default:
+	}
+}
+
+func TestFinalStatsUnavailable(t *testing.T) {
+	tests := []struct {
+		name string
+		spec memcachedv1beta1.MemcachedSpec
+		want bool
+	}{
+		{name: "plain", want: false},
+		{
+			name: "SASL",
+			spec: memcachedv1beta1.MemcachedSpec{Security: &memcachedv1beta1.SecuritySpec{SASL: &memcachedv1beta1.SASLSpec{Enabled: true}}},
+			want: true,
+		},
+		{
+			name: "TLS",
+			spec: memcachedv1beta1.MemcachedSpec{Security: &memcachedv1beta1.SecuritySpec{TLS: &memcachedv1beta1.TLSSpec{Enabled: true}}},
+			want: true,
+		},
+		{
+			name: "unix socket",
+			spec: memcachedv1beta1.MemcachedSpec{Memcached: &memcachedv1beta1.MemcachedConfig{UnixSocket: &memcachedv1beta1.UnixSocketSpec{Enabled: true}}},
+			want: true,
+		},
+		{
+			name: "loopback only",
+			spec: memcachedv1beta1.MemcachedSpec{Memcached: &memcachedv1beta1.MemcachedConfig{ListenAddresses: []string{"127.0.0.1"}}},
+			want: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &memcachedv1beta1.Memcached{Spec: tt.spec}
+			if got := finalStatsUnavailable(mc) != ""; got != tt.want {
+				t.Errorf("finalStatsUnavailable() = %q, want unavailable=%v", finalStatsUnavailable(mc), tt.want)
+			}
+		})
+	}
+}
+
+func TestQueryStats(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		want     map[string]string
+		wantErr  bool
+	}{
+		{
+			name:     "stats",
+			response: "STAT pid 1\r\nSTAT curr_items 42\r\nEND\r\n",
+			want:     map[string]string{"pid": "1", "curr_items": "42"},
+		},
+		{name: "error reply", response: "ERROR\r\n", wantErr: true},
+		{name: "truncated", response: "STAT pid 1\r\n", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newTestReconciler(newFakeClient())
+			r.StatsDialer = fakeStatsDialer(tt.response)
+
+			got, err := r.queryStats(context.Background(), "10.0.0.1:11211")
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("expected an error, got %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("queryStats: %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+			for k, v := range tt.want {
+				if got[k] != v {
+					t.Errorf("stat %s = %q, want %q", k, got[k], v)
+				}
+			}
+		})
+	}
+}
+
+func TestSummarizeStats(t *testing.T) {
+	got := summarizeStats("mc-0", map[string]string{
+		"get_hits": "90", "get_misses": "10", "evictions": "3",
+		"curr_items": "100", "bytes": "2048", "uptime": "3600",
+	})
+	want := "Final stats from pod mc-0: hitRate=90.0% getHits=90 getMisses=10 evictions=3 currItems=100 bytes=2048 uptime=3600s"
+	if got != want {
+		t.Errorf("summarizeStats() = %q, want %q", got, want)
+	}
+
+	got = summarizeStats("mc-0", map[string]string{})
+	if !strings.Contains(got, "hitRate=n/a") || !strings.Contains(got, "evictions=n/a") {
+		t.Errorf("summarizeStats() = %q, want n/a for missing counters", got)
+	}
+}
@@ -12,7 +12,8 @@ import (
 //
 // Precondition: mc.Spec.Autoscaling must not be nil (callers must guard with hpaEnabled).
 func constructHPA(mc *memcachedv1beta1.Memcached, hpa *autoscalingv2.HorizontalPodAutoscaler) {
-	hpa.Labels = labelsForMemcached(mc.Name)
+	hpa.Labels = withPropagatedLabels(mc, labelsForMemcached(mc.Name))
+	hpa.Annotations = mergePropagatedAnnotations(mc, hpa.Annotations)
 
 	hpa.Spec.ScaleTargetRef = autoscalingv2.CrossVersionObjectReference{
 		APIVersion: "apps/v1",
@@ -0,0 +1,33 @@
+package controller
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// LabelIgnore excludes a Memcached CR from reconciliation while set to "true",
+// e.g. to hand an instance over to manual control during incident response.
+// Unlike a spec change it needs no new generation, and removing the label
+// resumes reconciliation on the resulting update event.
+const LabelIgnore = "memcached.c5c3.io/ignore"
+
+// isIgnored reports whether obj carries LabelIgnore=true.
+func isIgnored(obj client.Object) bool {
+	return obj.GetLabels()[LabelIgnore] == "true"
+}
+
+// ignoredPredicate filters out events for Memcached CRs labeled with
+// LabelIgnore=true. Events from owned resources still enqueue their owner, so
+// reconcile checks isIgnored again after fetching the CR. Events of CRs marked
+// for deletion always pass, so the finalizer of an ignored CR is still removed.
+func ignoredPredicate() predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		if isIgnored(obj) && obj.GetDeletionTimestamp().IsZero() {
+			log.Log.WithName("memcached").Info("Skipping Memcached event; resource carries the ignore label",
+				"name", obj.GetName(), "namespace", obj.GetNamespace(), "label", LabelIgnore)
+			return false
+		}
+		return true
+	})
+}
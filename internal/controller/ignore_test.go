@@ -0,0 +1,105 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	memcachedv1beta1 "github.com/c5c3/memcached-operator/api/v1beta1"
+)
+
+func TestIgnoredPredicate(t *testing.T) {
+	tests := []struct {
+		name   string
+		labels map[string]string
+		want   bool
+	}{
+		{name: "no labels", want: true},
+		{name: "ignore label true", labels: map[string]string{LabelIgnore: "true"}, want: false},
+		{name: "ignore label false", labels: map[string]string{LabelIgnore: "false"}, want: true},
+		{name: "other labels", labels: map[string]string{"team": "cache"}, want: true},
+	}
+
+	p := ignoredPredicate()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &memcachedv1beta1.Memcached{ObjectMeta: metav1.ObjectMeta{Name: "cache", Namespace: "default", Labels: tt.labels}}
+			if got := p.Create(event.CreateEvent{Object: mc}); got != tt.want {
+				t.Errorf("Create = %v, want %v", got, tt.want)
+			}
+			if got := p.Update(event.UpdateEvent{ObjectOld: mc, ObjectNew: mc}); got != tt.want {
+				t.Errorf("Update = %v, want %v", got, tt.want)
+			}
+			if got := p.Delete(event.DeleteEvent{Object: mc}); got != tt.want {
+				t.Errorf("Delete = %v, want %v", got, tt.want)
+			}
+			if got := p.Generic(event.GenericEvent{Object: mc}); got != tt.want {
+				t.Errorf("Generic = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIgnoredPredicate_LabelRemovalResumes(t *testing.T) {
+	old := &memcachedv1beta1.Memcached{ObjectMeta: metav1.ObjectMeta{Name: "cache", Labels: map[string]string{LabelIgnore: "true"}}}
+	updated := &memcachedv1beta1.Memcached{ObjectMeta: metav1.ObjectMeta{Name: "cache"}}
+
+	if !ignoredPredicate().Update(event.UpdateEvent{ObjectOld: old, ObjectNew: updated}) {
+		t.Error("expected the update removing the ignore label to pass the predicate")
+	}
+}
+
+func TestIgnoredPredicate_DeletionPasses(t *testing.T) {
+	now := metav1.Now()
+	mc := &memcachedv1beta1.Memcached{ObjectMeta: metav1.ObjectMeta{
+		Name:              "cache",
+		Labels:            map[string]string{LabelIgnore: "true"},
+		Finalizers:        []string{FinalizerFinalStats},
+		DeletionTimestamp: &now,
+	}}
+
+	if !ignoredPredicate().Update(event.UpdateEvent{ObjectOld: mc, ObjectNew: mc}) {
+		t.Error("expected the update of an ignored CR marked for deletion to pass the predicate")
+	}
+}
+
+func TestReconcile_SkipsIgnoredMemcached(t *testing.T) {
+	tests := []struct {
+		name           string
+		labels         map[string]string
+		wantDeployment bool
+	}{
+		{name: "labeled CR is not reconciled", labels: map[string]string{LabelIgnore: "true"}},
+		{name: "unlabeled CR is reconciled", wantDeployment: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &memcachedv1beta1.Memcached{
+				ObjectMeta: metav1.ObjectMeta{Name: testInstanceName, Namespace: testDefaultNamespace, Labels: tt.labels},
+			}
+			c := fake.NewClientBuilder().WithScheme(testSchemeWithMonitoring()).WithObjects(mc).
+				WithStatusSubresource(&memcachedv1beta1.Memcached{}).Build()
+			r := newTestReconcilerWithMonitoring(c)
+
+			if _, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(mc)}); err != nil {
+				t.Fatalf("Reconcile: %v", err)
+			}
+
+			err := c.Get(context.Background(), client.ObjectKeyFromObject(mc), &appsv1.Deployment{})
+			if tt.wantDeployment && err != nil {
+				t.Errorf("expected a Deployment, got err=%v", err)
+			}
+			if !tt.wantDeployment && !apierrors.IsNotFound(err) {
+				t.Errorf("expected no Deployment, got err=%v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+// Package controller implements the reconciliation logic for the memcached-operator.
+package controller
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	memcachedv1beta1 "github.com/c5c3/memcached-operator/api/v1beta1"
+)
+
+// AnnotationReadOnly is set to "true" on the Service and Deployment while
+// spec.maintenance.readOnly is enabled, so clients and service meshes can stop
+// sending writes. memcached itself has no read-only mode.
+const AnnotationReadOnly = "memcached.c5c3.io/read-only"
+
+// applyReadOnlyAnnotation sets AnnotationReadOnly in annotations when mc is
+// read-only and removes it otherwise. Other keys are left untouched.
+func applyReadOnlyAnnotation(mc *memcachedv1beta1.Memcached, annotations map[string]string) map[string]string {
+	if !mc.IsReadOnly() {
+		delete(annotations, AnnotationReadOnly)
+		if len(annotations) == 0 {
+			return nil
+		}
+		return annotations
+	}
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[AnnotationReadOnly] = "true"
+	return annotations
+}
+
+// maintenanceCondition returns the Maintenance condition for mc, or nil when
+// spec.maintenance is not set and the condition should be absent.
+func maintenanceCondition(mc *memcachedv1beta1.Memcached) *metav1.Condition {
+	if mc.Spec.Maintenance == nil {
+		return nil
+	}
+	c := &metav1.Condition{
+		Type:               ConditionTypeMaintenance,
+		Status:             metav1.ConditionFalse,
+		Reason:             ConditionReasonReadWrite,
+		Message:            "Maintenance mode is off; the instance accepts writes",
+		ObservedGeneration: mc.Generation,
+	}
+	if mc.IsReadOnly() {
+		c.Status = metav1.ConditionTrue
+		c.Reason = ConditionReasonReadOnly
+		c.Message = "Maintenance mode is on; clients should not write to the instance"
+	}
+	return c
+}
@@ -0,0 +1,117 @@
+// Package controller implements the reconciliation logic for the memcached-operator.
+package controller
+
+import (
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	memcachedv1beta1 "github.com/c5c3/memcached-operator/api/v1beta1"
+)
+
+func newMaintenanceMemcached(maintenance *memcachedv1beta1.MaintenanceSpec) *memcachedv1beta1.Memcached {
+	return &memcachedv1beta1.Memcached{
+		ObjectMeta: metav1.ObjectMeta{Name: "cache", Namespace: "default", Generation: 3},
+		Spec: memcachedv1beta1.MemcachedSpec{
+			Replicas:    int32Ptr(3),
+			Maintenance: maintenance,
+		},
+	}
+}
+
+func TestMaintenanceCondition(t *testing.T) {
+	tests := []struct {
+		name        string
+		maintenance *memcachedv1beta1.MaintenanceSpec
+		wantNil     bool
+		wantStatus  metav1.ConditionStatus
+		wantReason  string
+	}{
+		{name: "maintenance unset", wantNil: true},
+		{
+			name:        "read-only",
+			maintenance: &memcachedv1beta1.MaintenanceSpec{ReadOnly: true},
+			wantStatus:  metav1.ConditionTrue,
+			wantReason:  ConditionReasonReadOnly,
+		},
+		{
+			name:        "read-write",
+			maintenance: &memcachedv1beta1.MaintenanceSpec{},
+			wantStatus:  metav1.ConditionFalse,
+			wantReason:  ConditionReasonReadWrite,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := maintenanceCondition(newMaintenanceMemcached(tt.maintenance))
+			if tt.wantNil {
+				if c != nil {
+					t.Errorf("condition = %+v, want nil", c)
+				}
+				return
+			}
+			if c == nil {
+				t.Fatal("expected condition, got nil")
+			}
+			if c.Type != ConditionTypeMaintenance || c.Status != tt.wantStatus || c.Reason != tt.wantReason {
+				t.Errorf("condition = %s/%s/%s, want %s/%s/%s",
+					c.Type, c.Status, c.Reason, ConditionTypeMaintenance, tt.wantStatus, tt.wantReason)
+			}
+			if c.ObservedGeneration != 3 {
+				t.Errorf("observedGeneration = %d, want 3", c.ObservedGeneration)
+			}
+		})
+	}
+}
+
+func TestApplyReadOnlyAnnotation(t *testing.T) {
+	readOnly := newMaintenanceMemcached(&memcachedv1beta1.MaintenanceSpec{ReadOnly: true})
+	got := applyReadOnlyAnnotation(readOnly, map[string]string{"example.com/other": "x"})
+	if got[AnnotationReadOnly] != "true" || got["example.com/other"] != "x" {
+		t.Errorf("annotations = %v, want read-only added and other keys kept", got)
+	}
+
+	readWrite := newMaintenanceMemcached(nil)
+	if got := applyReadOnlyAnnotation(readWrite, map[string]string{AnnotationReadOnly: "true"}); got != nil {
+		t.Errorf("annotations = %v, want nil after removing the only key", got)
+	}
+}
+
+func TestConstructors_ReadOnlyMode(t *testing.T) {
+	mc := newMaintenanceMemcached(&memcachedv1beta1.MaintenanceSpec{ReadOnly: true, Replicas: int32Ptr(1)})
+
+	dep := &appsv1.Deployment{}
+	constructDeployment(mc, dep, "", "")
+	svc := &corev1.Service{}
+	constructService(mc, svc)
+
+	if dep.Annotations[AnnotationReadOnly] != "true" {
+		t.Errorf("deployment annotations = %v, want %s=true", dep.Annotations, AnnotationReadOnly)
+	}
+	if svc.Annotations[AnnotationReadOnly] != "true" {
+		t.Errorf("service annotations = %v, want %s=true", svc.Annotations, AnnotationReadOnly)
+	}
+	if _, ok := dep.Spec.Template.Annotations[AnnotationReadOnly]; ok {
+		t.Error("read-only annotation must not be added to the Pod template")
+	}
+	if dep.Spec.Replicas == nil || *dep.Spec.Replicas != 1 {
+		t.Errorf("replicas = %v, want maintenance replicas 1", dep.Spec.Replicas)
+	}
+
+	mc.Spec.Maintenance.ReadOnly = false
+	constructDeployment(mc, dep, "", "")
+	constructService(mc, svc)
+
+	if _, ok := dep.Annotations[AnnotationReadOnly]; ok {
+		t.Error("read-only annotation should be removed from the Deployment")
+	}
+	if _, ok := svc.Annotations[AnnotationReadOnly]; ok {
+		t.Error("read-only annotation should be removed from the Service")
+	}
+	if *dep.Spec.Replicas != 3 {
+		t.Errorf("replicas = %d, want spec.replicas 3", *dep.Spec.Replicas)
+	}
+}
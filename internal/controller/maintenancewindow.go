@@ -0,0 +1,162 @@
+package controller
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	memcachedv1beta1 "github.com/c5c3/memcached-operator/api/v1beta1"
+	"github.com/c5c3/memcached-operator/internal/cron"
+)
+
+// AnnotationTemplateHash is the Deployment annotation recording the hash of the
+// Pod template the operator last built, while spec.maintenanceWindow is set. It
+// detects Pod template changes without comparing against the server-defaulted
+// template.
+const AnnotationTemplateHash = "memcached.c5c3.io/template-hash"
+
+// AnnotationRolloutDeferred is set to "true" on the Deployment while a Pod
+// template change is staged on the paused Deployment outside the maintenance
+// window.
+const AnnotationRolloutDeferred = "memcached.c5c3.io/rollout-deferred"
+
+// maintenanceWindowState reports whether the maintenance window of mc is open at
+// now, together with the time of the next transition: the end of the current
+// window when open, or the start of the next one when closed. Without a
+// maintenance window, or with a schedule that cannot be evaluated, the window is
+// always open and next is zero.
+func maintenanceWindowState(mc *memcachedv1beta1.Memcached, now time.Time) (open bool, next time.Time) {
+	mw := mc.Spec.MaintenanceWindow
+	if mw == nil {
+		return true, time.Time{}
+	}
+	schedule, err := cron.Parse(mw.Schedule)
+	if err != nil {
+		return true, time.Time{}
+	}
+
+	now = now.UTC()
+	duration := time.Duration(mw.DurationMinutes) * time.Minute
+	// The first window start after now-duration is the start of the open window
+	// if it lies at or before now, and the start of the next window otherwise.
+	start := schedule.Next(now.Add(-duration))
+	if start.IsZero() {
+		return true, time.Time{}
+	}
+	if !start.After(now) {
+		return true, start.Add(duration)
+	}
+	return false, start
+}
+
+// deploymentSnapshot holds the fields of an existing Deployment that the
+// maintenance window compares against, captured before constructDeployment
+// overwrites them.
+type deploymentSnapshot struct {
+	exists       bool
+	templateHash string
+	deferred     bool
+	replicas     *int32
+}
+
+// snapshotDeployment captures the maintenance window state of dep.
+func snapshotDeployment(dep *appsv1.Deployment) deploymentSnapshot {
+	snap := deploymentSnapshot{
+		exists:       dep.ResourceVersion != "",
+		templateHash: dep.Annotations[AnnotationTemplateHash],
+		deferred:     dep.Annotations[AnnotationRolloutDeferred] == "true",
+	}
+	if dep.Spec.Replicas != nil {
+		replicas := *dep.Spec.Replicas
+		snap.replicas = &replicas
+	}
+	return snap
+}
+
+// computeTemplateHash returns a SHA-256 hex digest of the Pod template, or an
+// empty string if it cannot be serialized.
+func computeTemplateHash(template *corev1.PodTemplateSpec) string {
+	data, err := json.Marshal(template)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// applyMaintenanceWindow defers the rollout of Pod template changes on the
+// desired Deployment dep while the maintenance window is closed: the new template
+// is staged and the Deployment is paused until the window opens. A change is
+// detected by comparing the template hash with the one recorded on the existing
+// Deployment; a Deployment without a recorded hash, such as one created before
+// the window was configured, is not deferred. With deferReplicaChanges set, the
+// existing replica count is kept as well. New Deployments are never deferred.
+func applyMaintenanceWindow(mc *memcachedv1beta1.Memcached, dep *appsv1.Deployment, prev deploymentSnapshot, open bool) {
+	mw := mc.Spec.MaintenanceWindow
+	if mw == nil {
+		delete(dep.Annotations, AnnotationTemplateHash)
+		delete(dep.Annotations, AnnotationRolloutDeferred)
+		return
+	}
+
+	hash := computeTemplateHash(&dep.Spec.Template)
+	if dep.Annotations == nil {
+		dep.Annotations = make(map[string]string)
+	}
+	dep.Annotations[AnnotationTemplateHash] = hash
+
+	if open || !prev.exists {
+		delete(dep.Annotations, AnnotationRolloutDeferred)
+		return
+	}
+
+	if mw.DeferReplicaChanges && prev.replicas != nil && dep.Spec.Replicas != nil {
+		dep.Spec.Replicas = prev.replicas
+	}
+
+	changed := prev.templateHash != "" && prev.templateHash != hash
+	if !changed && !prev.deferred {
+		return
+	}
+	dep.Spec.Paused = true
+	dep.Annotations[AnnotationRolloutDeferred] = "true"
+}
+
+// rolloutDeferredCondition returns the RolloutDeferred condition for mc, or nil
+// when no rollout or replica change is being held back by the maintenance window
+// and the condition should be absent. next is the start of the next window.
+func rolloutDeferredCondition(mc *memcachedv1beta1.Memcached, dep *appsv1.Deployment, next time.Time) *metav1.Condition {
+	mw := mc.Spec.MaintenanceWindow
+	if mw == nil || dep == nil {
+		return nil
+	}
+
+	var held string
+	switch {
+	case dep.Annotations[AnnotationRolloutDeferred] == "true":
+		held = "Pod template changes are staged on the paused Deployment"
+	case mw.DeferReplicaChanges && !mc.IsAutoscalingEnabled() &&
+		dep.Spec.Replicas != nil && *dep.Spec.Replicas != mc.DesiredReplicas():
+		held = fmt.Sprintf("Replica change to %d is held", mc.DesiredReplicas())
+	default:
+		return nil
+	}
+
+	until := "the next maintenance window"
+	if !next.IsZero() {
+		until += " at " + next.UTC().Format(time.RFC3339)
+	}
+	return &metav1.Condition{
+		Type:               ConditionTypeRolloutDeferred,
+		Status:             metav1.ConditionTrue,
+		Reason:             ConditionReasonOutsideMaintenanceWindow,
+		Message:            held + " until " + until,
+		ObservedGeneration: mc.Generation,
+	}
+}
@@ -0,0 +1,246 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	memcachedv1beta1 "github.com/c5c3/memcached-operator/api/v1beta1"
+)
+
+// Daily window 02:00-03:00 UTC used across the maintenance window tests.
+var (
+	testWindowOpen   = time.Date(2026, time.March, 10, 2, 30, 0, 0, time.UTC)
+	testWindowClosed = time.Date(2026, time.March, 10, 12, 0, 0, 0, time.UTC)
+)
+
+func newWindowMemcached(deferReplicas bool) *memcachedv1beta1.Memcached {
+	return &memcachedv1beta1.Memcached{
+		ObjectMeta: metav1.ObjectMeta{Name: testInstanceName, Namespace: testDefaultNamespace, UID: "uid-1", Generation: 1},
+		Spec: memcachedv1beta1.MemcachedSpec{
+			Replicas: int32Ptr(2),
+			Image:    stringPtr("memcached:1.6.29"),
+			MaintenanceWindow: &memcachedv1beta1.MaintenanceWindowSpec{
+				Schedule:            "0 2 * * *",
+				DurationMinutes:     60,
+				DeferReplicaChanges: deferReplicas,
+			},
+		},
+	}
+}
+
+func TestMaintenanceWindowState(t *testing.T) {
+	tests := []struct {
+		name     string
+		now      time.Time
+		wantOpen bool
+		wantNext time.Time
+	}{
+		{name: "at window start", now: time.Date(2026, 3, 10, 2, 0, 0, 0, time.UTC), wantOpen: true, wantNext: time.Date(2026, 3, 10, 3, 0, 0, 0, time.UTC)},
+		{name: "inside window", now: testWindowOpen, wantOpen: true, wantNext: time.Date(2026, 3, 10, 3, 0, 0, 0, time.UTC)},
+		{name: "at window end", now: time.Date(2026, 3, 10, 3, 0, 0, 0, time.UTC), wantOpen: false, wantNext: time.Date(2026, 3, 11, 2, 0, 0, 0, time.UTC)},
+		{name: "after window", now: testWindowClosed, wantOpen: false, wantNext: time.Date(2026, 3, 11, 2, 0, 0, 0, time.UTC)},
+		{name: "before window", now: time.Date(2026, 3, 10, 1, 59, 0, 0, time.UTC), wantOpen: false, wantNext: time.Date(2026, 3, 10, 2, 0, 0, 0, time.UTC)},
+		{name: "non-UTC clock", now: time.Date(2026, 3, 10, 3, 30, 0, 0, time.FixedZone("CET", 3600)), wantOpen: true, wantNext: time.Date(2026, 3, 10, 3, 0, 0, 0, time.UTC)},
+	}
+
+	mc := newWindowMemcached(false)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			open, next := maintenanceWindowState(mc, tt.now)
+			if open != tt.wantOpen {
+				t.Errorf("open = %v, want %v", open, tt.wantOpen)
+			}
+			if !next.Equal(tt.wantNext) {
+				t.Errorf("next = %v, want %v", next, tt.wantNext)
+			}
+		})
+	}
+}
+
+func TestMaintenanceWindowState_NoWindow(t *testing.T) {
+	mc := newWindowMemcached(false)
+	mc.Spec.MaintenanceWindow = nil
+	if open, next := maintenanceWindowState(mc, testWindowClosed); !open || !next.IsZero() {
+		t.Errorf("got open=%v next=%v, want always open", open, next)
+	}
+}
+
+// reconcileDeploymentAt runs reconcileDeployment with the reconciler clock fixed at
+// now and returns the resulting Deployment.
+func reconcileDeploymentAt(t *testing.T, r *MemcachedReconciler, mc *memcachedv1beta1.Memcached, now time.Time) *appsv1.Deployment {
+	t.Helper()
+	r.Clock = clocktesting.NewFakePassiveClock(now)
+	if _, err := r.reconcileDeployment(context.Background(), mc); err != nil {
+		t.Fatalf("reconcileDeployment: %v", err)
+	}
+	dep := &appsv1.Deployment{}
+	if err := r.Get(context.Background(), client.ObjectKeyFromObject(mc), dep); err != nil {
+		t.Fatalf("failed to get deployment: %v", err)
+	}
+	return dep
+}
+
+func TestReconcileDeployment_MaintenanceWindow_DefersOutsideWindow(t *testing.T) {
+	mc := newWindowMemcached(false)
+	r := newTestReconciler(newFakeClient(mc))
+
+	// A new Deployment is created right away, even outside the window.
+	dep := reconcileDeploymentAt(t, r, mc, testWindowClosed)
+	if dep.Spec.Paused {
+		t.Fatal("new Deployment should not be paused")
+	}
+	if dep.Annotations[AnnotationTemplateHash] == "" {
+		t.Error("expected the template hash annotation to be recorded")
+	}
+
+	// An image change outside the window is staged on the paused Deployment.
+	mc.Spec.Image = stringPtr("memcached:1.6.38")
+	dep = reconcileDeploymentAt(t, r, mc, testWindowClosed)
+	if !dep.Spec.Paused {
+		t.Error("expected the Deployment to be paused outside the window")
+	}
+	if dep.Annotations[AnnotationRolloutDeferred] != "true" {
+		t.Errorf("%s = %q, want true", AnnotationRolloutDeferred, dep.Annotations[AnnotationRolloutDeferred])
+	}
+	if got := dep.Spec.Template.Spec.Containers[0].Image; got != "memcached:1.6.38" {
+		t.Errorf("staged image = %q, want memcached:1.6.38", got)
+	}
+	if c := rolloutDeferredCondition(mc, dep, time.Date(2026, 3, 11, 2, 0, 0, 0, time.UTC)); c == nil {
+		t.Error("expected a RolloutDeferred condition")
+	} else if c.Reason != ConditionReasonOutsideMaintenanceWindow {
+		t.Errorf("reason = %q, want %q", c.Reason, ConditionReasonOutsideMaintenanceWindow)
+	}
+
+	// The Deployment stays paused on later reconciles outside the window.
+	dep = reconcileDeploymentAt(t, r, mc, testWindowClosed.Add(time.Hour))
+	if !dep.Spec.Paused {
+		t.Error("expected the Deployment to stay paused until the window opens")
+	}
+
+	// Replica changes still apply immediately.
+	mc.Spec.Replicas = int32Ptr(4)
+	dep = reconcileDeploymentAt(t, r, mc, testWindowClosed.Add(time.Hour))
+	if dep.Spec.Replicas == nil || *dep.Spec.Replicas != 4 {
+		t.Errorf("replicas = %v, want 4", dep.Spec.Replicas)
+	}
+
+	// The staged change rolls out once the window opens.
+	dep = reconcileDeploymentAt(t, r, mc, testWindowOpen.AddDate(0, 0, 1))
+	if dep.Spec.Paused {
+		t.Error("expected the Deployment to be resumed inside the window")
+	}
+	if _, ok := dep.Annotations[AnnotationRolloutDeferred]; ok {
+		t.Errorf("expected %s to be removed inside the window", AnnotationRolloutDeferred)
+	}
+	if c := rolloutDeferredCondition(mc, dep, time.Time{}); c != nil {
+		t.Errorf("expected no RolloutDeferred condition, got %v", c)
+	}
+}
+
+func TestReconcileDeployment_MaintenanceWindow_AppliesInsideWindow(t *testing.T) {
+	mc := newWindowMemcached(false)
+	r := newTestReconciler(newFakeClient(mc))
+	reconcileDeploymentAt(t, r, mc, testWindowOpen)
+
+	mc.Spec.Image = stringPtr("memcached:1.6.38")
+	dep := reconcileDeploymentAt(t, r, mc, testWindowOpen.Add(10*time.Minute))
+	if dep.Spec.Paused {
+		t.Error("expected the change to roll out inside the window")
+	}
+	if got := dep.Spec.Template.Spec.Containers[0].Image; got != "memcached:1.6.38" {
+		t.Errorf("image = %q, want memcached:1.6.38", got)
+	}
+}
+
+func TestReconcileDeployment_MaintenanceWindow_UnchangedNotPaused(t *testing.T) {
+	mc := newWindowMemcached(false)
+	r := newTestReconciler(newFakeClient(mc))
+	reconcileDeploymentAt(t, r, mc, testWindowOpen)
+
+	dep := reconcileDeploymentAt(t, r, mc, testWindowClosed)
+	if dep.Spec.Paused {
+		t.Error("expected an unchanged Deployment not to be paused outside the window")
+	}
+}
+
+func TestReconcileDeployment_MaintenanceWindow_DeferReplicaChanges(t *testing.T) {
+	mc := newWindowMemcached(true)
+	r := newTestReconciler(newFakeClient(mc))
+	reconcileDeploymentAt(t, r, mc, testWindowOpen)
+
+	mc.Spec.Replicas = int32Ptr(5)
+	dep := reconcileDeploymentAt(t, r, mc, testWindowClosed)
+	if dep.Spec.Replicas == nil || *dep.Spec.Replicas != 2 {
+		t.Errorf("replicas = %v, want the held count 2", dep.Spec.Replicas)
+	}
+	if dep.Spec.Paused {
+		t.Error("a held replica change alone should not pause the Deployment")
+	}
+	if c := rolloutDeferredCondition(mc, dep, time.Time{}); c == nil {
+		t.Error("expected a RolloutDeferred condition for the held replica change")
+	}
+
+	dep = reconcileDeploymentAt(t, r, mc, testWindowOpen.AddDate(0, 0, 1))
+	if dep.Spec.Replicas == nil || *dep.Spec.Replicas != 5 {
+		t.Errorf("replicas = %v, want 5 inside the window", dep.Spec.Replicas)
+	}
+}
+
+func TestReconcileDeployment_MaintenanceWindow_RemovedClearsAnnotations(t *testing.T) {
+	mc := newWindowMemcached(false)
+	r := newTestReconciler(newFakeClient(mc))
+	reconcileDeploymentAt(t, r, mc, testWindowOpen)
+	mc.Spec.Image = stringPtr("memcached:1.6.38")
+	reconcileDeploymentAt(t, r, mc, testWindowClosed)
+
+	mc.Spec.MaintenanceWindow = nil
+	dep := reconcileDeploymentAt(t, r, mc, testWindowClosed)
+	if dep.Spec.Paused {
+		t.Error("expected the Deployment to be resumed once the window is removed")
+	}
+	for _, key := range []string{AnnotationTemplateHash, AnnotationRolloutDeferred} {
+		if _, ok := dep.Annotations[key]; ok {
+			t.Errorf("expected %s to be removed", key)
+		}
+	}
+}
+
+func TestReconcile_MaintenanceWindowRequeue(t *testing.T) {
+	tests := []struct {
+		name      string
+		now       time.Time
+		wantAfter time.Duration
+	}{
+		{name: "closed requeues at the next window start", now: testWindowClosed, wantAfter: 14 * time.Hour},
+		{name: "open does not requeue", now: testWindowOpen, wantAfter: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := newWindowMemcached(false)
+			c := fake.NewClientBuilder().
+				WithScheme(testSchemeWithMonitoring()).
+				WithObjects(mc).
+				WithStatusSubresource(&memcachedv1beta1.Memcached{}).
+				Build()
+			r := newTestReconcilerWithMonitoring(c)
+			r.Clock = clocktesting.NewFakePassiveClock(tt.now)
+
+			result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(mc)})
+			if err != nil {
+				t.Fatalf("Reconcile: %v", err)
+			}
+			if result.RequeueAfter != tt.wantAfter {
+				t.Errorf("RequeueAfter = %s, want %s", result.RequeueAfter, tt.wantAfter)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package controller_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	memcachedv1beta1 "github.com/c5c3/memcached-operator/api/v1beta1"
+	"github.com/c5c3/memcached-operator/internal/controller"
+)
+
+var _ = Describe("Adopted Deployment reconciliation", func() {
+
+	// createPreexistingDeployment creates an unowned Deployment named after mc
+	// whose selector and pod template carry only the given labels.
+	createPreexistingDeployment := func(mc *memcachedv1beta1.Memcached, podLabels map[string]string) {
+		dep := &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: mc.Name, Namespace: mc.Namespace},
+			Spec: appsv1.DeploymentSpec{
+				Selector: &metav1.LabelSelector{MatchLabels: podLabels},
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: podLabels},
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{Name: "memcached", Image: "memcached:1.6"}},
+					},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, dep)).To(Succeed())
+	}
+
+	It("should add the standard labels and keep a compatible selector", func() {
+		mc := validMemcached(uniqueName("adopt-compatible"))
+		Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+		selector := map[string]string{"app.kubernetes.io/instance": mc.Name}
+		createPreexistingDeployment(mc, selector)
+
+		_, err := reconcileOnce(mc)
+		Expect(err).NotTo(HaveOccurred())
+
+		dep := fetchDeployment(mc)
+		Expect(dep.Spec.Selector.MatchLabels).To(Equal(selector))
+		Expect(metav1.IsControlledBy(dep, mc)).To(BeTrue())
+		for _, obj := range []map[string]string{dep.Labels, dep.Spec.Template.Labels} {
+			Expect(obj).To(HaveKeyWithValue("app.kubernetes.io/name", "memcached"))
+			Expect(obj).To(HaveKeyWithValue("app.kubernetes.io/instance", mc.Name))
+			Expect(obj).To(HaveKeyWithValue("app.kubernetes.io/managed-by", "memcached-operator"))
+		}
+
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), mc)).To(Succeed())
+		cond := findCondition(mc.Status.Conditions, controller.ConditionTypeDegraded)
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Reason).NotTo(Equal(controller.ConditionReasonSelectorConflict))
+	})
+
+	It("should leave a Deployment with a conflicting selector untouched and report SelectorImmutableConflict", func() {
+		mc := validMemcached(uniqueName("adopt-conflict"))
+		Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+		legacy := map[string]string{"app": "legacy-memcached"}
+		createPreexistingDeployment(mc, legacy)
+		before := fetchDeployment(mc)
+
+		_, err := reconcileOnce(mc)
+		Expect(err).NotTo(HaveOccurred())
+
+		dep := fetchDeployment(mc)
+		Expect(dep.ResourceVersion).To(Equal(before.ResourceVersion))
+		Expect(dep.Spec.Selector.MatchLabels).To(Equal(legacy))
+		Expect(dep.OwnerReferences).To(BeEmpty())
+
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), mc)).To(Succeed())
+		cond := findCondition(mc.Status.Conditions, controller.ConditionTypeDegraded)
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+		Expect(cond.Reason).To(Equal(controller.ConditionReasonSelectorConflict))
+		Expect(cond.Message).To(ContainSubstring("app=legacy-memcached"))
+		Expect(mc.Status.Phase).To(Equal(controller.PhaseDegraded))
+	})
+})
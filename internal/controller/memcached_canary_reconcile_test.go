@@ -0,0 +1,99 @@
+package controller_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	memcachedv1beta1 "github.com/c5c3/memcached-operator/api/v1beta1"
+)
+
+// fetchCanaryDeployment retrieves the canary Deployment of the Memcached CR.
+func fetchCanaryDeployment(mc *memcachedv1beta1.Memcached) *appsv1.Deployment {
+	dep := &appsv1.Deployment{}
+	key := types.NamespacedName{Name: mc.Name + "-canary", Namespace: mc.Namespace}
+	ExpectWithOffset(1, k8sClient.Get(ctx, key, dep)).To(Succeed())
+	return dep
+}
+
+var _ = Describe("Canary Deployment Reconciliation", func() {
+
+	Context("canary enabled", func() {
+		var mc *memcachedv1beta1.Memcached
+
+		BeforeEach(func() {
+			mc = validMemcached(uniqueName("canary"))
+			mc.Spec.Replicas = int32Ptr(3)
+			mc.Spec.Image = strPtr("memcached:1.6.29")
+			mc.Spec.Canary = &memcachedv1beta1.CanarySpec{
+				Enabled:  true,
+				Image:    strPtr("memcached:1.6.38"),
+				Replicas: int32Ptr(1),
+			}
+			Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+			_, err := reconcileOnce(mc)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should create the canary Deployment with the canary image and replicas", func() {
+			canary := fetchCanaryDeployment(mc)
+			Expect(canary.Spec.Replicas).NotTo(BeNil())
+			Expect(*canary.Spec.Replicas).To(Equal(int32(1)))
+			Expect(canary.Spec.Template.Spec.Containers[0].Image).To(Equal("memcached:1.6.38"))
+			Expect(canary.Spec.Template.Labels).To(HaveKeyWithValue("memcached.c5c3.io/track", "canary"))
+			Expect(canary.Spec.Selector.MatchLabels).To(HaveKeyWithValue("memcached.c5c3.io/track", "canary"))
+			Expect(canary.OwnerReferences).To(HaveLen(1))
+			Expect(canary.OwnerReferences[0].Name).To(Equal(mc.Name))
+		})
+
+		It("should leave the regular Deployment on the regular image and replicas", func() {
+			dep := fetchDeployment(mc)
+			Expect(*dep.Spec.Replicas).To(Equal(int32(3)))
+			Expect(dep.Spec.Template.Spec.Containers[0].Image).To(Equal("memcached:1.6.29"))
+			Expect(dep.Spec.Template.Labels).NotTo(HaveKey("memcached.c5c3.io/track"))
+		})
+
+		It("should select the regular and canary pods from the shared Service", func() {
+			svc := fetchService(mc)
+			selector := labels.SelectorFromSet(svc.Spec.Selector)
+			Expect(selector.Matches(labels.Set(fetchDeployment(mc).Spec.Template.Labels))).To(BeTrue())
+			Expect(selector.Matches(labels.Set(fetchCanaryDeployment(mc).Spec.Template.Labels))).To(BeTrue())
+		})
+
+		It("should delete the canary Deployment when the canary is disabled", func() {
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), mc)).To(Succeed())
+			mc.Spec.Canary.Enabled = false
+			Expect(k8sClient.Update(ctx, mc)).To(Succeed())
+
+			_, err := reconcileOnce(mc)
+			Expect(err).NotTo(HaveOccurred())
+
+			canary := &appsv1.Deployment{}
+			key := types.NamespacedName{Name: mc.Name + "-canary", Namespace: mc.Namespace}
+			err = k8sClient.Get(ctx, key, canary)
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+			fetchDeployment(mc)
+		})
+	})
+
+	Context("canary not configured", func() {
+		It("should not create a canary Deployment", func() {
+			mc := validMemcached(uniqueName("canary-none"))
+			Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+			_, err := reconcileOnce(mc)
+			Expect(err).NotTo(HaveOccurred())
+
+			canary := &appsv1.Deployment{}
+			key := types.NamespacedName{Name: mc.Name + "-canary", Namespace: mc.Namespace}
+			err = k8sClient.Get(ctx, key, canary)
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,109 @@
+package controller_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	memcachedv1beta1 "github.com/c5c3/memcached-operator/api/v1beta1"
+	"github.com/c5c3/memcached-operator/internal/controller"
+)
+
+var _ = Describe("Generated TLS certificate", func() {
+
+	certificateGVK := schema.GroupVersionKind{Group: "cert-manager.io", Version: "v1", Kind: "Certificate"}
+
+	fetchCertificate := func(mc *memcachedv1beta1.Memcached) (*unstructured.Unstructured, error) {
+		cert := &unstructured.Unstructured{}
+		cert.SetGroupVersionKind(certificateGVK)
+		err := k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), cert)
+		return cert, err
+	}
+
+	createWithGeneratedCertificate := func(name string) *memcachedv1beta1.Memcached {
+		mc := validMemcached(uniqueName(name))
+		mc.Spec.Security = &memcachedv1beta1.SecuritySpec{
+			TLS: &memcachedv1beta1.TLSSpec{
+				Enabled:              true,
+				CertificateSecretRef: corev1.LocalObjectReference{Name: mc.Name + "-tls"},
+				GenerateCertificate: &memcachedv1beta1.GenerateCertificateSpec{
+					IssuerRef: memcachedv1beta1.CertificateIssuerRef{Name: "selfsigned", Kind: "ClusterIssuer"},
+					DNSNames:  []string{mc.Name + ".default.svc"},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+		_, err := reconcileOnce(mc)
+		Expect(err).NotTo(HaveOccurred())
+		return mc
+	}
+
+	It("should create an owned Certificate issuing the TLS Secret", func() {
+		mc := createWithGeneratedCertificate("cert-create")
+
+		cert, err := fetchCertificate(mc)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cert.GetOwnerReferences()).To(HaveLen(1))
+		Expect(cert.GetOwnerReferences()[0].Name).To(Equal(mc.Name))
+
+		secretName, _, _ := unstructured.NestedString(cert.Object, "spec", "secretName")
+		Expect(secretName).To(Equal(mc.Name + "-tls"))
+		issuerKind, _, _ := unstructured.NestedString(cert.Object, "spec", "issuerRef", "kind")
+		Expect(issuerKind).To(Equal("ClusterIssuer"))
+	})
+
+	It("should default the DNS names to the Service names when none are set", func() {
+		mc := validMemcached(uniqueName("cert-default-dns"))
+		mc.Spec.Security = &memcachedv1beta1.SecuritySpec{
+			TLS: &memcachedv1beta1.TLSSpec{
+				Enabled:              true,
+				CertificateSecretRef: corev1.LocalObjectReference{Name: mc.Name + "-tls"},
+				GenerateCertificate: &memcachedv1beta1.GenerateCertificateSpec{
+					IssuerRef: memcachedv1beta1.CertificateIssuerRef{Name: "selfsigned", Kind: "ClusterIssuer"},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+		_, err := reconcileOnce(mc)
+		Expect(err).NotTo(HaveOccurred())
+
+		cert, err := fetchCertificate(mc)
+		Expect(err).NotTo(HaveOccurred())
+		dnsNames, _, _ := unstructured.NestedStringSlice(cert.Object, "spec", "dnsNames")
+		Expect(dnsNames).To(ContainElements(
+			mc.Name+".default.svc",
+			"*."+mc.Name+".default.svc.cluster.local",
+		))
+	})
+
+	It("should report CertificateNotReady until the Secret is issued", func() {
+		mc := createWithGeneratedCertificate("cert-pending")
+
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), mc)).To(Succeed())
+		cond := findCondition(mc.Status.Conditions, controller.ConditionTypeDegraded)
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Reason).To(Equal(controller.ConditionReasonCertificateNotReady))
+		Expect(mc.Status.Phase).To(Equal(controller.PhasePending))
+	})
+
+	It("should delete the Certificate when generation is disabled", func() {
+		mc := createWithGeneratedCertificate("cert-disable")
+
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), mc)).To(Succeed())
+		mc.Spec.Security.TLS.GenerateCertificate = nil
+		Expect(k8sClient.Update(ctx, mc)).To(Succeed())
+
+		_, err := reconcileOnce(mc)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = fetchCertificate(mc)
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+})
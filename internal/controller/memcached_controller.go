@@ -3,21 +3,33 @@ package controller
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
 	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	policyv1 "k8s.io/api/policy/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/clock"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 
@@ -30,22 +42,85 @@ type MemcachedReconciler struct {
 	client.Client
 	Scheme   *runtime.Scheme
 	Recorder events.EventRecorder
+
+	// NamespaceSelector, when non-nil, restricts reconciliation to Memcached
+	// resources in namespaces whose labels match the selector.
+	NamespaceSelector labels.Selector
+
+	// ReconcileTimeout bounds each Reconcile call with a context deadline.
+	// Zero disables the deadline.
+	ReconcileTimeout time.Duration
+
+	// PruneUnmanagedAnnotations removes annotations from owned resources unless
+	// they are operator-managed or match a prefix in AnnotationAllowlist.
+	PruneUnmanagedAnnotations bool
+
+	// AnnotationAllowlist lists annotation key prefixes preserved when
+	// PruneUnmanagedAnnotations is enabled.
+	AnnotationAllowlist []string
+
+	// TLSCopySourceNamespaces lists the namespaces spec.security.tls.copyFromNamespace
+	// may copy the TLS Secret from. Empty disables copying.
+	TLSCopySourceNamespaces []string
+
+	// Clock is the source of the current time for status timestamps and
+	// time-based features such as the maintenance window. When nil, the real
+	// clock is used; tests inject a fake clock.
+	Clock clock.PassiveClock
+
+	// StatsDialer opens the connection used to query memcached stats while
+	// finalizing a deleted Memcached CR. When nil, a net.Dialer is used; tests
+	// inject an in-memory connection.
+	StatsDialer func(ctx context.Context, network, address string) (net.Conn, error)
+
+	// PushGatewayClient sends the requests that delete stale Pushgateway groups.
+	// When nil, http.DefaultClient is used; tests inject a client for an
+	// httptest server.
+	PushGatewayClient *http.Client
+
+	// appliedGenerations maps a Memcached NamespacedName to the Deployment
+	// generation observed after the operator last wrote it. It lets the reconcile
+	// fast-path detect out-of-band edits to the Deployment.
+	appliedGenerations sync.Map
 }
 
 // +kubebuilder:rbac:groups=memcached.c5c3.io,resources=memcacheds,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=memcached.c5c3.io,resources=memcacheds/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=memcached.c5c3.io,resources=memcacheds/finalizers,verbs=update
 // +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=networking.k8s.io,resources=networkpolicies,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=monitoring.coreos.com,resources=servicemonitors,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=autoscaling,resources=horizontalpodautoscalers,verbs=get;list;watch;create;update;patch;delete
-// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups=cert-manager.io,resources=certificates,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch;patch
+// +kubebuilder:rbac:groups=discovery.k8s.io,resources=endpointslices,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 // +kubebuilder:rbac:groups=events.k8s.io,resources=events,verbs=create;patch
 
-// Reconcile handles a reconciliation request for a Memcached resource.
+// Reconcile handles a reconciliation request for a Memcached resource. When
+// ReconcileTimeout is set, the request is bounded by that deadline and an error
+// is returned on expiry so the request is requeued with backoff.
 func (r *MemcachedReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	if r.ReconcileTimeout <= 0 {
+		return r.reconcile(ctx, req)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, r.ReconcileTimeout)
+	defer cancel()
+
+	result, err := r.reconcile(ctx, req)
+	if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return ctrl.Result{}, fmt.Errorf("reconcile timed out after %s: %w", r.ReconcileTimeout, err)
+	}
+	return result, err
+}
+
+// reconcile performs a single reconciliation pass for a Memcached resource.
+func (r *MemcachedReconciler) reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	logger := log.FromContext(ctx)
 
 	memcached := &memcachedv1beta1.Memcached{}
@@ -53,22 +128,50 @@ func (r *MemcachedReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 		if apierrors.IsNotFound(err) {
 			logger.Info("Memcached resource not found; ignoring since it must have been deleted")
 			metrics.ResetInstanceMetrics(req.Name, req.Namespace)
+			r.appliedGenerations.Delete(req.NamespacedName)
 			return ctrl.Result{}, nil
 		}
 		logger.Error(err, "Failed to get Memcached resource")
 		return ctrl.Result{}, err
 	}
 
+	// Finalize before the namespace and ignore checks so a CR in a namespace that
+	// no longer matches the selector, or an ignored CR, can still be deleted.
+	if !memcached.DeletionTimestamp.IsZero() && controllerutil.ContainsFinalizer(memcached, FinalizerFinalStats) {
+		logger.Info("Finalizing Memcached")
+		return ctrl.Result{}, r.finalize(ctx, memcached)
+	}
+
+	selected, err := r.namespaceSelected(ctx, req.Namespace)
+	if err != nil {
+		logger.Error(err, "Failed to get Namespace", "namespace", req.Namespace)
+		return ctrl.Result{}, err
+	}
+	if !selected {
+		logger.V(1).Info("Namespace does not match the namespace label selector; skipping", "namespace", req.Namespace)
+		return ctrl.Result{}, nil
+	}
+
+	if isIgnored(memcached) {
+		logger.Info("Memcached carries the ignore label; skipping", "label", LabelIgnore)
+		return ctrl.Result{}, nil
+	}
+
+	if err := r.ensureFinalizer(ctx, memcached); err != nil {
+		logger.Error(err, "Failed to add finalizer")
+		return ctrl.Result{}, err
+	}
+
 	logger.Info("Reconciling Memcached", "name", memcached.Name, "namespace", memcached.Namespace)
 
-	reconcileStart := time.Now()
+	reconcileStart := r.now()
 	var reconcileErr error
 	defer func() {
 		result := "success"
 		if reconcileErr != nil {
 			result = "error"
 		}
-		metrics.RecordReconciliation(memcached.Name, memcached.Namespace, result, time.Since(reconcileStart))
+		metrics.RecordReconciliation(memcached.Name, memcached.Namespace, result, r.now().Sub(reconcileStart))
 	}()
 
 	// Record instance info gauge with current spec values.
@@ -76,11 +179,12 @@ func (r *MemcachedReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 	if memcached.Spec.Image != nil {
 		image = *memcached.Spec.Image
 	}
-	desiredReplicas := memcachedv1beta1.DefaultReplicas
-	if memcached.Spec.Replicas != nil {
-		desiredReplicas = *memcached.Spec.Replicas
+	metrics.RecordInstanceInfo(memcached.Name, memcached.Namespace, image, memcached.DesiredReplicas())
+	metrics.RecordInstanceFeatures(memcached.Name, memcached.Namespace, enabledFeatures(memcached))
+
+	if reconcileErr = r.reconcileCertificate(ctx, memcached); reconcileErr != nil {
+		return ctrl.Result{}, reconcileErr
 	}
-	metrics.RecordInstanceInfo(memcached.Name, memcached.Namespace, image, desiredReplicas)
 
 	var missingSecrets []string
 	missingSecrets, reconcileErr = r.reconcileDeployment(ctx, memcached)
@@ -88,6 +192,14 @@ func (r *MemcachedReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 		return ctrl.Result{}, reconcileErr
 	}
 
+	if reconcileErr = r.reconcileCanaryDeployment(ctx, memcached); reconcileErr != nil {
+		return ctrl.Result{}, reconcileErr
+	}
+
+	if reconcileErr = r.reconcilePodDeletionCost(ctx, memcached); reconcileErr != nil {
+		return ctrl.Result{}, reconcileErr
+	}
+
 	if reconcileErr = r.reconcileHPA(ctx, memcached); reconcileErr != nil {
 		return ctrl.Result{}, reconcileErr
 	}
@@ -108,23 +220,81 @@ func (r *MemcachedReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 		return ctrl.Result{}, reconcileErr
 	}
 
+	r.prunePushGatewayGroups(ctx, memcached)
+
 	if reconcileErr = r.reconcileStatus(ctx, memcached, missingSecrets); reconcileErr != nil {
 		return ctrl.Result{}, reconcileErr
 	}
 
 	metrics.RecordReadyReplicas(memcached.Name, memcached.Namespace, memcached.Status.ReadyReplicas)
 
+	// Requeue when the maintenance window opens, to roll out staged changes.
+	now := r.now()
+	if open, next := maintenanceWindowState(memcached, now); !open && !next.IsZero() {
+		return ctrl.Result{RequeueAfter: next.Sub(now)}, nil
+	}
+
 	return ctrl.Result{}, nil
 }
 
+// now returns the current time from r.Clock, or from the real clock when unset.
+func (r *MemcachedReconciler) now() time.Time {
+	if r.Clock == nil {
+		return clock.RealClock{}.Now()
+	}
+	return r.Clock.Now()
+}
+
+// reconcileCertificate ensures the cert-manager Certificate issuing the TLS Secret matches
+// the desired state. When certificate generation is disabled, it deletes (or orphans) any
+// existing Certificate owned by the CR; clusters without the cert-manager CRDs are skipped.
+func (r *MemcachedReconciler) reconcileCertificate(ctx context.Context, mc *memcachedv1beta1.Memcached) error {
+	cert := newCertificate(mc)
+	if !mc.IsCertificateGenerated() {
+		if err := r.disableOwnedResource(ctx, mc, cert, "Certificate"); err != nil && !meta.IsNoMatchError(err) {
+			return err
+		}
+		return nil
+	}
+
+	_, err := r.reconcileResource(ctx, mc, cert, func() error {
+		return constructCertificate(mc, cert)
+	}, "Certificate")
+	return err
+}
+
 // reconcileDeployment ensures the Deployment for the Memcached CR matches the desired state.
-// It fetches referenced Secrets, computes a hash for rolling-update annotations, reads the
-// restart-trigger annotation from the CR, and passes everything to constructDeployment.
+// It fetches referenced Secrets, computes the secret and security hashes for Pod template
+// annotations, reads the restart-trigger annotation from the CR, and passes everything to
+// constructDeployment.
 // It returns the names of any missing Secrets for use by status reconciliation.
 func (r *MemcachedReconciler) reconcileDeployment(ctx context.Context, mc *memcachedv1beta1.Memcached) ([]string, error) {
+	logger := log.FromContext(ctx)
+
+	missingSources, err := r.reconcileTLSSecretCopy(ctx, mc)
+	if err != nil {
+		return nil, err
+	}
+
 	found, missing := fetchReferencedSecrets(ctx, r.Client, mc)
+	missing = append(missing, missingSources...)
 	secretHash := computeSecretHash(found...)
+	securityHash := computeSecurityHash(found...)
 	restartTrigger := mc.Annotations[AnnotationRestartTrigger]
+	specHash := computeSpecHash(mc, secretHash, securityHash, restartTrigger)
+
+	// Fast-path: skip rebuilding and diffing the Deployment when nothing it is
+	// built from has changed and it has not been modified out of band. A
+	// maintenance window opens and closes without a spec change, so it always
+	// takes the full path.
+	if mc.Spec.MaintenanceWindow == nil && r.deploymentUpToDate(ctx, mc, specHash) {
+		logger.V(1).Info("Deployment up to date; skipping rebuild", "name", mc.Name)
+		metrics.RecordReconcileResource("Deployment", "unchanged")
+		return missing, nil
+	}
+
+	windowOpen, _ := maintenanceWindowState(mc, r.now())
+	clientCAUnavailable := r.clientCAUnavailable(ctx, mc)
 
 	dep := &appsv1.Deployment{
 		ObjectMeta: metav1.ObjectMeta{
@@ -133,18 +303,57 @@ func (r *MemcachedReconciler) reconcileDeployment(ctx context.Context, mc *memca
 		},
 	}
 
-	_, err := r.reconcileResource(ctx, mc, dep, func() error {
+	_, err = r.reconcileResource(ctx, mc, dep, func() error {
+		var existing *appsv1.Deployment
+		if logger.V(1).Enabled() && dep.ResourceVersion != "" {
+			existing = dep.DeepCopy()
+		}
+		prev := snapshotDeployment(dep)
+
+		// The selector of an existing (possibly adopted) Deployment is immutable.
+		// Keep it when it still selects the managed pod labels; otherwise leave
+		// the Deployment alone and let reconcileStatus report the conflict.
+		existingSelector := dep.Spec.Selector
+		if existingSelector != nil && !selectorMatchesLabels(existingSelector, labelsForMemcached(mc.Name)) {
+			return errSelectorImmutableConflict
+		}
+
 		constructDeployment(mc, dep, secretHash, restartTrigger)
+		if existingSelector != nil {
+			dep.Spec.Selector = existingSelector
+		}
+		if clientCAUnavailable {
+			removeClientCA(dep)
+		}
+		setSecurityHashAnnotation(dep, securityHash)
+		setSpecHashAnnotation(dep, specHash)
+		applyMaintenanceWindow(mc, dep, prev, windowOpen)
+
+		if existing != nil {
+			if changed := diffDeploymentFields(existing, dep); len(changed) > 0 {
+				logger.V(1).Info("Deployment fields differ from desired state", "name", dep.Name, "changed", changed)
+			}
+		}
 		return nil
 	}, "Deployment")
+	if errors.Is(err, errSelectorImmutableConflict) {
+		logger.Info("Existing Deployment selector does not match the managed labels; skipping update",
+			"name", dep.Name, "selector", dep.Spec.Selector)
+		return missing, nil
+	}
+	if err == nil {
+		r.appliedGenerations.Store(client.ObjectKeyFromObject(mc), dep.Generation)
+	}
 	return missing, err
 }
 
 // reconcileHPA ensures the HorizontalPodAutoscaler for the Memcached CR matches the desired state.
-// When autoscaling is disabled, it deletes any existing HPA owned by the CR.
+// When autoscaling is disabled, it deletes any existing HPA owned by the CR. The HPA is
+// never orphaned, even with retainOrphansOnDisable: it would keep scaling the Deployment
+// while constructDeployment resets spec.replicas on every reconcile.
 func (r *MemcachedReconciler) reconcileHPA(ctx context.Context, mc *memcachedv1beta1.Memcached) error {
 	if !mc.IsAutoscalingEnabled() {
-		return r.deleteOwnedResource(ctx, &autoscalingv2.HorizontalPodAutoscaler{
+		return r.deleteOwnedResource(ctx, mc, &autoscalingv2.HorizontalPodAutoscaler{
 			ObjectMeta: metav1.ObjectMeta{Name: mc.Name, Namespace: mc.Namespace},
 		}, "HorizontalPodAutoscaler")
 	}
@@ -166,6 +375,12 @@ func (r *MemcachedReconciler) reconcileHPA(ctx context.Context, mc *memcachedv1b
 // reconcileService ensures the headless Service for the Memcached CR matches the desired state.
 // It uses reconcileResource for idempotent create/update with conflict retries.
 func (r *MemcachedReconciler) reconcileService(ctx context.Context, mc *memcachedv1beta1.Memcached) error {
+	// An externally managed Service is only checked for, in reconcileStatus. A
+	// Service the operator created before manage was set to false is released.
+	if !mc.IsServiceManaged() {
+		return r.releaseOwnedService(ctx, mc)
+	}
+
 	svc := &corev1.Service{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      mc.Name,
@@ -173,18 +388,33 @@ func (r *MemcachedReconciler) reconcileService(ctx context.Context, mc *memcache
 		},
 	}
 
-	_, err := r.reconcileResource(ctx, mc, svc, func() error {
+	endpointSlices, err := r.listServiceEndpointSlices(ctx, mc)
+	if err != nil {
+		return err
+	}
+	readyEndpoints := countReadyEndpoints(endpointSlices)
+	if readyEndpoints != int(mc.Status.ReadyReplicas) {
+		log.FromContext(ctx).V(1).Info("Ready endpoint count differs from status.readyReplicas",
+			"readyEndpoints", readyEndpoints, "readyReplicas", mc.Status.ReadyReplicas)
+	}
+
+	if err := r.deleteServiceOnTypeChange(ctx, mc); err != nil {
+		return err
+	}
+
+	_, err = r.reconcileResource(ctx, mc, svc, func() error {
 		constructService(mc, svc)
+		setReadyEndpointsAnnotation(svc, readyEndpoints)
 		return nil
 	}, "Service")
 	return err
 }
 
 // reconcilePDB ensures the PodDisruptionBudget for the Memcached CR matches the desired state.
-// When PDB is disabled, it deletes any existing PDB owned by the CR.
+// When PDB is disabled, it deletes (or orphans) any existing PDB owned by the CR.
 func (r *MemcachedReconciler) reconcilePDB(ctx context.Context, mc *memcachedv1beta1.Memcached) error {
 	if !mc.IsPDBEnabled() {
-		return r.deleteOwnedResource(ctx, &policyv1.PodDisruptionBudget{
+		return r.disableOwnedResource(ctx, mc, &policyv1.PodDisruptionBudget{
 			ObjectMeta: metav1.ObjectMeta{Name: mc.Name, Namespace: mc.Namespace},
 		}, "PodDisruptionBudget")
 	}
@@ -204,10 +434,10 @@ func (r *MemcachedReconciler) reconcilePDB(ctx context.Context, mc *memcachedv1b
 }
 
 // reconcileServiceMonitor ensures the ServiceMonitor for the Memcached CR matches the desired state.
-// When monitoring is disabled, it deletes any existing ServiceMonitor owned by the CR.
+// When monitoring is disabled, it deletes (or orphans) any existing ServiceMonitor owned by the CR.
 func (r *MemcachedReconciler) reconcileServiceMonitor(ctx context.Context, mc *memcachedv1beta1.Memcached) error {
 	if !mc.IsServiceMonitorEnabled() {
-		return r.deleteOwnedResource(ctx, &monitoringv1.ServiceMonitor{
+		return r.disableOwnedResource(ctx, mc, &monitoringv1.ServiceMonitor{
 			ObjectMeta: metav1.ObjectMeta{Name: mc.Name, Namespace: mc.Namespace},
 		}, "ServiceMonitor")
 	}
@@ -227,10 +457,14 @@ func (r *MemcachedReconciler) reconcileServiceMonitor(ctx context.Context, mc *m
 }
 
 // reconcileNetworkPolicy ensures the NetworkPolicy for the Memcached CR matches the desired state.
-// When NetworkPolicy is disabled, it deletes any existing NetworkPolicy owned by the CR.
+// When NetworkPolicy is disabled, it deletes (or orphans) any existing NetworkPolicy owned by the CR.
 func (r *MemcachedReconciler) reconcileNetworkPolicy(ctx context.Context, mc *memcachedv1beta1.Memcached) error {
+	if err := r.reconcileDefaultDenyNetworkPolicy(ctx, mc); err != nil {
+		return err
+	}
+
 	if !mc.IsNetworkPolicyEnabled() {
-		return r.deleteOwnedResource(ctx, &networkingv1.NetworkPolicy{
+		return r.disableOwnedResource(ctx, mc, &networkingv1.NetworkPolicy{
 			ObjectMeta: metav1.ObjectMeta{Name: mc.Name, Namespace: mc.Namespace},
 		}, "NetworkPolicy")
 	}
@@ -249,10 +483,31 @@ func (r *MemcachedReconciler) reconcileNetworkPolicy(ctx context.Context, mc *me
 	return err
 }
 
+// reconcileDefaultDenyNetworkPolicy ensures the companion default-deny NetworkPolicy
+// exists while spec.security.networkPolicy.createDefaultDeny is set, and cleans it up
+// otherwise, including when the allow policy itself is disabled.
+func (r *MemcachedReconciler) reconcileDefaultDenyNetworkPolicy(ctx context.Context, mc *memcachedv1beta1.Memcached) error {
+	np := &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultDenyNetworkPolicyName(mc),
+			Namespace: mc.Namespace,
+		},
+	}
+	if !mc.IsDefaultDenyNetworkPolicyEnabled() {
+		return r.disableOwnedResource(ctx, mc, np, "NetworkPolicy")
+	}
+
+	_, err := r.reconcileResource(ctx, mc, np, func() error {
+		constructDefaultDenyNetworkPolicy(mc, np)
+		return nil
+	}, "NetworkPolicy")
+	return err
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *MemcachedReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
-		For(&memcachedv1beta1.Memcached{}).
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&memcachedv1beta1.Memcached{}, builder.WithPredicates(ignoredPredicate())).
 		Owns(&appsv1.Deployment{}).
 		Owns(&autoscalingv2.HorizontalPodAutoscaler{}).
 		Owns(&corev1.Service{}).
@@ -260,6 +515,15 @@ func (r *MemcachedReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Owns(&networkingv1.NetworkPolicy{}).
 		Owns(&monitoringv1.ServiceMonitor{}).
 		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(mapSecretToMemcached(mgr.GetClient()))).
-		Named("memcached").
-		Complete(r)
+		Watches(&discoveryv1.EndpointSlice{},
+			handler.EnqueueRequestsFromMapFunc(mapEndpointSliceToMemcached()),
+			builder.WithPredicates(predicate.NewPredicateFuncs(isOperatorManaged)))
+
+	if r.NamespaceSelector != nil {
+		b = b.Watches(&corev1.Namespace{},
+			handler.EnqueueRequestsFromMapFunc(mapNamespaceToMemcached(mgr.GetClient())),
+			builder.WithPredicates(predicate.LabelChangedPredicate{}))
+	}
+
+	return b.Named("memcached").Complete(r)
 }
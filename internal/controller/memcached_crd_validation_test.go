@@ -13,6 +13,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	memcachedv1beta1 "github.com/c5c3/memcached-operator/api/v1beta1"
+	"github.com/c5c3/memcached-operator/internal/controller"
 )
 
 // uniqueName returns a unique resource name for test isolation.
@@ -224,11 +225,19 @@ var _ = Describe("CRD Validation: MemcachedConfig and Spec fields", func() {
 			Expect(k8sClient.Create(ctx, mc)).To(Succeed())
 		})
 
-		It("should reject verbosity=3", func() {
+		It("should accept verbosity=3", func() {
 			mc := validMemcached(uniqueName("verb3"))
 			mc.Spec.Memcached = &memcachedv1beta1.MemcachedConfig{
 				Verbosity: 3,
 			}
+			Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+		})
+
+		It("should reject verbosity=4", func() {
+			mc := validMemcached(uniqueName("verb4"))
+			mc.Spec.Memcached = &memcachedv1beta1.MemcachedConfig{
+				Verbosity: 4,
+			}
 			Expect(k8sClient.Create(ctx, mc)).NotTo(Succeed())
 		})
 	})
@@ -996,6 +1005,23 @@ var _ = Describe("CRD Validation: MemcachedStatus and printer columns", func() {
 			// Age column is based on metadata.creationTimestamp, which is auto-set.
 			Expect(updated.CreationTimestamp.IsZero()).To(BeFalse())
 		})
+
+		It("should populate the fields backing the Replicas, Ready, Phase, and Age columns on reconcile", func() {
+			mc := validMemcached(uniqueName("printer-phase"))
+			mc.Spec.Replicas = int32Ptr(3)
+			Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+			_, err := reconcileOnce(mc)
+			Expect(err).NotTo(HaveOccurred())
+
+			fetched := &memcachedv1beta1.Memcached{}
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), fetched)).To(Succeed())
+			Expect(*fetched.Spec.Replicas).To(Equal(int32(3)))
+			Expect(fetched.Status.ReadyReplicas).To(Equal(int32(0)))
+			// No Deployment becomes ready in envtest, so the Phase column reads Pending.
+			Expect(fetched.Status.Phase).To(Equal(controller.PhasePending))
+			Expect(fetched.CreationTimestamp.IsZero()).To(BeFalse())
+		})
 	})
 
 	Context("full resource with all fields", func() {
@@ -0,0 +1,110 @@
+package controller_test
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	memcachedv1beta1 "github.com/c5c3/memcached-operator/api/v1beta1"
+)
+
+const podDeletionCostAnnotation = "controller.kubernetes.io/pod-deletion-cost"
+
+var _ = Describe("Pod deletion cost reconciliation", func() {
+
+	// createAgedPods creates n pods carrying mc's instance labels, oldest first.
+	// The API server stamps creationTimestamp with second precision, so the pods
+	// are created more than a second apart to give each a distinct age.
+	createAgedPods := func(mc *memcachedv1beta1.Memcached, n int) []*corev1.Pod {
+		pods := make([]*corev1.Pod, 0, n)
+		for i := range n {
+			if i > 0 {
+				time.Sleep(1100 * time.Millisecond)
+			}
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      fmt.Sprintf("%s-%d", mc.Name, i),
+					Namespace: mc.Namespace,
+					Labels: map[string]string{
+						"app.kubernetes.io/name":       "memcached",
+						"app.kubernetes.io/instance":   mc.Name,
+						"app.kubernetes.io/managed-by": "memcached-operator",
+					},
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "memcached", Image: "memcached:1.6"}},
+				},
+			}
+			Expect(k8sClient.Create(ctx, pod)).To(Succeed())
+			pods = append(pods, pod)
+		}
+		return pods
+	}
+
+	deletionCost := func(pod *corev1.Pod) (string, bool) {
+		current := &corev1.Pod{}
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(pod), current)).To(Succeed())
+		cost, ok := current.Annotations[podDeletionCostAnnotation]
+		return cost, ok
+	}
+
+	It("should give older pods a higher deletion cost when preserveWarmPodsOnScaleDown is set", func() {
+		mc := validMemcached(uniqueName("deletion-cost"))
+		mc.Spec.Replicas = int32Ptr(3)
+		mc.Spec.PreserveWarmPodsOnScaleDown = true
+		Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+		pods := createAgedPods(mc, 3)
+
+		_, err := reconcileOnce(mc)
+		Expect(err).NotTo(HaveOccurred())
+
+		for i, want := range []string{"2", "1", "0"} {
+			cost, ok := deletionCost(pods[i])
+			Expect(ok).To(BeTrue(), "pod %s has no deletion cost", pods[i].Name)
+			Expect(cost).To(Equal(want), "unexpected deletion cost for pod %s", pods[i].Name)
+		}
+	})
+
+	It("should remove the deletion cost once preserveWarmPodsOnScaleDown is disabled", func() {
+		mc := validMemcached(uniqueName("deletion-cost-off"))
+		mc.Spec.PreserveWarmPodsOnScaleDown = true
+		Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+		pods := createAgedPods(mc, 2)
+
+		_, err := reconcileOnce(mc)
+		Expect(err).NotTo(HaveOccurred())
+		_, ok := deletionCost(pods[0])
+		Expect(ok).To(BeTrue())
+
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), mc)).To(Succeed())
+		mc.Spec.PreserveWarmPodsOnScaleDown = false
+		Expect(k8sClient.Update(ctx, mc)).To(Succeed())
+
+		_, err = reconcileOnce(mc)
+		Expect(err).NotTo(HaveOccurred())
+		for _, pod := range pods {
+			_, ok := deletionCost(pod)
+			Expect(ok).To(BeFalse(), "pod %s still has a deletion cost", pod.Name)
+		}
+	})
+
+	It("should not annotate pods when preserveWarmPodsOnScaleDown is unset", func() {
+		mc := validMemcached(uniqueName("deletion-cost-unset"))
+		Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+		pods := createAgedPods(mc, 1)
+
+		_, err := reconcileOnce(mc)
+		Expect(err).NotTo(HaveOccurred())
+		_, ok := deletionCost(pods[0])
+		Expect(ok).To(BeFalse())
+	})
+})
@@ -932,6 +932,48 @@ var _ = Describe("Deployment Reconciliation", func() {
 
 	// --- Task 1.1: Graceful shutdown ---
 
+	Context("minReadySeconds", func() {
+		It("should derive minReadySeconds from the readiness probe period with multi-replica HA", func() {
+			mc := validMemcached(uniqueName("dep-minready-ha"))
+			mc.Spec.Replicas = int32Ptr(3)
+			soft := memcachedv1beta1.AntiAffinityPresetSoft
+			mc.Spec.HighAvailability = &memcachedv1beta1.HighAvailabilitySpec{AntiAffinityPreset: &soft}
+			Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+			_, err := reconcileOnce(mc)
+			Expect(err).NotTo(HaveOccurred())
+
+			dep := fetchDeployment(mc)
+			readiness := dep.Spec.Template.Spec.Containers[0].ReadinessProbe
+			Expect(dep.Spec.MinReadySeconds).To(Equal(readiness.PeriodSeconds))
+			Expect(dep.Spec.MinReadySeconds).To(BeNumerically(">", 0))
+		})
+
+		It("should use an explicit minReadySeconds over the derived default", func() {
+			mc := validMemcached(uniqueName("dep-minready-explicit"))
+			mc.Spec.Replicas = int32Ptr(3)
+			mc.Spec.MinReadySeconds = int32Ptr(20)
+			soft := memcachedv1beta1.AntiAffinityPresetSoft
+			mc.Spec.HighAvailability = &memcachedv1beta1.HighAvailabilitySpec{AntiAffinityPreset: &soft}
+			Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+			_, err := reconcileOnce(mc)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fetchDeployment(mc).Spec.MinReadySeconds).To(Equal(int32(20)))
+		})
+
+		It("should leave minReadySeconds at 0 without HA or graceful shutdown", func() {
+			mc := validMemcached(uniqueName("dep-minready-none"))
+			Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+			_, err := reconcileOnce(mc)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fetchDeployment(mc).Spec.MinReadySeconds).To(BeZero())
+		})
+	})
+
 	Context("graceful shutdown (REQ-001, REQ-002, REQ-003, REQ-004, REQ-005)", func() {
 		It("should create Deployment with preStop hook and terminationGracePeriodSeconds when graceful shutdown is enabled", func() {
 			mc := validMemcached(uniqueName("dep-gs-on"))
@@ -0,0 +1,102 @@
+package controller_test
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	memcachedv1beta1 "github.com/c5c3/memcached-operator/api/v1beta1"
+	"github.com/c5c3/memcached-operator/internal/controller"
+)
+
+// createEndpointSlice fabricates an EndpointSlice for mc's Service with one
+// endpoint per entry in ready, as the EndpointSlice controller would.
+func createEndpointSlice(mc *memcachedv1beta1.Memcached, ready ...bool) {
+	slice := &discoveryv1.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      mc.Name + "-slice",
+			Namespace: mc.Namespace,
+			Labels: map[string]string{
+				discoveryv1.LabelServiceName:   mc.Name,
+				"app.kubernetes.io/managed-by": "memcached-operator",
+			},
+		},
+		AddressType: discoveryv1.AddressTypeIPv4,
+	}
+	for i, r := range ready {
+		slice.Endpoints = append(slice.Endpoints, discoveryv1.Endpoint{
+			Addresses:  []string{fmt.Sprintf("10.0.0.%d", i+1)},
+			Conditions: discoveryv1.EndpointConditions{Ready: &r},
+			TargetRef: &corev1.ObjectReference{
+				Kind:      "Pod",
+				Namespace: mc.Namespace,
+				Name:      fmt.Sprintf("%s-%d", mc.Name, i),
+			},
+		})
+	}
+	Expect(k8sClient.Create(ctx, slice)).To(Succeed())
+}
+
+var _ = Describe("Service ready-endpoints annotation", func() {
+
+	It("should annotate the Service with zero ready endpoints when none exist", func() {
+		mc := validMemcached(uniqueName("endpoints-none"))
+		Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+		_, err := reconcileOnce(mc)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fetchService(mc).Annotations).To(HaveKeyWithValue(controller.AnnotationReadyEndpoints, "0"))
+	})
+
+	It("should count only ready endpoints", func() {
+		mc := validMemcached(uniqueName("endpoints"))
+		mc.Spec.Replicas = int32Ptr(3)
+		Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+		createEndpointSlice(mc, true, true, false)
+
+		_, err := reconcileOnce(mc)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fetchService(mc).Annotations).To(HaveKeyWithValue(controller.AnnotationReadyEndpoints, "2"))
+	})
+})
+
+var _ = Describe("Status readyEndpoints", func() {
+
+	It("should leave readyEndpoints empty unless trackEndpoints is set", func() {
+		mc := validMemcached(uniqueName("track-off"))
+		Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+		createEndpointSlice(mc, true)
+
+		_, err := reconcileOnce(mc)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), mc)).To(Succeed())
+		Expect(mc.Status.ReadyEndpoints).To(BeEmpty())
+	})
+
+	It("should list the addresses of the ready endpoints", func() {
+		mc := validMemcached(uniqueName("track-on"))
+		mc.Spec.Replicas = int32Ptr(3)
+		mc.Spec.Service = &memcachedv1beta1.ServiceSpec{TrackEndpoints: true}
+		Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+		createEndpointSlice(mc, true, false, true)
+
+		_, err := reconcileOnce(mc)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), mc)).To(Succeed())
+		Expect(mc.Status.ReadyEndpoints).To(Equal([]string{"10.0.0.1", "10.0.0.3"}))
+		Expect(fetchService(mc).Spec.PublishNotReadyAddresses).To(BeFalse())
+	})
+})
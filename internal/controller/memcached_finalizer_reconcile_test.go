@@ -0,0 +1,60 @@
+package controller_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/events"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	memcachedv1beta1 "github.com/c5c3/memcached-operator/api/v1beta1"
+	"github.com/c5c3/memcached-operator/internal/controller"
+)
+
+var _ = Describe("Final stats finalizer", func() {
+
+	It("should add the finalizer on the first reconcile", func() {
+		mc := validMemcached(uniqueName("fin-add"))
+		Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+		_, err := reconcileOnce(mc)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), mc)).To(Succeed())
+		Expect(mc.Finalizers).To(ContainElement(controller.FinalizerFinalStats))
+	})
+
+	It("should complete the finalizer when no pods exist", func() {
+		mc := validMemcached(uniqueName("fin-nopods"))
+		Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+		_, err := reconcileOnce(mc)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(k8sClient.Delete(ctx, mc)).To(Succeed())
+
+		// Deletion waits for the finalizer.
+		fetched := &memcachedv1beta1.Memcached{}
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), fetched)).To(Succeed())
+		Expect(fetched.DeletionTimestamp).NotTo(BeNil())
+
+		recorder := events.NewFakeRecorder(10)
+		r := &controller.MemcachedReconciler{
+			Client:   k8sClient,
+			Scheme:   scheme.Scheme,
+			Recorder: recorder,
+		}
+		result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(mc)})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(ctrl.Result{}))
+
+		err = k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), &memcachedv1beta1.Memcached{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue(), "expected the Memcached to be gone, got err=%v", err)
+
+		// Without a reachable pod no FinalStats Event is recorded.
+		Expect(recorder.Events).To(BeEmpty())
+	})
+})
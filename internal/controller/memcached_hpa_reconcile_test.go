@@ -35,6 +35,42 @@ func cpuResourceRequirements() *corev1.ResourceRequirements {
 
 var _ = Describe("HPA Reconciliation", func() {
 
+	Context("HPA with a Pods metric on current connections", func() {
+		It("should pass the Pods metric through to the HPA", func() {
+			mc := validMemcached(uniqueName("hpa-pods-metric"))
+			mc.Spec.Autoscaling = &memcachedv1beta1.AutoscalingSpec{
+				Enabled:     true,
+				MaxReplicas: 5,
+				Metrics: []autoscalingv2.MetricSpec{
+					{
+						Type: autoscalingv2.PodsMetricSourceType,
+						Pods: &autoscalingv2.PodsMetricSource{
+							Metric: autoscalingv2.MetricIdentifier{Name: "memcached_current_connections"},
+							Target: autoscalingv2.MetricTarget{
+								Type:         autoscalingv2.AverageValueMetricType,
+								AverageValue: resource.NewQuantity(500, resource.DecimalSI),
+							},
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+			_, err := reconcileOnce(mc)
+			Expect(err).NotTo(HaveOccurred())
+
+			hpa := fetchHPA(mc)
+			Expect(hpa.Spec.Metrics).To(HaveLen(1))
+			metric := hpa.Spec.Metrics[0]
+			Expect(metric.Type).To(Equal(autoscalingv2.PodsMetricSourceType))
+			Expect(metric.Pods).NotTo(BeNil())
+			Expect(metric.Pods.Metric.Name).To(Equal("memcached_current_connections"))
+			Expect(metric.Pods.Target.Type).To(Equal(autoscalingv2.AverageValueMetricType))
+			Expect(metric.Pods.Target.AverageValue.Value()).To(Equal(int64(500)))
+			Expect(metric.Resource).To(BeNil())
+		})
+	})
+
 	Context("HPA creation with full spec", func() {
 		var mc *memcachedv1beta1.Memcached
 
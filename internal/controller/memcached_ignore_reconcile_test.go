@@ -0,0 +1,58 @@
+package controller_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/c5c3/memcached-operator/internal/controller"
+)
+
+var _ = Describe("Ignore label", func() {
+
+	It("should not reconcile a CR labeled memcached.c5c3.io/ignore=true", func() {
+		mc := validMemcached(uniqueName("ignored"))
+		mc.Labels = map[string]string{controller.LabelIgnore: "true"}
+		Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+		_, err := reconcileOnce(mc)
+		Expect(err).NotTo(HaveOccurred())
+
+		err = k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), &appsv1.Deployment{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue(), "expected no Deployment, got err=%v", err)
+
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), mc)).To(Succeed())
+		Expect(mc.Status.ObservedGeneration).To(BeZero())
+	})
+
+	It("should reconcile an unlabeled CR", func() {
+		mc := validMemcached(uniqueName("not-ignored"))
+		Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+		_, err := reconcileOnce(mc)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), &appsv1.Deployment{})).To(Succeed())
+	})
+
+	It("should resume reconciling once the label is removed", func() {
+		mc := validMemcached(uniqueName("unignored"))
+		mc.Labels = map[string]string{controller.LabelIgnore: "true"}
+		Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+		_, err := reconcileOnce(mc)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), mc)).To(Succeed())
+		delete(mc.Labels, controller.LabelIgnore)
+		Expect(k8sClient.Update(ctx, mc)).To(Succeed())
+
+		_, err = reconcileOnce(mc)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), &appsv1.Deployment{})).To(Succeed())
+	})
+})
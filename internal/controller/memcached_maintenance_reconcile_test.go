@@ -0,0 +1,65 @@
+package controller_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	memcachedv1beta1 "github.com/c5c3/memcached-operator/api/v1beta1"
+	"github.com/c5c3/memcached-operator/internal/controller"
+)
+
+var _ = Describe("Maintenance read-only mode", func() {
+
+	It("should toggle the Maintenance condition and read-only annotation", func() {
+		mc := validMemcached(uniqueName("maint"))
+		mc.Spec.Replicas = int32Ptr(3)
+		mc.Spec.Maintenance = &memcachedv1beta1.MaintenanceSpec{ReadOnly: true, Replicas: int32Ptr(1)}
+		Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+		_, err := reconcileOnce(mc)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), mc)).To(Succeed())
+		cond := findCondition(mc.Status.Conditions, controller.ConditionTypeMaintenance)
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+		Expect(cond.Reason).To(Equal(controller.ConditionReasonReadOnly))
+
+		dep := fetchDeployment(mc)
+		Expect(dep.Annotations).To(HaveKeyWithValue(controller.AnnotationReadOnly, "true"))
+		Expect(dep.Spec.Template.Annotations).NotTo(HaveKey(controller.AnnotationReadOnly))
+		Expect(*dep.Spec.Replicas).To(Equal(int32(1)))
+		Expect(fetchService(mc).Annotations).To(HaveKeyWithValue(controller.AnnotationReadOnly, "true"))
+
+		By("turning read-only mode off")
+		mc.Spec.Maintenance.ReadOnly = false
+		Expect(k8sClient.Update(ctx, mc)).To(Succeed())
+
+		_, err = reconcileOnce(mc)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), mc)).To(Succeed())
+		cond = findCondition(mc.Status.Conditions, controller.ConditionTypeMaintenance)
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+		Expect(cond.Reason).To(Equal(controller.ConditionReasonReadWrite))
+
+		dep = fetchDeployment(mc)
+		Expect(dep.Annotations).NotTo(HaveKey(controller.AnnotationReadOnly))
+		Expect(*dep.Spec.Replicas).To(Equal(int32(3)))
+		Expect(fetchService(mc).Annotations).NotTo(HaveKey(controller.AnnotationReadOnly))
+
+		By("removing spec.maintenance")
+		mc.Spec.Maintenance = nil
+		Expect(k8sClient.Update(ctx, mc)).To(Succeed())
+
+		_, err = reconcileOnce(mc)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), mc)).To(Succeed())
+		Expect(findCondition(mc.Status.Conditions, controller.ConditionTypeMaintenance)).To(BeNil())
+	})
+})
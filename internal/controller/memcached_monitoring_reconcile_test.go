@@ -114,6 +114,45 @@ var _ = Describe("Monitoring Reconciliation", func() {
 		})
 	})
 
+	Context("pushgateway sidecar", func() {
+
+		It("should add the push sidecar only while pushGateway is configured", func() {
+			mc := validMemcached(uniqueName("mon-push"))
+			mc.Spec.Monitoring = &memcachedv1beta1.MonitoringSpec{
+				Enabled: true,
+				PushGateway: &memcachedv1beta1.PushGatewaySpec{
+					URL:     "http://pushgateway.monitoring:9091",
+					JobName: "batch-cache",
+				},
+			}
+			Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+			_, err := reconcileOnce(mc)
+			Expect(err).NotTo(HaveOccurred())
+
+			dep := fetchDeployment(mc)
+			Expect(dep.Spec.Template.Spec.Containers).To(HaveLen(3))
+			push := dep.Spec.Template.Spec.Containers[2]
+			Expect(push.Name).To(Equal("pushgateway"))
+			Expect(push.Env).To(ContainElements(
+				corev1.EnvVar{Name: "PUSHGATEWAY_URL", Value: "http://pushgateway.monitoring:9091"},
+				corev1.EnvVar{Name: "PUSHGATEWAY_JOB", Value: "batch-cache"},
+				corev1.EnvVar{Name: "PUSH_INTERVAL_SECONDS", Value: "30"},
+			))
+
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), mc)).To(Succeed())
+			mc.Spec.Monitoring.PushGateway = nil
+			Expect(k8sClient.Update(ctx, mc)).To(Succeed())
+
+			_, err = reconcileOnce(mc)
+			Expect(err).NotTo(HaveOccurred())
+
+			dep = fetchDeployment(mc)
+			Expect(dep.Spec.Template.Spec.Containers).To(HaveLen(2))
+			Expect(dep.Spec.Template.Spec.Containers[1].Name).To(Equal("exporter"))
+		})
+	})
+
 	Context("toggling monitoring (REQ-005, REQ-006)", func() {
 
 		It("should add exporter sidecar when monitoring is enabled on existing CR", func() {
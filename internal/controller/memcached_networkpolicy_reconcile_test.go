@@ -180,6 +180,45 @@ var _ = Describe("NetworkPolicy Reconciliation", func() {
 		})
 	})
 
+	Context("NetworkPolicy with allowDebugNamespace", func() {
+		It("should add a separate ingress rule scoped to the debug namespace", func() {
+			mc := validMemcached(uniqueName("np-debug"))
+			mc.Spec.Security = &memcachedv1beta1.SecuritySpec{
+				NetworkPolicy: &memcachedv1beta1.NetworkPolicySpec{
+					Enabled: true,
+					AllowedSources: []networkingv1.NetworkPolicyPeer{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"app": "client"},
+							},
+						},
+					},
+					AllowDebugNamespace: strPtr("troubleshooting"),
+				},
+			}
+			Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+			_, err := reconcileOnce(mc)
+			Expect(err).NotTo(HaveOccurred())
+
+			np := fetchNetworkPolicy(mc)
+			Expect(np.Spec.Ingress).To(HaveLen(2))
+
+			// The allowedSources rule is left untouched.
+			Expect(np.Spec.Ingress[0].From).To(HaveLen(1))
+			Expect(np.Spec.Ingress[0].From[0].PodSelector.MatchLabels).To(HaveKeyWithValue("app", "client"))
+
+			debug := np.Spec.Ingress[1]
+			Expect(debug.Ports).To(HaveLen(1))
+			Expect(debug.Ports[0].Port.IntValue()).To(Equal(11211))
+			Expect(debug.From).To(HaveLen(1))
+			Expect(debug.From[0].PodSelector).To(BeNil())
+			Expect(debug.From[0].NamespaceSelector).NotTo(BeNil())
+			Expect(debug.From[0].NamespaceSelector.MatchLabels).To(Equal(
+				map[string]string{"kubernetes.io/metadata.name": "troubleshooting"}))
+		})
+	})
+
 	Context("No NetworkPolicy when disabled", func() {
 		It("should not create a NetworkPolicy resource", func() {
 			mc := validMemcached(uniqueName("np-disabled"))
@@ -194,6 +233,75 @@ var _ = Describe("NetworkPolicy Reconciliation", func() {
 		})
 	})
 
+	Context("Default-deny NetworkPolicy", func() {
+		fetchDefaultDeny := func(mc *memcachedv1beta1.Memcached) (*networkingv1.NetworkPolicy, error) {
+			np := &networkingv1.NetworkPolicy{}
+			err := k8sClient.Get(ctx, client.ObjectKey{Name: mc.Name + "-default-deny", Namespace: mc.Namespace}, np)
+			return np, err
+		}
+
+		It("should create both policies when createDefaultDeny is set", func() {
+			mc := validMemcached(uniqueName("np-deny"))
+			mc.Spec.Security = &memcachedv1beta1.SecuritySpec{
+				NetworkPolicy: &memcachedv1beta1.NetworkPolicySpec{Enabled: true, CreateDefaultDeny: true},
+			}
+			Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+			_, err := reconcileOnce(mc)
+			Expect(err).NotTo(HaveOccurred())
+
+			allow := fetchNetworkPolicy(mc)
+			Expect(allow.Spec.Ingress).To(HaveLen(1))
+
+			deny, err := fetchDefaultDeny(mc)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(deny.Spec.PolicyTypes).To(ConsistOf(networkingv1.PolicyTypeIngress))
+			Expect(deny.Spec.Ingress).To(BeEmpty())
+			Expect(deny.Spec.PodSelector.MatchLabels).To(Equal(allow.Spec.PodSelector.MatchLabels))
+			Expect(metav1.IsControlledBy(deny, mc)).To(BeTrue())
+		})
+
+		It("should not create the default-deny policy unless requested", func() {
+			mc := validMemcached(uniqueName("np-deny-unset"))
+			mc.Spec.Security = &memcachedv1beta1.SecuritySpec{
+				NetworkPolicy: &memcachedv1beta1.NetworkPolicySpec{Enabled: true},
+			}
+			Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+			_, err := reconcileOnce(mc)
+			Expect(err).NotTo(HaveOccurred())
+
+			fetchNetworkPolicy(mc)
+			_, err = fetchDefaultDeny(mc)
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should delete both policies when the NetworkPolicy is disabled", func() {
+			mc := validMemcached(uniqueName("np-deny-off"))
+			mc.Spec.Security = &memcachedv1beta1.SecuritySpec{
+				NetworkPolicy: &memcachedv1beta1.NetworkPolicySpec{Enabled: true, CreateDefaultDeny: true},
+			}
+			Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+			_, err := reconcileOnce(mc)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = fetchDefaultDeny(mc)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), mc)).To(Succeed())
+			mc.Spec.Security.NetworkPolicy.Enabled = false
+			Expect(k8sClient.Update(ctx, mc)).To(Succeed())
+
+			_, err = reconcileOnce(mc)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), &networkingv1.NetworkPolicy{})
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+			_, err = fetchDefaultDeny(mc)
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+
 	Context("NetworkPolicy update when monitoring toggled on", func() {
 		It("should add metrics port 9150 on update", func() {
 			mc := validMemcached(uniqueName("np-toggle-mon"))
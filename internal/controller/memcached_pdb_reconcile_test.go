@@ -161,6 +161,36 @@ var _ = Describe("PDB Reconciliation", func() {
 		})
 	})
 
+	Context("PDB budget derived from the anti-affinity preset", func() {
+		createWithPreset := func(prefix string, preset memcachedv1beta1.AntiAffinityPreset) *memcachedv1beta1.Memcached {
+			mc := validMemcached(uniqueName(prefix))
+			mc.Spec.Replicas = int32Ptr(3)
+			mc.Spec.HighAvailability = &memcachedv1beta1.HighAvailabilitySpec{
+				AntiAffinityPreset:  &preset,
+				PodDisruptionBudget: &memcachedv1beta1.PDBSpec{Enabled: true},
+			}
+			Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+			_, err := reconcileOnce(mc)
+			Expect(err).NotTo(HaveOccurred())
+			return mc
+		}
+
+		It("should default to maxUnavailable=1 under the hard preset", func() {
+			pdb := fetchPDB(createWithPreset("pdb-default-hard", memcachedv1beta1.AntiAffinityPresetHard))
+			Expect(pdb.Spec.MaxUnavailable).NotTo(BeNil())
+			Expect(*pdb.Spec.MaxUnavailable).To(Equal(intstr.FromInt32(1)))
+			Expect(pdb.Spec.MinAvailable).To(BeNil())
+		})
+
+		It("should default to maxUnavailable=25% under the soft preset", func() {
+			pdb := fetchPDB(createWithPreset("pdb-default-soft", memcachedv1beta1.AntiAffinityPresetSoft))
+			Expect(pdb.Spec.MaxUnavailable).NotTo(BeNil())
+			Expect(pdb.Spec.MaxUnavailable.String()).To(Equal("25%"))
+			Expect(pdb.Spec.MinAvailable).To(BeNil())
+		})
+	})
+
 	Context("PDB with both minAvailable and maxUnavailable set", func() {
 		It("should be rejected by the validation webhook", func() {
 			mc := validMemcached(uniqueName("pdb-both-set"))
@@ -0,0 +1,61 @@
+package controller_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("Label and annotation propagation", func() {
+
+	It("should copy listed CR labels and annotations onto the Deployment and Service", func() {
+		mc := validMemcached(uniqueName("propagate"))
+		mc.Labels = map[string]string{
+			"cost-center":            "1234",
+			"team":                   "platform",
+			"app.kubernetes.io/name": "spoofed",
+		}
+		mc.Annotations = map[string]string{"example.com/owner": "alice"}
+		mc.Spec.PropagateLabels = []string{"cost-center", "app.kubernetes.io/name"}
+		mc.Spec.PropagateAnnotations = []string{"example.com/owner"}
+		Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+		_, err := reconcileOnce(mc)
+		Expect(err).NotTo(HaveOccurred())
+
+		dep := fetchDeployment(mc)
+		svc := fetchService(mc)
+		for _, labels := range []map[string]string{dep.Labels, svc.Labels} {
+			Expect(labels).To(HaveKeyWithValue("cost-center", "1234"))
+			Expect(labels).NotTo(HaveKey("team"))
+			Expect(labels).To(HaveKeyWithValue("app.kubernetes.io/name", "memcached"))
+		}
+		Expect(dep.Annotations).To(HaveKeyWithValue("example.com/owner", "alice"))
+		Expect(svc.Annotations).To(HaveKeyWithValue("example.com/owner", "alice"))
+		Expect(svc.Spec.Selector).NotTo(HaveKey("cost-center"))
+		Expect(dep.Spec.Selector.MatchLabels).NotTo(HaveKey("cost-center"))
+	})
+
+	It("should update owned resources when a propagated label value changes", func() {
+		mc := validMemcached(uniqueName("propagate-upd"))
+		mc.Labels = map[string]string{"cost-center": "1234"}
+		mc.Spec.PropagateLabels = []string{"cost-center"}
+		Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+		_, err := reconcileOnce(mc)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = reconcileOnce(mc)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), mc)).To(Succeed())
+		mc.Labels["cost-center"] = "5678"
+		Expect(k8sClient.Update(ctx, mc)).To(Succeed())
+
+		_, err = reconcileOnce(mc)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fetchDeployment(mc).Labels).To(HaveKeyWithValue("cost-center", "5678"))
+		Expect(fetchService(mc).Labels).To(HaveKeyWithValue("cost-center", "5678"))
+	})
+})
@@ -98,8 +98,8 @@ var _ = Describe("RBAC Manifest Verification (REQ-007)", func() {
 			Expect(role.Name).To(Equal("manager-role"))
 		})
 
-		It("should have exactly 10 rules to prevent permission creep", func() {
-			Expect(role.Rules).To(HaveLen(10), "unexpected number of rules — update this test if a new rule is legitimately needed")
+		It("should have exactly 14 rules to prevent permission creep", func() {
+			Expect(role.Rules).To(HaveLen(14), "unexpected number of rules — update this test if a new rule is legitimately needed")
 		})
 	})
 
@@ -133,13 +133,36 @@ var _ = Describe("RBAC Manifest Verification (REQ-007)", func() {
 			Entry("PodDisruptionBudgets", "policy", "poddisruptionbudgets"),
 			Entry("NetworkPolicies", "networking.k8s.io", "networkpolicies"),
 			Entry("ServiceMonitors", "monitoring.coreos.com", "servicemonitors"),
+			Entry("Certificates", "cert-manager.io", "certificates"),
 		)
 	})
 
 	Context("Secrets permission", func() {
-		It("should grant read-only access on secrets", func() {
+		It("should grant read and write but not delete on secrets", func() {
 			rule := findRule(role.Rules, "", "secrets")
 			Expect(rule).NotTo(BeNil(), "rule for secrets not found")
+			Expect(sortedVerbs(rule.Verbs)).To(Equal([]string{"create", "get", "list", "patch", "update", "watch"}))
+		})
+	})
+
+	Context("Namespaces and pods permission", func() {
+		It("should grant read-only access on namespaces", func() {
+			rule := findRule(role.Rules, "", "namespaces")
+			Expect(rule).NotTo(BeNil(), "rule for namespaces not found")
+			Expect(sortedVerbs(rule.Verbs)).To(Equal([]string{"get", "list", "watch"}))
+		})
+
+		It("should grant read and patch access on pods", func() {
+			rule := findRule(role.Rules, "", "pods")
+			Expect(rule).NotTo(BeNil(), "rule for pods not found")
+			Expect(sortedVerbs(rule.Verbs)).To(Equal([]string{"get", "list", "patch", "watch"}))
+		})
+	})
+
+	Context("EndpointSlices permission", func() {
+		It("should grant read-only access on endpointslices", func() {
+			rule := findRule(role.Rules, "discovery.k8s.io", "endpointslices")
+			Expect(rule).NotTo(BeNil(), "rule for endpointslices not found")
 			Expect(sortedVerbs(rule.Verbs)).To(Equal([]string{"get", "list", "watch"}))
 		})
 	})
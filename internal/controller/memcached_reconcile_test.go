@@ -210,6 +210,71 @@ var _ = Describe("Idempotent Create-or-Update Reconciliation", func() {
 		})
 	})
 
+	Context("reconcile fast-path with a spec-hash annotation", func() {
+		It("should record the spec hash and still apply real spec changes", func() {
+			mc := validMemcached(uniqueName("fast-path"))
+			mc.Spec.Replicas = int32Ptr(1)
+			Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+			// Reuse a single reconciler so the fast-path state is retained.
+			r := &controller.MemcachedReconciler{
+				Client: k8sClient,
+				Scheme: scheme.Scheme,
+			}
+			req := ctrl.Request{NamespacedName: client.ObjectKeyFromObject(mc)}
+			_, err := r.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			dep := fetchDeployment(mc)
+			Expect(dep.Annotations).To(HaveKey(controller.AnnotationSpecHash))
+			hash := dep.Annotations[controller.AnnotationSpecHash]
+			depRV := dep.ResourceVersion
+
+			// Status now observes the current generation: a no-op reconcile
+			// takes the fast-path and leaves the Deployment untouched.
+			_, err = r.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fetchDeployment(mc).ResourceVersion).To(Equal(depRV))
+
+			// A real spec change triggers a full update.
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), mc)).To(Succeed())
+			mc.Spec.Replicas = int32Ptr(3)
+			Expect(k8sClient.Update(ctx, mc)).To(Succeed())
+
+			_, err = r.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			updated := fetchDeployment(mc)
+			Expect(*updated.Spec.Replicas).To(Equal(int32(3)))
+			Expect(updated.Annotations[controller.AnnotationSpecHash]).NotTo(Equal(hash))
+		})
+
+		It("should still correct out-of-band Deployment edits", func() {
+			mc := validMemcached(uniqueName("fast-path-drift"))
+			mc.Spec.Replicas = int32Ptr(2)
+			Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+			r := &controller.MemcachedReconciler{
+				Client: k8sClient,
+				Scheme: scheme.Scheme,
+			}
+			req := ctrl.Request{NamespacedName: client.ObjectKeyFromObject(mc)}
+			_, err := r.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = r.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			dep := fetchDeployment(mc)
+			patch := client.MergeFrom(dep.DeepCopy())
+			dep.Spec.Replicas = int32Ptr(7)
+			Expect(k8sClient.Patch(ctx, dep, patch)).To(Succeed())
+
+			_, err = r.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*fetchDeployment(mc).Spec.Replicas).To(Equal(int32(2)))
+		})
+	})
+
 	Context("CR deleted between event delivery and reconciliation (REQ-006)", func() {
 		It("should return no error when CR is deleted", func() {
 			mc := validMemcached(uniqueName("idem-deleted"))
@@ -0,0 +1,88 @@
+package controller_test
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	memcachedv1beta1 "github.com/c5c3/memcached-operator/api/v1beta1"
+	"github.com/c5c3/memcached-operator/internal/controller"
+)
+
+var _ = Describe("Frequent restarts status reporting", func() {
+
+	// createPodWithRestarts creates a pod carrying mc's instance labels and
+	// fabricates a container status with the given restarts and termination reason.
+	createPodWithRestarts := func(mc *memcachedv1beta1.Memcached, idx int, restarts int32, reason string) {
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("%s-%d", mc.Name, idx),
+				Namespace: mc.Namespace,
+				Labels: map[string]string{
+					"app.kubernetes.io/name":       "memcached",
+					"app.kubernetes.io/instance":   mc.Name,
+					"app.kubernetes.io/managed-by": "memcached-operator",
+				},
+			},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{Name: "memcached", Image: "memcached:1.6"}},
+			},
+		}
+		Expect(k8sClient.Create(ctx, pod)).To(Succeed())
+
+		pod.Status.ContainerStatuses = []corev1.ContainerStatus{{
+			Name:         "memcached",
+			Image:        "memcached:1.6",
+			RestartCount: restarts,
+			LastTerminationState: corev1.ContainerState{
+				Terminated: &corev1.ContainerStateTerminated{
+					ExitCode:   137,
+					Reason:     reason,
+					FinishedAt: metav1.NewTime(time.Now().Add(-time.Minute)),
+				},
+			},
+		}}
+		Expect(k8sClient.Status().Update(ctx, pod)).To(Succeed())
+	}
+
+	It("should set Degraded with reason FrequentRestarts when restarts exceed the threshold", func() {
+		mc := validMemcached(uniqueName("restarts"))
+		mc.Spec.Replicas = int32Ptr(2)
+		Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+		createPodWithRestarts(mc, 0, 3, "OOMKilled")
+		createPodWithRestarts(mc, 1, 4, "OOMKilled")
+
+		_, err := reconcileOnce(mc)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), mc)).To(Succeed())
+		cond := findCondition(mc.Status.Conditions, controller.ConditionTypeDegraded)
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+		Expect(cond.Reason).To(Equal(controller.ConditionReasonFrequentRestarts))
+		Expect(cond.Message).To(ContainSubstring("7 times"))
+		Expect(cond.Message).To(ContainSubstring("OOMKilled"))
+	})
+
+	It("should not report FrequentRestarts below the threshold", func() {
+		mc := validMemcached(uniqueName("restarts-low"))
+		Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+		createPodWithRestarts(mc, 0, 1, "Error")
+
+		_, err := reconcileOnce(mc)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), mc)).To(Succeed())
+		cond := findCondition(mc.Status.Conditions, controller.ConditionTypeDegraded)
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Reason).NotTo(Equal(controller.ConditionReasonFrequentRestarts))
+	})
+})
@@ -0,0 +1,80 @@
+package controller_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	memcachedv1beta1 "github.com/c5c3/memcached-operator/api/v1beta1"
+)
+
+var _ = Describe("Optional resource cleanup on disable", func() {
+
+	// createWithOptionalResources creates a Memcached CR with a PDB and a
+	// NetworkPolicy and reconciles it once so both resources exist.
+	createWithOptionalResources := func(name string, retain bool) *memcachedv1beta1.Memcached {
+		minAvailable := intstr.FromInt32(1)
+		mc := validMemcached(uniqueName(name))
+		mc.Spec.Replicas = int32Ptr(2)
+		mc.Spec.RetainOrphansOnDisable = retain
+		mc.Spec.HighAvailability = &memcachedv1beta1.HighAvailabilitySpec{
+			PodDisruptionBudget: &memcachedv1beta1.PDBSpec{Enabled: true, MinAvailable: &minAvailable},
+		}
+		mc.Spec.Security = &memcachedv1beta1.SecuritySpec{
+			NetworkPolicy: &memcachedv1beta1.NetworkPolicySpec{Enabled: true},
+		}
+		Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+		_, err := reconcileOnce(mc)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), &policyv1.PodDisruptionBudget{})).To(Succeed())
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), &networkingv1.NetworkPolicy{})).To(Succeed())
+		return mc
+	}
+
+	disableOptionalResources := func(mc *memcachedv1beta1.Memcached) {
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), mc)).To(Succeed())
+		mc.Spec.HighAvailability.PodDisruptionBudget.Enabled = false
+		mc.Spec.Security.NetworkPolicy.Enabled = false
+		Expect(k8sClient.Update(ctx, mc)).To(Succeed())
+
+		_, err := reconcileOnce(mc)
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	It("should delete optional resources by default", func() {
+		mc := createWithOptionalResources("orphans-delete", false)
+
+		disableOptionalResources(mc)
+
+		err := k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), &policyv1.PodDisruptionBudget{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		err = k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), &networkingv1.NetworkPolicy{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should retain and orphan optional resources when retainOrphansOnDisable is set", func() {
+		mc := createWithOptionalResources("orphans-retain", true)
+
+		disableOptionalResources(mc)
+
+		pdb := &policyv1.PodDisruptionBudget{}
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), pdb)).To(Succeed())
+		Expect(pdb.OwnerReferences).To(BeEmpty())
+		np := &networkingv1.NetworkPolicy{}
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), np)).To(Succeed())
+		Expect(np.OwnerReferences).To(BeEmpty())
+
+		By("reconciling again, which leaves the orphaned resources alone")
+		_, err := reconcileOnce(mc)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), pdb)).To(Succeed())
+		Expect(pdb.OwnerReferences).To(BeEmpty())
+	})
+})
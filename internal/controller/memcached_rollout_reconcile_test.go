@@ -0,0 +1,63 @@
+package controller_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/c5c3/memcached-operator/internal/controller"
+)
+
+var _ = Describe("Suspended rollouts", func() {
+
+	It("should stage template changes on a paused Deployment until the rollout is resumed", func() {
+		mc := validMemcached(uniqueName("suspend"))
+		mc.Spec.Image = strPtr("memcached:1.6.33")
+		mc.Spec.SuspendRollout = true
+		Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+		_, err := reconcileOnce(mc)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), mc)).To(Succeed())
+		cond := findCondition(mc.Status.Conditions, controller.ConditionTypeRolloutSuspended)
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+		Expect(cond.Reason).To(Equal(controller.ConditionReasonSuspendRollout))
+		Expect(mc.Status.Phase).To(Equal(controller.PhasePaused))
+
+		dep := fetchDeployment(mc)
+		Expect(dep.Spec.Paused).To(BeTrue())
+
+		By("changing the image while suspended")
+		mc.Spec.Image = strPtr("memcached:1.6.34")
+		Expect(k8sClient.Update(ctx, mc)).To(Succeed())
+
+		_, err = reconcileOnce(mc)
+		Expect(err).NotTo(HaveOccurred())
+
+		// The template change is staged on the Deployment, but the Deployment stays
+		// paused so the Deployment controller does not roll it out.
+		dep = fetchDeployment(mc)
+		Expect(dep.Spec.Paused).To(BeTrue())
+		Expect(dep.Spec.Template.Spec.Containers[0].Image).To(Equal("memcached:1.6.34"))
+
+		By("resuming the rollout")
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), mc)).To(Succeed())
+		mc.Spec.SuspendRollout = false
+		Expect(k8sClient.Update(ctx, mc)).To(Succeed())
+
+		_, err = reconcileOnce(mc)
+		Expect(err).NotTo(HaveOccurred())
+
+		dep = fetchDeployment(mc)
+		Expect(dep.Spec.Paused).To(BeFalse())
+		Expect(dep.Spec.Template.Spec.Containers[0].Image).To(Equal("memcached:1.6.34"))
+
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), mc)).To(Succeed())
+		Expect(findCondition(mc.Status.Conditions, controller.ConditionTypeRolloutSuspended)).To(BeNil())
+		Expect(mc.Status.Phase).NotTo(Equal(controller.PhasePaused))
+	})
+})
@@ -5,6 +5,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -123,6 +124,38 @@ var _ = Describe("Security Context Reconciliation", func() {
 			Expect(*containerSC.ReadOnlyRootFilesystem).To(BeTrue())
 		})
 
+		It("should mount a size-limited memory-backed /tmp with a read-only root filesystem", func() {
+			mc := validMemcached(uniqueName("sec-tmpfs"))
+			readOnly := true
+			sizeLimit := resource.MustParse("16Mi")
+			mc.Spec.Memcached = &memcachedv1beta1.MemcachedConfig{TmpfsSizeLimit: &sizeLimit}
+			mc.Spec.Security = &memcachedv1beta1.SecuritySpec{
+				ContainerSecurityContext: &corev1.SecurityContext{
+					ReadOnlyRootFilesystem: &readOnly,
+				},
+			}
+			Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+			_, err := reconcileOnce(mc)
+			Expect(err).NotTo(HaveOccurred())
+
+			dep := fetchDeployment(mc)
+
+			var tmp *corev1.Volume
+			for i := range dep.Spec.Template.Spec.Volumes {
+				if dep.Spec.Template.Spec.Volumes[i].Name == "tmp" {
+					tmp = &dep.Spec.Template.Spec.Volumes[i]
+				}
+			}
+			Expect(tmp).NotTo(BeNil())
+			Expect(tmp.EmptyDir).NotTo(BeNil())
+			Expect(tmp.EmptyDir.Medium).To(Equal(corev1.StorageMediumMemory))
+			Expect(tmp.EmptyDir.SizeLimit).NotTo(BeNil())
+			Expect(tmp.EmptyDir.SizeLimit.Cmp(sizeLimit)).To(Equal(0))
+			Expect(dep.Spec.Template.Spec.Containers[0].VolumeMounts).To(ContainElement(
+				corev1.VolumeMount{Name: "tmp", MountPath: "/tmp"}))
+		})
+
 		It("should have nil security contexts when security is nil", func() {
 			mc := validMemcached(uniqueName("sec-nil"))
 			Expect(k8sClient.Create(ctx, mc)).To(Succeed())
@@ -8,6 +8,8 @@ import (
 	. "github.com/onsi/gomega"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -130,6 +132,122 @@ var _ = Describe("Service Reconciliation", func() {
 		})
 	})
 
+	Context("traffic distribution", func() {
+		It("should round-trip spec.service.trafficDistribution without applying it to the headless Service", func() {
+			mc := validMemcached(uniqueName("svc-td"))
+			mc.Spec.Service = &memcachedv1beta1.ServiceSpec{
+				TrafficDistribution: strPtr(corev1.ServiceTrafficDistributionPreferClose),
+			}
+			Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+			fetched := &memcachedv1beta1.Memcached{}
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), fetched)).To(Succeed())
+			Expect(fetched.Spec.Service.TrafficDistribution).To(HaveValue(Equal("PreferClose")))
+
+			_, err := reconcileOnce(mc)
+			Expect(err).NotTo(HaveOccurred())
+
+			svc := fetchService(mc)
+			Expect(svc.Spec.ClusterIP).To(Equal(corev1.ClusterIPNone))
+			Expect(svc.Spec.TrafficDistribution).To(BeNil())
+		})
+
+		It("should reject an unknown trafficDistribution value", func() {
+			mc := validMemcached(uniqueName("svc-td-bad"))
+			mc.Spec.Service = &memcachedv1beta1.ServiceSpec{
+				TrafficDistribution: strPtr("Anywhere"),
+			}
+			Expect(k8sClient.Create(ctx, mc)).NotTo(Succeed())
+		})
+	})
+
+	Context("topology-aware hints", func() {
+		It("should annotate the Service when spec.service.topologyAwareHints is set", func() {
+			mc := validMemcached(uniqueName("svc-hints"))
+			mc.Spec.Service = &memcachedv1beta1.ServiceSpec{TopologyAwareHints: true}
+			Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+			_, err := reconcileOnce(mc)
+			Expect(err).NotTo(HaveOccurred())
+
+			svc := fetchService(mc)
+			Expect(svc.Annotations).To(HaveKeyWithValue("service.kubernetes.io/topology-aware-hints", "auto"))
+			Expect(svc.Annotations).To(HaveKeyWithValue("service.kubernetes.io/topology-mode", "Auto"))
+
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), mc)).To(Succeed())
+			mc.Spec.Service.TopologyAwareHints = false
+			Expect(k8sClient.Update(ctx, mc)).To(Succeed())
+			_, err = reconcileOnce(mc)
+			Expect(err).NotTo(HaveOccurred())
+
+			svc = fetchService(mc)
+			Expect(svc.Annotations).NotTo(HaveKey("service.kubernetes.io/topology-aware-hints"))
+			Expect(svc.Annotations).NotTo(HaveKey("service.kubernetes.io/topology-mode"))
+		})
+	})
+
+	Context("service type", func() {
+		It("should recreate the Service when switching between Headless and ClusterIP", func() {
+			mc := validMemcached(uniqueName("svc-type"))
+			Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+			_, err := reconcileOnce(mc)
+			Expect(err).NotTo(HaveOccurred())
+			headless := fetchService(mc)
+			Expect(headless.Spec.ClusterIP).To(Equal(corev1.ClusterIPNone))
+
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), mc)).To(Succeed())
+			mc.Spec.Service = &memcachedv1beta1.ServiceSpec{Type: strPtr(memcachedv1beta1.ServiceTypeClusterIP)}
+			Expect(k8sClient.Update(ctx, mc)).To(Succeed())
+			_, err = reconcileOnce(mc)
+			Expect(err).NotTo(HaveOccurred())
+
+			clusterIP := fetchService(mc)
+			Expect(clusterIP.UID).NotTo(Equal(headless.UID))
+			Expect(clusterIP.Spec.ClusterIP).NotTo(BeEmpty())
+			Expect(clusterIP.Spec.ClusterIP).NotTo(Equal(corev1.ClusterIPNone))
+
+			// Reconciling again keeps the ClusterIP Service and its allocated IP.
+			_, err = reconcileOnce(mc)
+			Expect(err).NotTo(HaveOccurred())
+			unchanged := fetchService(mc)
+			Expect(unchanged.UID).To(Equal(clusterIP.UID))
+			Expect(unchanged.Spec.ClusterIP).To(Equal(clusterIP.Spec.ClusterIP))
+
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), mc)).To(Succeed())
+			mc.Spec.Service.Type = strPtr(memcachedv1beta1.ServiceTypeHeadless)
+			Expect(k8sClient.Update(ctx, mc)).To(Succeed())
+			_, err = reconcileOnce(mc)
+			Expect(err).NotTo(HaveOccurred())
+
+			recreated := fetchService(mc)
+			Expect(recreated.UID).NotTo(Equal(clusterIP.UID))
+			Expect(recreated.Spec.ClusterIP).To(Equal(corev1.ClusterIPNone))
+		})
+
+		It("should apply trafficDistribution to a ClusterIP Service", func() {
+			mc := validMemcached(uniqueName("svc-type-td"))
+			mc.Spec.Service = &memcachedv1beta1.ServiceSpec{
+				Type:                strPtr(memcachedv1beta1.ServiceTypeClusterIP),
+				TrafficDistribution: strPtr(corev1.ServiceTrafficDistributionPreferClose),
+			}
+			Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+			_, err := reconcileOnce(mc)
+			Expect(err).NotTo(HaveOccurred())
+
+			svc := fetchService(mc)
+			Expect(svc.Spec.ClusterIP).NotTo(Equal(corev1.ClusterIPNone))
+			Expect(svc.Spec.TrafficDistribution).To(HaveValue(Equal("PreferClose")))
+		})
+
+		It("should reject an unknown type", func() {
+			mc := validMemcached(uniqueName("svc-type-bad"))
+			mc.Spec.Service = &memcachedv1beta1.ServiceSpec{Type: strPtr("NodePort")}
+			Expect(k8sClient.Create(ctx, mc)).NotTo(Succeed())
+		})
+	})
+
 	Context("idempotency and drift detection", func() {
 		It("should be idempotent when reconciling without changes", func() {
 			mc := validMemcached(uniqueName("svc-idempotent"))
@@ -178,4 +296,70 @@ var _ = Describe("Service Reconciliation", func() {
 			Expect(err.Error()).To(ContainSubstring("reconciling Service"))
 		})
 	})
+
+	Context("externally managed Service (spec.service.manage=false)", func() {
+		unmanaged := func(prefix string) *memcachedv1beta1.Memcached {
+			mc := validMemcached(uniqueName(prefix))
+			manage := false
+			mc.Spec.Service = &memcachedv1beta1.ServiceSpec{Manage: &manage}
+			return mc
+		}
+
+		It("should not create a Service and accept a pre-existing one that selects the pods", func() {
+			mc := unmanaged("svc-unmanaged-ok")
+			Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+			shared := &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: mc.Name + "-shared", Namespace: mc.Namespace},
+				Spec: corev1.ServiceSpec{
+					ClusterIP: corev1.ClusterIPNone,
+					Selector: map[string]string{
+						"app.kubernetes.io/name":     "memcached",
+						"app.kubernetes.io/instance": mc.Name,
+					},
+					Ports: []corev1.ServicePort{{Name: "memcached", Port: 11211, TargetPort: intstr.FromInt32(11211)}},
+				},
+			}
+			Expect(k8sClient.Create(ctx, shared)).To(Succeed())
+
+			_, err := reconcileOnce(mc)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), &corev1.Service{})
+			Expect(apierrors.IsNotFound(err)).To(BeTrue(), "operator must not create the Service when manage=false")
+
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), mc)).To(Succeed())
+			cond := findCondition(mc.Status.Conditions, controller.ConditionTypeDegraded)
+			Expect(cond).NotTo(BeNil())
+			Expect(cond.Reason).NotTo(Equal(controller.ConditionReasonServiceMissing))
+		})
+
+		It("should report Degraded with reason ServiceMissing when no Service selects the pods", func() {
+			mc := unmanaged("svc-unmanaged-missing")
+			Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+			// A Service selecting a different instance does not count.
+			other := &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: mc.Name + "-other", Namespace: mc.Namespace},
+				Spec: corev1.ServiceSpec{
+					Selector: map[string]string{"app.kubernetes.io/instance": mc.Name + "-other"},
+					Ports:    []corev1.ServicePort{{Port: 11211}},
+				},
+			}
+			Expect(k8sClient.Create(ctx, other)).To(Succeed())
+
+			_, err := reconcileOnce(mc)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), &corev1.Service{})
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), mc)).To(Succeed())
+			cond := findCondition(mc.Status.Conditions, controller.ConditionTypeDegraded)
+			Expect(cond).NotTo(BeNil())
+			Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+			Expect(cond.Reason).To(Equal(controller.ConditionReasonServiceMissing))
+			Expect(mc.Status.Phase).To(Equal(controller.PhaseDegraded))
+		})
+	})
 })
@@ -48,6 +48,12 @@ var _ = Describe("ServiceMonitor Reconciliation", func() {
 			Expect(sm.Spec.Endpoints[0].ScrapeTimeout).To(Equal(monitoringv1.Duration("10s")))
 		})
 
+		It("should leave honorLabels and honorTimestamps to the Prometheus defaults", func() {
+			sm := fetchServiceMonitor(mc)
+			Expect(sm.Spec.Endpoints[0].HonorLabels).To(BeFalse())
+			Expect(sm.Spec.Endpoints[0].HonorTimestamps).To(BeNil())
+		})
+
 		It("should set standard labels on metadata", func() {
 			sm := fetchServiceMonitor(mc)
 			Expect(sm.Labels).To(HaveKeyWithValue("app.kubernetes.io/name", "memcached"))
@@ -100,6 +106,33 @@ var _ = Describe("ServiceMonitor Reconciliation", func() {
 		})
 	})
 
+	Context("ServiceMonitor with honorLabels and honorTimestamps", func() {
+		It("should set both on the endpoint and round-trip them through the API", func() {
+			honorLabels, honorTimestamps := true, false
+			mc := validMemcached(uniqueName("sm-honor"))
+			mc.Spec.Monitoring = &memcachedv1beta1.MonitoringSpec{
+				Enabled: true,
+				ServiceMonitor: &memcachedv1beta1.ServiceMonitorSpec{
+					HonorLabels:     &honorLabels,
+					HonorTimestamps: &honorTimestamps,
+				},
+			}
+			Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+			fetched := &memcachedv1beta1.Memcached{}
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), fetched)).To(Succeed())
+			Expect(fetched.Spec.Monitoring.ServiceMonitor.HonorLabels).To(HaveValue(BeTrue()))
+			Expect(fetched.Spec.Monitoring.ServiceMonitor.HonorTimestamps).To(HaveValue(BeFalse()))
+
+			_, err := reconcileOnce(mc)
+			Expect(err).NotTo(HaveOccurred())
+
+			sm := fetchServiceMonitor(mc)
+			Expect(sm.Spec.Endpoints[0].HonorLabels).To(BeTrue())
+			Expect(sm.Spec.Endpoints[0].HonorTimestamps).To(HaveValue(BeFalse()))
+		})
+	})
+
 	Context("ServiceMonitor with custom scrapeTimeout", func() {
 		It("should use custom scrapeTimeout", func() {
 			mc := validMemcached(uniqueName("sm-custto"))
@@ -141,6 +174,28 @@ var _ = Describe("ServiceMonitor Reconciliation", func() {
 		})
 	})
 
+	Context("ServiceMonitor with the stats sidecar enabled", func() {
+		It("should scrape only the exporter, not the JSON stats port", func() {
+			mc := validMemcached(uniqueName("sm-stats"))
+			mc.Spec.Monitoring = &memcachedv1beta1.MonitoringSpec{
+				Enabled:        true,
+				ServiceMonitor: &memcachedv1beta1.ServiceMonitorSpec{},
+			}
+			mc.Spec.StatsSidecar = &memcachedv1beta1.StatsSidecarSpec{
+				Enabled: true,
+				Image:   strPtr("example.com/memcached-stats:1.0"),
+			}
+			Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+			_, err := reconcileOnce(mc)
+			Expect(err).NotTo(HaveOccurred())
+
+			sm := fetchServiceMonitor(mc)
+			Expect(sm.Spec.Endpoints).To(HaveLen(1))
+			Expect(sm.Spec.Endpoints[0].Port).To(Equal("metrics"))
+		})
+	})
+
 	Context("ServiceMonitor with additional labels", func() {
 		It("should include additional labels on metadata but not on selector", func() {
 			mc := validMemcached(uniqueName("sm-addlbl"))
@@ -9,6 +9,7 @@ import (
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -58,6 +59,10 @@ var _ = Describe("Status Reconciliation", func() {
 			Expect(mc.Status.ReadyReplicas).To(Equal(int32(0)))
 		})
 
+		It("should set phase Pending while the rollout has no ready replicas", func() {
+			Expect(mc.Status.Phase).To(Equal(controller.PhasePending))
+		})
+
 		It("should set Available=False since no replicas are ready (REQ-003)", func() {
 			cond := findCondition(mc.Status.Conditions, "Available")
 			Expect(cond).NotTo(BeNil())
@@ -157,6 +162,53 @@ var _ = Describe("Status Reconciliation", func() {
 		})
 	})
 
+	Context("stale conditions are pruned across spec changes", func() {
+		It("should leave no condition with an older observedGeneration after two spec changes", func() {
+			mc := validMemcached(uniqueName("status-prune"))
+			mc.Spec.Maintenance = &memcachedv1beta1.MaintenanceSpec{ReadOnly: true}
+			Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+			_, err := reconcileOnce(mc)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), mc)).To(Succeed())
+			Expect(findCondition(mc.Status.Conditions, controller.ConditionTypeMaintenance)).NotTo(BeNil())
+
+			// A condition type the operator no longer writes, e.g. left by an older release.
+			mc.Status.Conditions = append(mc.Status.Conditions, metav1.Condition{
+				Type:               "Legacy",
+				Status:             metav1.ConditionTrue,
+				Reason:             "Legacy",
+				Message:            "left over from an earlier generation",
+				ObservedGeneration: mc.Generation,
+				LastTransitionTime: metav1.Now(),
+			})
+			Expect(k8sClient.Status().Update(ctx, mc)).To(Succeed())
+
+			// First spec change: leave maintenance mode.
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), mc)).To(Succeed())
+			mc.Spec.Maintenance = nil
+			Expect(k8sClient.Update(ctx, mc)).To(Succeed())
+			_, err = reconcileOnce(mc)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Second spec change: scale up.
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), mc)).To(Succeed())
+			mc.Spec.Replicas = int32Ptr(2)
+			Expect(k8sClient.Update(ctx, mc)).To(Succeed())
+			_, err = reconcileOnce(mc)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), mc)).To(Succeed())
+			Expect(mc.Status.Conditions).To(HaveLen(4))
+			Expect(findCondition(mc.Status.Conditions, controller.ConditionTypeMaintenance)).To(BeNil())
+			Expect(findCondition(mc.Status.Conditions, "Legacy")).To(BeNil())
+			for _, c := range mc.Status.Conditions {
+				Expect(c.ObservedGeneration).To(Equal(mc.Generation), "condition %s", c.Type)
+			}
+		})
+	})
+
 	Context("scaled to zero replicas (REQ-003, REQ-005)", func() {
 		It("should set Available=True, Progressing=False, Degraded=False with 0 replicas", func() {
 			mc := validMemcached(uniqueName("status-zero"))
@@ -186,6 +238,68 @@ var _ = Describe("Status Reconciliation", func() {
 			Expect(ready.Status).To(Equal(metav1.ConditionFalse))
 
 			Expect(mc.Status.ServerList).To(BeNil(), "serverList should be nil when Ready=False (MO-0056, REQ-004)")
+			Expect(mc.Status.Phase).To(Equal(controller.PhaseRunning))
+		})
+	})
+
+	Context("status.phase for a completed rollout without ready replicas", func() {
+		It("should set phase Degraded once all replicas are updated but none are ready", func() {
+			mc := validMemcached(uniqueName("status-phase-deg"))
+			Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+			_, err := reconcileOnce(mc)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Simulate the Deployment controller: rollout done, pod not ready.
+			dep := fetchDeployment(mc)
+			dep.Status.Replicas = 1
+			dep.Status.UpdatedReplicas = 1
+			dep.Status.ReadyReplicas = 0
+			Expect(k8sClient.Status().Update(ctx, dep)).To(Succeed())
+
+			_, err = reconcileOnce(mc)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), mc)).To(Succeed())
+			Expect(mc.Status.Phase).To(Equal(controller.PhaseDegraded))
+		})
+	})
+
+	Context("status.currentImage reflects the deployed image", func() {
+		It("should report the operator default image when spec.image is unset", func() {
+			mc := validMemcached(uniqueName("status-image-default"))
+			Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+			_, err := reconcileOnce(mc)
+			Expect(err).NotTo(HaveOccurred())
+
+			dep := fetchDeployment(mc)
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), mc)).To(Succeed())
+			Expect(mc.Status.CurrentImage).To(Equal(memcachedv1beta1.DefaultImage))
+			Expect(mc.Status.CurrentImage).To(Equal(dep.Spec.Template.Spec.Containers[0].Image))
+		})
+
+		It("should follow spec.image when it is set and changed", func() {
+			mc := validMemcached(uniqueName("status-image-custom"))
+			mc.Spec.Image = strPtr("memcached:1.6.28")
+			Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+			_, err := reconcileOnce(mc)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), mc)).To(Succeed())
+			Expect(mc.Status.CurrentImage).To(Equal("memcached:1.6.28"))
+
+			mc.Spec.Image = strPtr("memcached:1.6.29")
+			Expect(k8sClient.Update(ctx, mc)).To(Succeed())
+
+			_, err = reconcileOnce(mc)
+			Expect(err).NotTo(HaveOccurred())
+
+			dep := fetchDeployment(mc)
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), mc)).To(Succeed())
+			Expect(mc.Status.CurrentImage).To(Equal("memcached:1.6.29"))
+			Expect(mc.Status.CurrentImage).To(Equal(dep.Spec.Template.Spec.Containers[0].Image))
 		})
 	})
 
@@ -330,4 +444,79 @@ var _ = Describe("Status Reconciliation", func() {
 			Expect(mc.Status.ServerList).To(BeNil())
 		})
 	})
+
+	Context("recentActions timeline", func() {
+		It("should record a replica change and keep only the last 5 actions", func() {
+			mc := validMemcached(uniqueName("status-actions"))
+			mc.Spec.Replicas = int32Ptr(1)
+			Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+			_, err := reconcileOnce(mc)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), mc)).To(Succeed())
+			Expect(mc.Status.RecentActions).NotTo(BeEmpty())
+			Expect(mc.Status.RecentActions[0].Action).To(Equal(controller.ActionCreated))
+			generation := mc.Generation
+
+			mc.Spec.Replicas = int32Ptr(2)
+			Expect(k8sClient.Update(ctx, mc)).To(Succeed())
+			_, err = reconcileOnce(mc)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), mc)).To(Succeed())
+			last := mc.Status.RecentActions[len(mc.Status.RecentActions)-1]
+			Expect(last.Action).To(Equal(controller.ActionUpdated))
+			Expect(last.Message).To(Equal(fmt.Sprintf("Updated Deployment %s", mc.Name)))
+			Expect(last.Time.IsZero()).To(BeFalse())
+			Expect(mc.Generation).To(Equal(generation + 1))
+
+			for replicas := int32(3); replicas <= 8; replicas++ {
+				Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), mc)).To(Succeed())
+				mc.Spec.Replicas = int32Ptr(replicas)
+				Expect(k8sClient.Update(ctx, mc)).To(Succeed())
+				_, err = reconcileOnce(mc)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), mc)).To(Succeed())
+			Expect(mc.Status.RecentActions).To(HaveLen(5))
+			for _, a := range mc.Status.RecentActions {
+				Expect(a.Message).To(Equal(fmt.Sprintf("Updated Deployment %s", mc.Name)))
+			}
+			// Status writes never bump the generation; only the spec updates did.
+			Expect(mc.Generation).To(Equal(generation + 7))
+		})
+	})
+
+	Context("recommendations", func() {
+		It("should recommend a larger maxMemoryMB without changing the Deployment", func() {
+			mc := validMemcached(uniqueName("status-recs"))
+			mc.Spec.Memcached = &memcachedv1beta1.MemcachedConfig{Threads: 1, MaxMemoryMB: 64}
+			mc.Spec.Resources = &corev1.ResourceRequirements{
+				Limits: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("1"),
+					corev1.ResourceMemory: resource.MustParse("1Gi"),
+				},
+			}
+			Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+			_, err := reconcileOnce(mc)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), mc)).To(Succeed())
+			Expect(mc.Status.Recommendations).To(ConsistOf(
+				"maxMemoryMB=64 uses little of the 1Gi memory limit; it could be raised to 819 (80% of the limit)"))
+
+			dep := fetchDeployment(mc)
+			Expect(dep.Spec.Template.Spec.Containers[0].Args).To(ContainElements("-m", "64"))
+
+			mc.Spec.Memcached.MaxMemoryMB = 800
+			Expect(k8sClient.Update(ctx, mc)).To(Succeed())
+			_, err = reconcileOnce(mc)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), mc)).To(Succeed())
+			Expect(mc.Status.Recommendations).To(BeEmpty())
+		})
+	})
 })
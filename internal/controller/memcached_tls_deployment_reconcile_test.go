@@ -4,7 +4,9 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -98,6 +100,7 @@ var _ = Describe("TLS Deployment Reconciliation", func() {
 
 			// ssl_ca_cert arg present.
 			Expect(container.Args).To(ContainElement("ssl_ca_cert=/etc/memcached/tls/ca.crt"))
+			Expect(container.Args).To(ContainElement("ssl_verify_mode=2"))
 
 			// Volume should include ca.crt item.
 			var tlsVol *corev1.Volume
@@ -357,4 +360,127 @@ var _ = Describe("TLS Deployment Reconciliation", func() {
 			Expect(tlsVol.Secret.SecretName).To(Equal("tls-secret-v2"))
 		})
 	})
+
+	Context("client CA availability", func() {
+		mtlsMemcached := func(prefix, secretName string) *memcachedv1beta1.Memcached {
+			mc := validMemcached(uniqueName(prefix))
+			mc.Spec.Security = &memcachedv1beta1.SecuritySpec{
+				TLS: &memcachedv1beta1.TLSSpec{
+					Enabled:              true,
+					EnableClientCert:     true,
+					CertificateSecretRef: corev1.LocalObjectReference{Name: secretName},
+				},
+			}
+			return mc
+		}
+		createSecret := func(name, namespace string, withCA bool) *corev1.Secret {
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+				Data: map[string][]byte{
+					"tls.crt": []byte("cert"),
+					"tls.key": []byte("key"),
+				},
+			}
+			if withCA {
+				secret.Data["ca.crt"] = []byte("ca")
+			}
+			Expect(k8sClient.Create(ctx, secret)).To(Succeed())
+			return secret
+		}
+		hasCAItem := func(dep *appsv1.Deployment) bool {
+			for _, v := range dep.Spec.Template.Spec.Volumes {
+				if v.Name == testTLSVolumeName && v.Secret != nil {
+					for _, item := range v.Secret.Items {
+						if item.Key == "ca.crt" {
+							return true
+						}
+					}
+				}
+			}
+			return false
+		}
+
+		It("should use ca.crt from the certificate Secret when present", func() {
+			secretName := uniqueName("mtls-ca")
+			mc := mtlsMemcached("mtls-ca-present", secretName)
+			createSecret(secretName, mc.Namespace, true)
+			Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+			_, err := reconcileOnce(mc)
+			Expect(err).NotTo(HaveOccurred())
+
+			dep := fetchDeployment(mc)
+			Expect(dep.Spec.Template.Spec.Containers[0].Args).To(ContainElement("ssl_ca_cert=/etc/memcached/tls/ca.crt"))
+			Expect(dep.Spec.Template.Spec.Containers[0].Args).To(ContainElement("ssl_verify_mode=2"))
+			Expect(hasCAItem(dep)).To(BeTrue())
+
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), mc)).To(Succeed())
+			degraded := findCondition(mc.Status.Conditions, "Degraded")
+			Expect(degraded).NotTo(BeNil())
+			Expect(degraded.Reason).NotTo(Equal("ClientCAUnavailable"))
+		})
+
+		It("should report ClientCAUnavailable and drop ssl_ca_cert when ca.crt is absent, and recover once it is added", func() {
+			secretName := uniqueName("mtls-noca")
+			mc := mtlsMemcached("mtls-ca-absent", secretName)
+			secret := createSecret(secretName, mc.Namespace, false)
+			Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+			_, err := reconcileOnce(mc)
+			Expect(err).NotTo(HaveOccurred())
+
+			dep := fetchDeployment(mc)
+			for _, arg := range dep.Spec.Template.Spec.Containers[0].Args {
+				Expect(arg).NotTo(ContainSubstring("ssl_ca_cert"))
+			}
+			// Fails closed: client certificates are still required.
+			Expect(dep.Spec.Template.Spec.Containers[0].Args).To(ContainElement("ssl_verify_mode=2"))
+			Expect(hasCAItem(dep)).To(BeFalse())
+
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), mc)).To(Succeed())
+			degraded := findCondition(mc.Status.Conditions, "Degraded")
+			Expect(degraded).NotTo(BeNil())
+			Expect(degraded.Status).To(Equal(metav1.ConditionTrue))
+			Expect(degraded.Reason).To(Equal("ClientCAUnavailable"))
+			Expect(mc.Status.Phase).To(Equal("Degraded"))
+
+			secret.Data["ca.crt"] = []byte("ca")
+			Expect(k8sClient.Update(ctx, secret)).To(Succeed())
+			_, err = reconcileOnce(mc)
+			Expect(err).NotTo(HaveOccurred())
+
+			dep = fetchDeployment(mc)
+			Expect(dep.Spec.Template.Spec.Containers[0].Args).To(ContainElement("ssl_ca_cert=/etc/memcached/tls/ca.crt"))
+			Expect(hasCAItem(dep)).To(BeTrue())
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), mc)).To(Succeed())
+			Expect(findCondition(mc.Status.Conditions, "Degraded").Reason).NotTo(Equal("ClientCAUnavailable"))
+		})
+
+		It("should report SecretNotFound rather than ClientCAUnavailable when the Secret is missing", func() {
+			mc := mtlsMemcached("mtls-ca-nosecret", uniqueName("mtls-missing"))
+			Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+			_, err := reconcileOnce(mc)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), mc)).To(Succeed())
+			degraded := findCondition(mc.Status.Conditions, "Degraded")
+			Expect(degraded).NotTo(BeNil())
+			Expect(degraded.Reason).To(Equal("SecretNotFound"))
+		})
+
+		It("should not check for a client CA when enableClientCert is false", func() {
+			secretName := uniqueName("tls-noca")
+			mc := mtlsMemcached("mtls-ca-off", secretName)
+			mc.Spec.Security.TLS.EnableClientCert = false
+			createSecret(secretName, mc.Namespace, false)
+			Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+			_, err := reconcileOnce(mc)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), mc)).To(Succeed())
+			Expect(findCondition(mc.Status.Conditions, "Degraded").Reason).NotTo(Equal("ClientCAUnavailable"))
+		})
+	})
 })
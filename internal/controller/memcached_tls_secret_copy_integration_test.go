@@ -0,0 +1,147 @@
+package controller_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	memcachedv1beta1 "github.com/c5c3/memcached-operator/api/v1beta1"
+	"github.com/c5c3/memcached-operator/internal/controller"
+)
+
+var _ = Describe("TLS Secret copy from another namespace", func() {
+
+	var sourceNamespace string
+
+	BeforeEach(func() {
+		sourceNamespace = uniqueName("certs")
+		Expect(k8sClient.Create(ctx, &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{Name: sourceNamespace},
+		})).To(Succeed())
+	})
+
+	// reconcileCopy reconciles mc with an operator that allows copying from sourceNamespace.
+	reconcileCopy := func(mc *memcachedv1beta1.Memcached) (ctrl.Result, error) {
+		r := &controller.MemcachedReconciler{
+			Client:                  k8sClient,
+			Scheme:                  scheme.Scheme,
+			TLSCopySourceNamespaces: []string{sourceNamespace},
+		}
+		return r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(mc)})
+	}
+
+	newSourceSecret := func(name, cert string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Namespace:   sourceNamespace,
+				Annotations: map[string]string{controller.AnnotationCopyAllowedTo: "default"},
+			},
+			Type: corev1.SecretTypeTLS,
+			Data: map[string][]byte{
+				"tls.crt": []byte(cert),
+				"tls.key": []byte("key-data"),
+			},
+		}
+	}
+
+	fetchCopy := func(mc *memcachedv1beta1.Memcached, name string) *corev1.Secret {
+		secret := &corev1.Secret{}
+		Expect(k8sClient.Get(ctx, client.ObjectKey{Name: name, Namespace: mc.Namespace}, secret)).To(Succeed())
+		return secret
+	}
+
+	It("should copy the source Secret into the Memcached namespace owned by the CR", func() {
+		secretName := uniqueName("tls-copy")
+		Expect(k8sClient.Create(ctx, newSourceSecret(secretName, "cert-v1"))).To(Succeed())
+
+		mc := validMemcached(uniqueName("tls-copy"))
+		tls := tlsSpec(secretName)
+		tls.CopyFromNamespace = &sourceNamespace
+		mc.Spec.Security = &memcachedv1beta1.SecuritySpec{TLS: tls}
+		Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+		_, err := reconcileCopy(mc)
+		Expect(err).NotTo(HaveOccurred())
+
+		copied := fetchCopy(mc, secretName)
+		Expect(copied.Type).To(Equal(corev1.SecretTypeTLS))
+		Expect(copied.Data).To(HaveKeyWithValue("tls.crt", []byte("cert-v1")))
+
+		// The copy is controlled by the CR, so it is garbage-collected on deletion.
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), mc)).To(Succeed())
+		Expect(metav1.IsControlledBy(copied, mc)).To(BeTrue())
+
+		dep := fetchDeployment(mc)
+		Expect(dep.Spec.Template.Annotations).To(HaveKey(controller.AnnotationSecretHash))
+	})
+
+	It("should sync the copy and roll the Deployment when the source changes", func() {
+		secretName := uniqueName("tls-sync")
+		source := newSourceSecret(secretName, "cert-v1")
+		Expect(k8sClient.Create(ctx, source)).To(Succeed())
+
+		mc := validMemcached(uniqueName("tls-sync"))
+		tls := tlsSpec(secretName)
+		tls.CopyFromNamespace = &sourceNamespace
+		mc.Spec.Security = &memcachedv1beta1.SecuritySpec{TLS: tls}
+		Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+		_, err := reconcileCopy(mc)
+		Expect(err).NotTo(HaveOccurred())
+		hashBefore := fetchDeployment(mc).Spec.Template.Annotations[controller.AnnotationSecretHash]
+
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(source), source)).To(Succeed())
+		source.Data["tls.crt"] = []byte("cert-v2")
+		Expect(k8sClient.Update(ctx, source)).To(Succeed())
+
+		_, err = reconcileCopy(mc)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fetchCopy(mc, secretName).Data).To(HaveKeyWithValue("tls.crt", []byte("cert-v2")))
+		hashAfter := fetchDeployment(mc).Spec.Template.Annotations[controller.AnnotationSecretHash]
+		Expect(hashAfter).NotTo(Equal(hashBefore))
+	})
+
+	It("should refuse to copy from a namespace the operator does not allow", func() {
+		secretName := uniqueName("tls-denied")
+		Expect(k8sClient.Create(ctx, newSourceSecret(secretName, "cert-v1"))).To(Succeed())
+
+		mc := validMemcached(uniqueName("tls-denied"))
+		tls := tlsSpec(secretName)
+		tls.CopyFromNamespace = &sourceNamespace
+		mc.Spec.Security = &memcachedv1beta1.SecuritySpec{TLS: tls}
+		Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+		_, err := reconcileOnce(mc)
+		Expect(err).To(MatchError(ContainSubstring("--tls-copy-source-namespaces")))
+
+		err = k8sClient.Get(ctx, client.ObjectKey{Name: secretName, Namespace: mc.Namespace}, &corev1.Secret{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue(), "expected no copy, got err=%v", err)
+	})
+
+	It("should report the missing source Secret in the Degraded condition", func() {
+		secretName := uniqueName("tls-missing")
+
+		mc := validMemcached(uniqueName("tls-missing"))
+		tls := tlsSpec(secretName)
+		tls.CopyFromNamespace = &sourceNamespace
+		mc.Spec.Security = &memcachedv1beta1.SecuritySpec{TLS: tls}
+		Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+		_, err := reconcileCopy(mc)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), mc)).To(Succeed())
+		cond := findCondition(mc.Status.Conditions, controller.ConditionTypeDegraded)
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Reason).To(Equal(controller.ConditionReasonSecretNotFound))
+		Expect(cond.Message).To(ContainSubstring(sourceNamespace + "/" + secretName))
+	})
+})
@@ -45,6 +45,28 @@ var _ = Describe("TLS Service Reconciliation", func() {
 			Expect(svc.Spec.Ports[1].Protocol).To(Equal(corev1.ProtocolTCP))
 		})
 
+		It("should publish a custom TLS port on the Service", func() {
+			mc := validMemcached(uniqueName("tls-svc-port"))
+			port := int32(12345)
+			mc.Spec.Security = &memcachedv1beta1.SecuritySpec{
+				TLS: &memcachedv1beta1.TLSSpec{
+					Enabled:              true,
+					CertificateSecretRef: corev1.LocalObjectReference{Name: "tls-secret"},
+					Port:                 &port,
+				},
+			}
+			Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+
+			_, err := reconcileOnce(mc)
+			Expect(err).NotTo(HaveOccurred())
+
+			svc := fetchService(mc)
+			Expect(svc.Spec.Ports).To(HaveLen(2))
+			Expect(svc.Spec.Ports[1].Name).To(Equal("memcached-tls"))
+			Expect(svc.Spec.Ports[1].Port).To(Equal(int32(12345)))
+			Expect(svc.Spec.Ports[1].TargetPort).To(Equal(intstr.FromString("memcached-tls")))
+		})
+
 		It("should not have TLS port when TLS is not configured", func() {
 			mc := validMemcached(uniqueName("tls-svc-off"))
 			Expect(k8sClient.Create(ctx, mc)).To(Succeed())
@@ -70,6 +92,7 @@ var _ = Describe("TLS Service Reconciliation", func() {
 
 			svc := fetchService(mc)
 			Expect(svc.Spec.Ports).To(HaveLen(1))
+			originalUID := svc.UID
 
 			// Enable TLS.
 			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), mc)).To(Succeed())
@@ -88,6 +111,7 @@ var _ = Describe("TLS Service Reconciliation", func() {
 			Expect(svc.Spec.Ports).To(HaveLen(2))
 			Expect(svc.Spec.Ports[1].Name).To(Equal("memcached-tls"))
 			Expect(svc.Spec.Ports[1].Port).To(Equal(int32(11212)))
+			Expect(svc.UID).To(Equal(originalUID), "Service should be updated in place")
 
 			// Disable TLS.
 			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mc), mc)).To(Succeed())
@@ -100,6 +124,7 @@ var _ = Describe("TLS Service Reconciliation", func() {
 			svc = fetchService(mc)
 			Expect(svc.Spec.Ports).To(HaveLen(1))
 			Expect(svc.Spec.Ports[0].Name).To(Equal("memcached"))
+			Expect(svc.UID).To(Equal(originalUID), "Service should be updated in place")
 		})
 	})
 
@@ -0,0 +1,54 @@
+// Package controller implements the reconciliation logic for the memcached-operator.
+package controller
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	memcachedv1beta1 "github.com/c5c3/memcached-operator/api/v1beta1"
+)
+
+// namespaceSelected reports whether the namespace with the given name matches
+// the reconciler's NamespaceSelector. A nil selector selects every namespace.
+func (r *MemcachedReconciler) namespaceSelected(ctx context.Context, name string) (bool, error) {
+	if r.NamespaceSelector == nil {
+		return true, nil
+	}
+
+	ns := &corev1.Namespace{}
+	if err := r.Get(ctx, types.NamespacedName{Name: name}, ns); err != nil {
+		return false, client.IgnoreNotFound(err)
+	}
+
+	return r.NamespaceSelector.Matches(labels.Set(ns.Labels)), nil
+}
+
+// mapNamespaceToMemcached returns a handler.MapFunc that enqueues every Memcached
+// CR in a Namespace when that Namespace changes. This lets instances be picked up
+// or released as soon as the namespace labels start or stop matching the selector.
+func mapNamespaceToMemcached(c client.Client) handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		var list memcachedv1beta1.MemcachedList
+		if err := c.List(ctx, &list, client.InNamespace(obj.GetName())); err != nil {
+			return nil
+		}
+
+		requests := make([]reconcile.Request, 0, len(list.Items))
+		for i := range list.Items {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      list.Items[i].Name,
+					Namespace: list.Items[i].Namespace,
+				},
+			})
+		}
+
+		return requests
+	}
+}
@@ -0,0 +1,119 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	memcachedv1beta1 "github.com/c5c3/memcached-operator/api/v1beta1"
+)
+
+func TestNamespaceSelected(t *testing.T) {
+	selector := labels.SelectorFromSet(labels.Set{"memcached-operator/enabled": "true"})
+	enabled := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name:   "enabled",
+		Labels: map[string]string{"memcached-operator/enabled": "true"},
+	}}
+	plain := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "plain"}}
+
+	tests := []struct {
+		name      string
+		selector  labels.Selector
+		namespace string
+		want      bool
+	}{
+		{name: "nil selector selects everything", selector: nil, namespace: "plain", want: true},
+		{name: "matching labels", selector: selector, namespace: "enabled", want: true},
+		{name: "non-matching labels", selector: selector, namespace: "plain", want: false},
+		{name: "missing namespace", selector: selector, namespace: "gone", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newTestReconciler(newFakeClient(enabled, plain))
+			r.NamespaceSelector = tt.selector
+
+			got, err := r.namespaceSelected(context.Background(), tt.namespace)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("namespaceSelected(%q) = %v, want %v", tt.namespace, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReconcile_SkipsUnselectedNamespace(t *testing.T) {
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "plain"}}
+	mc := &memcachedv1beta1.Memcached{
+		ObjectMeta: metav1.ObjectMeta{Name: "cache", Namespace: "plain"},
+	}
+	c := newFakeClient(ns, mc)
+	r := newTestReconciler(c)
+	r.NamespaceSelector = labels.SelectorFromSet(labels.Set{"memcached-operator/enabled": "true"})
+
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "cache", Namespace: "plain"}}
+	if _, err := r.Reconcile(context.Background(), req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	dep := &appsv1.Deployment{}
+	err := c.Get(context.Background(), types.NamespacedName{Name: "cache", Namespace: "plain"}, dep)
+	if !apierrors.IsNotFound(err) {
+		t.Errorf("expected no Deployment for unselected namespace, got err=%v", err)
+	}
+}
+
+func TestReconcile_FinalizesInUnselectedNamespace(t *testing.T) {
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "plain"}}
+	now := metav1.Now()
+	mc := &memcachedv1beta1.Memcached{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "cache",
+			Namespace:         "plain",
+			Finalizers:        []string{FinalizerFinalStats},
+			DeletionTimestamp: &now,
+		},
+	}
+	c := newFakeClient(ns, mc)
+	r := newTestReconciler(c)
+	r.NamespaceSelector = labels.SelectorFromSet(labels.Set{"memcached-operator/enabled": "true"})
+
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "cache", Namespace: "plain"}}
+	if _, err := r.Reconcile(context.Background(), req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	err := c.Get(context.Background(), req.NamespacedName, &memcachedv1beta1.Memcached{})
+	if !apierrors.IsNotFound(err) {
+		t.Errorf("expected the Memcached to be deleted once the finalizer is removed, got err=%v", err)
+	}
+}
+
+func TestMapNamespaceToMemcached(t *testing.T) {
+	mc1 := &memcachedv1beta1.Memcached{ObjectMeta: metav1.ObjectMeta{Name: "mc1", Namespace: "team-a"}}
+	mc2 := &memcachedv1beta1.Memcached{ObjectMeta: metav1.ObjectMeta{Name: "mc2", Namespace: "team-a"}}
+	other := &memcachedv1beta1.Memcached{ObjectMeta: metav1.ObjectMeta{Name: "mc3", Namespace: "team-b"}}
+	c := newFakeClient(mc1, mc2, other)
+
+	mapFn := mapNamespaceToMemcached(c)
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}}
+	requests := mapFn(context.Background(), ns)
+
+	if len(requests) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(requests))
+	}
+	for _, req := range requests {
+		if req.Namespace != "team-a" {
+			t.Errorf("unexpected request namespace: %v", req)
+		}
+	}
+}
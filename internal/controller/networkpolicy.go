@@ -15,7 +15,8 @@ import (
 func constructNetworkPolicy(mc *memcachedv1beta1.Memcached, np *networkingv1.NetworkPolicy) {
 	labels := labelsForMemcached(mc.Name)
 
-	np.Labels = labels
+	np.Labels = withPropagatedLabels(mc, labels)
+	np.Annotations = mergePropagatedAnnotations(mc, np.Annotations)
 	np.Spec.PodSelector = metav1.LabelSelector{
 		MatchLabels: labels,
 	}
@@ -33,7 +34,7 @@ func constructNetworkPolicy(mc *memcachedv1beta1.Memcached, np *networkingv1.Net
 	if mc.IsTLSEnabled() {
 		ports = append(ports, networkingv1.NetworkPolicyPort{
 			Protocol: protocolPtr(corev1.ProtocolTCP),
-			Port:     intstrPtr(intstr.FromInt32(PortMemcachedTLS)),
+			Port:     intstrPtr(intstr.FromInt32(mc.TLSPort())),
 		})
 	}
 
@@ -45,6 +46,14 @@ func constructNetworkPolicy(mc *memcachedv1beta1.Memcached, np *networkingv1.Net
 		})
 	}
 
+	// Add stats port when the stats sidecar is enabled.
+	if mc.IsStatsSidecarEnabled() {
+		ports = append(ports, networkingv1.NetworkPolicyPort{
+			Protocol: protocolPtr(corev1.ProtocolTCP),
+			Port:     intstrPtr(intstr.FromInt32(mc.StatsSidecarPort())),
+		})
+	}
+
 	// Build the single ingress rule.
 	ingressRule := networkingv1.NetworkPolicyIngressRule{
 		Ports: ports,
@@ -57,6 +66,46 @@ func constructNetworkPolicy(mc *memcachedv1beta1.Memcached, np *networkingv1.Net
 	}
 
 	np.Spec.Ingress = []networkingv1.NetworkPolicyIngressRule{ingressRule}
+
+	// Add a separate rule admitting the debug namespace to the memcached port.
+	if mc.Spec.Security != nil && mc.Spec.Security.NetworkPolicy != nil &&
+		mc.Spec.Security.NetworkPolicy.AllowDebugNamespace != nil {
+		np.Spec.Ingress = append(np.Spec.Ingress, networkingv1.NetworkPolicyIngressRule{
+			Ports: []networkingv1.NetworkPolicyPort{{
+				Protocol: protocolPtr(corev1.ProtocolTCP),
+				Port:     intstrPtr(intstr.FromInt32(PortMemcached)),
+			}},
+			From: []networkingv1.NetworkPolicyPeer{{
+				NamespaceSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{
+						corev1.LabelMetadataName: *mc.Spec.Security.NetworkPolicy.AllowDebugNamespace,
+					},
+				},
+			}},
+		})
+	}
+}
+
+// defaultDenyNetworkPolicyName returns the name of the companion default-deny
+// NetworkPolicy of mc.
+func defaultDenyNetworkPolicyName(mc *memcachedv1beta1.Memcached) string {
+	return mc.Name + "-default-deny"
+}
+
+// constructDefaultDenyNetworkPolicy sets the desired state of the default-deny
+// NetworkPolicy. It selects the same pods as the allow policy and declares the
+// Ingress policy type without rules, denying all ingress the allow policy does
+// not admit.
+func constructDefaultDenyNetworkPolicy(mc *memcachedv1beta1.Memcached, np *networkingv1.NetworkPolicy) {
+	labels := labelsForMemcached(mc.Name)
+
+	np.Labels = withPropagatedLabels(mc, labels)
+	np.Annotations = mergePropagatedAnnotations(mc, np.Annotations)
+	np.Spec.PodSelector = metav1.LabelSelector{
+		MatchLabels: labels,
+	}
+	np.Spec.PolicyTypes = []networkingv1.PolicyType{networkingv1.PolicyTypeIngress}
+	np.Spec.Ingress = nil
 }
 
 func protocolPtr(p corev1.Protocol) *corev1.Protocol {
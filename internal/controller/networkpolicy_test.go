@@ -287,6 +287,57 @@ func TestConstructNetworkPolicy(t *testing.T) {
 	}
 }
 
+func TestConstructNetworkPolicy_CustomTLSPort(t *testing.T) {
+	mc := &memcachedv1beta1.Memcached{
+		ObjectMeta: metav1.ObjectMeta{Name: "np-tls-port", Namespace: "default"},
+		Spec: memcachedv1beta1.MemcachedSpec{
+			Security: &memcachedv1beta1.SecuritySpec{
+				TLS: &memcachedv1beta1.TLSSpec{
+					Enabled:              true,
+					CertificateSecretRef: corev1.LocalObjectReference{Name: "my-tls-secret"},
+					Port:                 int32Ptr(12345),
+				},
+				NetworkPolicy: &memcachedv1beta1.NetworkPolicySpec{Enabled: true},
+			},
+		},
+	}
+	np := &networkingv1.NetworkPolicy{}
+
+	constructNetworkPolicy(mc, np)
+
+	ports := np.Spec.Ingress[0].Ports
+	if len(ports) != 2 {
+		t.Fatalf("expected 2 ingress ports, got %d", len(ports))
+	}
+	if ports[1].Port.IntValue() != 12345 {
+		t.Errorf("TLS ingress port = %d, want 12345", ports[1].Port.IntValue())
+	}
+}
+
+func TestConstructNetworkPolicy_StatsSidecarPort(t *testing.T) {
+	statsImage := "example.com/memcached-stats:1.0"
+	mc := &memcachedv1beta1.Memcached{
+		ObjectMeta: metav1.ObjectMeta{Name: "np-stats", Namespace: "default"},
+		Spec: memcachedv1beta1.MemcachedSpec{
+			StatsSidecar: &memcachedv1beta1.StatsSidecarSpec{Enabled: true, Image: &statsImage},
+			Security: &memcachedv1beta1.SecuritySpec{
+				NetworkPolicy: &memcachedv1beta1.NetworkPolicySpec{Enabled: true},
+			},
+		},
+	}
+	np := &networkingv1.NetworkPolicy{}
+
+	constructNetworkPolicy(mc, np)
+
+	ports := np.Spec.Ingress[0].Ports
+	if len(ports) != 2 {
+		t.Fatalf("expected 2 ingress ports, got %d", len(ports))
+	}
+	if ports[1].Port.IntValue() != int(memcachedv1beta1.DefaultStatsSidecarPort) {
+		t.Errorf("stats ingress port = %d, want %d", ports[1].Port.IntValue(), memcachedv1beta1.DefaultStatsSidecarPort)
+	}
+}
+
 func TestConstructNetworkPolicy_Labels(t *testing.T) {
 	mc := &memcachedv1beta1.Memcached{
 		ObjectMeta: metav1.ObjectMeta{
@@ -429,6 +480,99 @@ func TestConstructNetworkPolicy_MultiplePeers(t *testing.T) {
 	}
 }
 
+func TestConstructNetworkPolicy_AllowDebugNamespace(t *testing.T) {
+	debugNS := "troubleshooting"
+	mc := &memcachedv1beta1.Memcached{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "debug-ns",
+			Namespace: "default",
+		},
+		Spec: memcachedv1beta1.MemcachedSpec{
+			Security: &memcachedv1beta1.SecuritySpec{
+				TLS: &memcachedv1beta1.TLSSpec{Enabled: true},
+				NetworkPolicy: &memcachedv1beta1.NetworkPolicySpec{
+					Enabled:             true,
+					AllowDebugNamespace: &debugNS,
+				},
+			},
+		},
+	}
+	np := &networkingv1.NetworkPolicy{}
+
+	constructNetworkPolicy(mc, np)
+
+	if len(np.Spec.Ingress) != 2 {
+		t.Fatalf("expected 2 ingress rules, got %d", len(np.Spec.Ingress))
+	}
+	if np.Spec.Ingress[0].From != nil {
+		t.Errorf("first rule from = %v, want nil (allowedSources unset)", np.Spec.Ingress[0].From)
+	}
+
+	rule := np.Spec.Ingress[1]
+	if len(rule.Ports) != 1 || rule.Ports[0].Port.IntValue() != int(PortMemcached) {
+		t.Errorf("debug rule ports = %v, want only %d", rule.Ports, PortMemcached)
+	}
+	if len(rule.From) != 1 || rule.From[0].NamespaceSelector == nil {
+		t.Fatalf("debug rule from = %v, want a single namespaceSelector peer", rule.From)
+	}
+	if got := rule.From[0].NamespaceSelector.MatchLabels[corev1.LabelMetadataName]; got != debugNS {
+		t.Errorf("namespaceSelector matchLabels[%s] = %q, want %q", corev1.LabelMetadataName, got, debugNS)
+	}
+}
+
+func TestConstructDefaultDenyNetworkPolicy(t *testing.T) {
+	mc := &memcachedv1beta1.Memcached{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "deny",
+			Namespace: "default",
+		},
+		Spec: memcachedv1beta1.MemcachedSpec{
+			Security: &memcachedv1beta1.SecuritySpec{
+				NetworkPolicy: &memcachedv1beta1.NetworkPolicySpec{Enabled: true, CreateDefaultDeny: true},
+			},
+		},
+	}
+	np := &networkingv1.NetworkPolicy{}
+
+	constructDefaultDenyNetworkPolicy(mc, np)
+
+	if got := defaultDenyNetworkPolicyName(mc); got != "deny-default-deny" {
+		t.Errorf("name = %q, want %q", got, "deny-default-deny")
+	}
+	if len(np.Spec.PolicyTypes) != 1 || np.Spec.PolicyTypes[0] != networkingv1.PolicyTypeIngress {
+		t.Errorf("policyTypes = %v, want [Ingress]", np.Spec.PolicyTypes)
+	}
+	if np.Spec.Ingress != nil {
+		t.Errorf("ingress = %v, want nil (deny all)", np.Spec.Ingress)
+	}
+	if !reflect.DeepEqual(np.Spec.PodSelector.MatchLabels, labelsForMemcached(mc.Name)) {
+		t.Errorf("podSelector = %v, want %v", np.Spec.PodSelector.MatchLabels, labelsForMemcached(mc.Name))
+	}
+}
+
+func TestIsDefaultDenyNetworkPolicyEnabled(t *testing.T) {
+	tests := []struct {
+		name string
+		np   *memcachedv1beta1.NetworkPolicySpec
+		want bool
+	}{
+		{name: "unset", want: false},
+		{name: "policy disabled", np: &memcachedv1beta1.NetworkPolicySpec{CreateDefaultDeny: true}, want: false},
+		{name: "default deny not requested", np: &memcachedv1beta1.NetworkPolicySpec{Enabled: true}, want: false},
+		{name: "enabled", np: &memcachedv1beta1.NetworkPolicySpec{Enabled: true, CreateDefaultDeny: true}, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &memcachedv1beta1.Memcached{Spec: memcachedv1beta1.MemcachedSpec{
+				Security: &memcachedv1beta1.SecuritySpec{NetworkPolicy: tt.np},
+			}}
+			if got := mc.IsDefaultDenyNetworkPolicyEnabled(); got != tt.want {
+				t.Errorf("IsDefaultDenyNetworkPolicyEnabled() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestConstructNetworkPolicy_Idempotent(t *testing.T) {
 	mc := &memcachedv1beta1.Memcached{
 		ObjectMeta: metav1.ObjectMeta{
@@ -3,21 +3,25 @@ package controller
 
 import (
 	policyv1 "k8s.io/api/policy/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 
 	memcachedv1beta1 "github.com/c5c3/memcached-operator/api/v1beta1"
 )
 
+// defaultSharedNodeMaxUnavailable is the PDB budget applied when neither field is
+// set and the anti-affinity preset lets several pods share a node. It is rounded up
+// by the disruption controller, so small deployments still allow one eviction.
+var defaultSharedNodeMaxUnavailable = intstr.FromString("25%")
+
 // constructPDB sets the desired state of the PodDisruptionBudget based on the Memcached CR spec.
 // It mutates pdb in-place and is designed to be called from within controllerutil.CreateOrUpdate.
 func constructPDB(mc *memcachedv1beta1.Memcached, pdb *policyv1.PodDisruptionBudget) {
 	labels := labelsForMemcached(mc.Name)
 
-	pdb.Labels = labels
-	pdb.Spec.Selector = &metav1.LabelSelector{
-		MatchLabels: labels,
-	}
+	pdb.Labels = withPropagatedLabels(mc, labels)
+	pdb.Annotations = mergePropagatedAnnotations(mc, pdb.Annotations)
+	// Canary pods are excluded, so the budget only counts the regular pods.
+	pdb.Spec.Selector = regularPodSelector(mc.Name)
 
 	pdbSpec := mc.Spec.HighAvailability.PodDisruptionBudget
 
@@ -31,9 +35,22 @@ func constructPDB(mc *memcachedv1beta1.Memcached, pdb *policyv1.PodDisruptionBud
 		pdb.Spec.MaxUnavailable = pdbSpec.MaxUnavailable
 		pdb.Spec.MinAvailable = nil
 	default:
-		// Neither set: default minAvailable to 1.
-		defaultMinAvailable := intstr.FromInt32(1)
-		pdb.Spec.MinAvailable = &defaultMinAvailable
-		pdb.Spec.MaxUnavailable = nil
+		// Neither set: derive maxUnavailable from the anti-affinity domains.
+		defaultMaxUnavailable := defaultPDBMaxUnavailable(mc)
+		pdb.Spec.MaxUnavailable = &defaultMaxUnavailable
+		pdb.Spec.MinAvailable = nil
+	}
+}
+
+// defaultPDBMaxUnavailable returns the maxUnavailable applied when the CR sets
+// neither minAvailable nor maxUnavailable. Hard anti-affinity places each pod on
+// its own node, so a node drain evicts exactly one pod and a budget of 1 fits.
+// With soft or no anti-affinity, several pods may share a node, so the budget
+// scales with the replica count instead.
+func defaultPDBMaxUnavailable(mc *memcachedv1beta1.Memcached) intstr.IntOrString {
+	ha := mc.Spec.HighAvailability
+	if ha != nil && ha.AntiAffinityPreset != nil && *ha.AntiAffinityPreset == memcachedv1beta1.AntiAffinityPresetHard {
+		return intstr.FromInt32(1)
 	}
+	return defaultSharedNodeMaxUnavailable
 }
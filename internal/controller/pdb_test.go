@@ -21,10 +21,10 @@ func TestConstructPDB(t *testing.T) {
 		wantMaxUnavailable *intstr.IntOrString
 	}{
 		{
-			name:               "default minAvailable when neither set",
+			name:               "default maxUnavailable percentage when neither set",
 			pdbSpec:            &memcachedv1beta1.PDBSpec{Enabled: true},
-			wantMinAvailable:   intOrStringPtr(intstr.FromInt32(1)),
-			wantMaxUnavailable: nil,
+			wantMinAvailable:   nil,
+			wantMaxUnavailable: intOrStringPtr(intstr.FromString("25%")),
 		},
 		{
 			name:               "custom minAvailable integer",
@@ -180,6 +180,9 @@ func TestConstructPDB_InstanceScopedSelector(t *testing.T) {
 			if got != tt.instanceName {
 				t.Errorf("selector app.kubernetes.io/instance = %q, want %q", got, tt.instanceName)
 			}
+			if want := []metav1.LabelSelectorRequirement{notCanary()}; !reflect.DeepEqual(pdb.Spec.Selector.MatchExpressions, want) {
+				t.Errorf("selector matchExpressions = %v, want %v", pdb.Spec.Selector.MatchExpressions, want)
+			}
 		})
 	}
 }
@@ -242,6 +245,69 @@ func TestIsPDBEnabled(t *testing.T) {
 	}
 }
 
+func TestConstructPDB_DefaultFollowsAntiAffinityPreset(t *testing.T) {
+	hard := memcachedv1beta1.AntiAffinityPresetHard
+	soft := memcachedv1beta1.AntiAffinityPresetSoft
+	tests := []struct {
+		name   string
+		preset *memcachedv1beta1.AntiAffinityPreset
+		want   intstr.IntOrString
+	}{
+		{name: "hard", preset: &hard, want: intstr.FromInt32(1)},
+		{name: "soft", preset: &soft, want: intstr.FromString("25%")},
+		{name: "none", preset: nil, want: intstr.FromString("25%")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &memcachedv1beta1.Memcached{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-cache", Namespace: "default"},
+				Spec: memcachedv1beta1.MemcachedSpec{
+					HighAvailability: &memcachedv1beta1.HighAvailabilitySpec{
+						AntiAffinityPreset:  tt.preset,
+						PodDisruptionBudget: &memcachedv1beta1.PDBSpec{Enabled: true},
+					},
+				},
+			}
+			pdb := &policyv1.PodDisruptionBudget{}
+
+			constructPDB(mc, pdb)
+
+			if pdb.Spec.MinAvailable != nil {
+				t.Errorf("expected nil MinAvailable, got %v", *pdb.Spec.MinAvailable)
+			}
+			if pdb.Spec.MaxUnavailable == nil || *pdb.Spec.MaxUnavailable != tt.want {
+				t.Errorf("MaxUnavailable = %v, want %v", pdb.Spec.MaxUnavailable, tt.want)
+			}
+		})
+	}
+
+	t.Run("explicit minAvailable wins under hard preset", func(t *testing.T) {
+		mc := &memcachedv1beta1.Memcached{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-cache", Namespace: "default"},
+			Spec: memcachedv1beta1.MemcachedSpec{
+				HighAvailability: &memcachedv1beta1.HighAvailabilitySpec{
+					AntiAffinityPreset: &hard,
+					PodDisruptionBudget: &memcachedv1beta1.PDBSpec{
+						Enabled:      true,
+						MinAvailable: intOrStringPtr(intstr.FromInt32(2)),
+					},
+				},
+			},
+		}
+		pdb := &policyv1.PodDisruptionBudget{}
+
+		constructPDB(mc, pdb)
+
+		if pdb.Spec.MinAvailable == nil || *pdb.Spec.MinAvailable != intstr.FromInt32(2) {
+			t.Errorf("MinAvailable = %v, want 2", pdb.Spec.MinAvailable)
+		}
+		if pdb.Spec.MaxUnavailable != nil {
+			t.Errorf("expected nil MaxUnavailable, got %v", *pdb.Spec.MaxUnavailable)
+		}
+	})
+}
+
 func TestConstructPDB_SwitchMinAvailableToMaxUnavailable(t *testing.T) {
 	// Step 1: Create a PDB with minAvailable=2.
 	mc := &memcachedv1beta1.Memcached{
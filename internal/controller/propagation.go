@@ -0,0 +1,101 @@
+// Package controller implements the reconciliation logic for the memcached-operator.
+package controller
+
+import (
+	"maps"
+	"slices"
+	"strings"
+
+	memcachedv1beta1 "github.com/c5c3/memcached-operator/api/v1beta1"
+)
+
+// propagatedLabels returns the labels of mc listed in spec.propagateLabels.
+// Keys not present on mc are skipped. It returns nil when nothing is propagated.
+func propagatedLabels(mc *memcachedv1beta1.Memcached) map[string]string {
+	return selectKeys(mc.Labels, mc.Spec.PropagateLabels)
+}
+
+// propagatedAnnotations returns the annotations of mc listed in spec.propagateAnnotations.
+// Keys not present on mc are skipped. It returns nil when nothing is propagated.
+func propagatedAnnotations(mc *memcachedv1beta1.Memcached) map[string]string {
+	return selectKeys(mc.Annotations, mc.Spec.PropagateAnnotations)
+}
+
+// withPropagatedLabels returns a new map holding the propagated labels of mc
+// overlaid with labels, so operator-managed labels always win on conflict.
+// labels itself is never modified, which keeps it safe to share with selectors.
+func withPropagatedLabels(mc *memcachedv1beta1.Memcached, labels map[string]string) map[string]string {
+	return overlay(propagatedLabels(mc), labels)
+}
+
+// withPropagatedAnnotations returns a new map holding the propagated annotations
+// of mc overlaid with annotations. It returns nil when both are empty.
+func withPropagatedAnnotations(mc *memcachedv1beta1.Memcached, annotations map[string]string) map[string]string {
+	return overlay(propagatedAnnotations(mc), annotations)
+}
+
+// AnnotationPropagatedAnnotations records, as a sorted comma-separated list, the
+// annotation keys mergePropagatedAnnotations last wrote onto a resource, so keys
+// later dropped from spec.propagateAnnotations can be removed again.
+const AnnotationPropagatedAnnotations = "memcached.c5c3.io/propagated-annotations"
+
+// mergePropagatedAnnotations writes the propagated annotations of mc into
+// annotations in place, overwriting the listed keys and deleting keys propagated
+// previously but no longer listed or set on mc. It is used for resources whose
+// annotations are otherwise not managed by the operator, so annotations set by
+// other controllers are preserved.
+func mergePropagatedAnnotations(mc *memcachedv1beta1.Memcached, annotations map[string]string) map[string]string {
+	propagated := propagatedAnnotations(mc)
+	if previous := annotations[AnnotationPropagatedAnnotations]; previous != "" {
+		for _, k := range strings.Split(previous, ",") {
+			if _, ok := propagated[k]; !ok {
+				delete(annotations, k)
+			}
+		}
+	}
+	if len(propagated) == 0 {
+		delete(annotations, AnnotationPropagatedAnnotations)
+		return annotations
+	}
+
+	if annotations == nil {
+		annotations = make(map[string]string, len(propagated)+1)
+	}
+	for k, v := range propagated {
+		annotations[k] = v
+	}
+	annotations[AnnotationPropagatedAnnotations] = strings.Join(slices.Sorted(maps.Keys(propagated)), ",")
+	return annotations
+}
+
+// selectKeys returns the entries of m whose keys are listed in keys.
+func selectKeys(m map[string]string, keys []string) map[string]string {
+	var out map[string]string
+	for _, k := range keys {
+		v, ok := m[k]
+		if !ok {
+			continue
+		}
+		if out == nil {
+			out = make(map[string]string, len(keys))
+		}
+		out[k] = v
+	}
+	return out
+}
+
+// overlay returns a new map with the entries of base overridden by top.
+// It returns nil when both maps are empty.
+func overlay(base, top map[string]string) map[string]string {
+	if len(base) == 0 && len(top) == 0 {
+		return nil
+	}
+	out := make(map[string]string, len(base)+len(top))
+	for k, v := range base {
+		out[k] = v
+	}
+	for k, v := range top {
+		out[k] = v
+	}
+	return out
+}
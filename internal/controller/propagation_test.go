@@ -0,0 +1,191 @@
+// Package controller implements the reconciliation logic for the memcached-operator.
+package controller
+
+import (
+	"reflect"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	memcachedv1beta1 "github.com/c5c3/memcached-operator/api/v1beta1"
+)
+
+func newPropagationMemcached() *memcachedv1beta1.Memcached {
+	minAvailable := intstr.FromInt32(1)
+	return &memcachedv1beta1.Memcached{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cache",
+			Namespace: "default",
+			Labels: map[string]string{
+				"cost-center":            "1234",
+				"team":                   "platform",
+				"app.kubernetes.io/name": "spoofed",
+			},
+			Annotations: map[string]string{
+				"example.com/owner": "alice",
+				"example.com/other": "ignored",
+			},
+		},
+		Spec: memcachedv1beta1.MemcachedSpec{
+			PropagateLabels:      []string{"cost-center", "app.kubernetes.io/name", "missing"},
+			PropagateAnnotations: []string{"example.com/owner"},
+			HighAvailability: &memcachedv1beta1.HighAvailabilitySpec{
+				PodDisruptionBudget: &memcachedv1beta1.PDBSpec{Enabled: true, MinAvailable: &minAvailable},
+			},
+		},
+	}
+}
+
+func TestPropagatedMetadata(t *testing.T) {
+	mc := newPropagationMemcached()
+
+	wantLabels := map[string]string{"cost-center": "1234", "app.kubernetes.io/name": "spoofed"}
+	if got := propagatedLabels(mc); !reflect.DeepEqual(got, wantLabels) {
+		t.Errorf("propagatedLabels() = %v, want %v", got, wantLabels)
+	}
+	wantAnnotations := map[string]string{"example.com/owner": "alice"}
+	if got := propagatedAnnotations(mc); !reflect.DeepEqual(got, wantAnnotations) {
+		t.Errorf("propagatedAnnotations() = %v, want %v", got, wantAnnotations)
+	}
+
+	mc.Spec.PropagateLabels = nil
+	if got := propagatedLabels(mc); got != nil {
+		t.Errorf("propagatedLabels() = %v, want nil when nothing is listed", got)
+	}
+}
+
+func TestWithPropagatedLabels_OperatorLabelsWin(t *testing.T) {
+	mc := newPropagationMemcached()
+	base := labelsForMemcached(mc.Name)
+
+	got := withPropagatedLabels(mc, base)
+
+	if got["app.kubernetes.io/name"] != "memcached" {
+		t.Errorf("app.kubernetes.io/name = %q, want operator value %q", got["app.kubernetes.io/name"], "memcached")
+	}
+	if got["cost-center"] != "1234" {
+		t.Errorf("cost-center = %q, want %q", got["cost-center"], "1234")
+	}
+	if _, ok := got["team"]; ok {
+		t.Error("unlisted label team should not be propagated")
+	}
+	if _, ok := base["cost-center"]; ok {
+		t.Error("base labels must not be modified")
+	}
+}
+
+func TestMergePropagatedAnnotations_PreservesExisting(t *testing.T) {
+	mc := newPropagationMemcached()
+	existing := map[string]string{
+		"deployment.kubernetes.io/revision": "3",
+		"example.com/owner":                 "bob",
+	}
+
+	got := mergePropagatedAnnotations(mc, existing)
+
+	if got["deployment.kubernetes.io/revision"] != "3" {
+		t.Error("existing annotation should be preserved")
+	}
+	if got["example.com/owner"] != "alice" {
+		t.Errorf("example.com/owner = %q, want propagated value %q", got["example.com/owner"], "alice")
+	}
+	if got[AnnotationPropagatedAnnotations] != "example.com/owner" {
+		t.Errorf("%s = %q, want %q", AnnotationPropagatedAnnotations, got[AnnotationPropagatedAnnotations], "example.com/owner")
+	}
+
+	if got := mergePropagatedAnnotations(mc, nil); got["example.com/owner"] != "alice" {
+		t.Errorf("example.com/owner = %q, want %q", got["example.com/owner"], "alice")
+	}
+}
+
+func TestMergePropagatedAnnotations_UpdatesAndRemoves(t *testing.T) {
+	mc := newPropagationMemcached()
+	mc.Spec.PropagateAnnotations = []string{"example.com/owner", "example.com/other"}
+	annotations := mergePropagatedAnnotations(mc, map[string]string{"deployment.kubernetes.io/revision": "3"})
+
+	// A changed value is written through.
+	mc.Annotations["example.com/owner"] = "bob"
+	annotations = mergePropagatedAnnotations(mc, annotations)
+	if annotations["example.com/owner"] != "bob" {
+		t.Errorf("example.com/owner = %q, want updated value %q", annotations["example.com/owner"], "bob")
+	}
+
+	// A key dropped from spec.propagateAnnotations is removed.
+	mc.Spec.PropagateAnnotations = []string{"example.com/owner"}
+	annotations = mergePropagatedAnnotations(mc, annotations)
+	if _, ok := annotations["example.com/other"]; ok {
+		t.Error("example.com/other should be removed once it is no longer propagated")
+	}
+	if annotations[AnnotationPropagatedAnnotations] != "example.com/owner" {
+		t.Errorf("%s = %q, want %q", AnnotationPropagatedAnnotations, annotations[AnnotationPropagatedAnnotations], "example.com/owner")
+	}
+
+	// Propagating nothing removes every propagated key and the tracking annotation.
+	mc.Spec.PropagateAnnotations = nil
+	annotations = mergePropagatedAnnotations(mc, annotations)
+	want := map[string]string{"deployment.kubernetes.io/revision": "3"}
+	if !reflect.DeepEqual(annotations, want) {
+		t.Errorf("annotations = %v, want %v", annotations, want)
+	}
+}
+
+func TestConstructors_PropagateMetadataButNotSelectors(t *testing.T) {
+	mc := newPropagationMemcached()
+	selector := labelsForMemcached(mc.Name)
+
+	dep := &appsv1.Deployment{}
+	constructDeployment(mc, dep, "", "")
+	svc := &corev1.Service{}
+	constructService(mc, svc)
+	pdb := &policyv1.PodDisruptionBudget{}
+	constructPDB(mc, pdb)
+	np := &networkingv1.NetworkPolicy{}
+	constructNetworkPolicy(mc, np)
+
+	objects := []struct {
+		name        string
+		labels      map[string]string
+		annotations map[string]string
+		selector    map[string]string
+	}{
+		{"Deployment", dep.Labels, dep.Annotations, dep.Spec.Selector.MatchLabels},
+		{"Service", svc.Labels, svc.Annotations, svc.Spec.Selector},
+		{"PodDisruptionBudget", pdb.Labels, pdb.Annotations, pdb.Spec.Selector.MatchLabels},
+		{"NetworkPolicy", np.Labels, np.Annotations, np.Spec.PodSelector.MatchLabels},
+	}
+	for _, o := range objects {
+		t.Run(o.name, func(t *testing.T) {
+			if o.labels["cost-center"] != "1234" {
+				t.Errorf("labels = %v, want cost-center propagated", o.labels)
+			}
+			if o.annotations["example.com/owner"] != "alice" {
+				t.Errorf("annotations = %v, want example.com/owner propagated", o.annotations)
+			}
+			if !reflect.DeepEqual(o.selector, selector) {
+				t.Errorf("selector = %v, want %v", o.selector, selector)
+			}
+		})
+	}
+	if _, ok := dep.Spec.Template.Labels["cost-center"]; ok {
+		t.Error("propagated labels should not be added to the Pod template")
+	}
+}
+
+func TestConstructService_SpecAnnotationsWinOverPropagated(t *testing.T) {
+	mc := newPropagationMemcached()
+	mc.Spec.Service = &memcachedv1beta1.ServiceSpec{
+		Annotations: map[string]string{"example.com/owner": "service-spec"},
+	}
+	svc := &corev1.Service{}
+
+	constructService(mc, svc)
+
+	if svc.Annotations["example.com/owner"] != "service-spec" {
+		t.Errorf("example.com/owner = %q, want %q", svc.Annotations["example.com/owner"], "service-spec")
+	}
+}
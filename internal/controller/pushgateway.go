@@ -0,0 +1,148 @@
+// Package controller implements the reconciliation logic for the memcached-operator.
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	memcachedv1beta1 "github.com/c5c3/memcached-operator/api/v1beta1"
+)
+
+// pushGatewayTimeout bounds each request made to the Pushgateway, so an
+// unreachable Pushgateway never holds up a reconcile or a deletion for long.
+const pushGatewayTimeout = 5 * time.Second
+
+// pushGatewayGroups is the part of the Pushgateway's /api/v1/metrics response
+// the operator reads: the grouping key labels of every pushed group.
+type pushGatewayGroups struct {
+	Data []struct {
+		Labels map[string]string `json:"labels"`
+	} `json:"data"`
+}
+
+// prunePushGatewayGroups deletes the Pushgateway groups left behind by pods of mc
+// that no longer exist. The pushgateway sidecar pushes under
+// job/<jobName>/instance/<pod name>, and the Pushgateway keeps a group until it is
+// deleted, so without pruning every replaced pod would leave stale metrics behind.
+// Only groups keyed by exactly job and instance are considered. Pruning is best
+// effort: failures are logged and never fail the reconcile.
+func (r *MemcachedReconciler) prunePushGatewayGroups(ctx context.Context, mc *memcachedv1beta1.Memcached) {
+	if !mc.IsMonitoringEnabled() || mc.Spec.Monitoring.PushGateway == nil {
+		return
+	}
+	logger := log.FromContext(ctx)
+
+	live := make(map[string]bool)
+	if mc.DeletionTimestamp.IsZero() {
+		// Canary pods run the pushgateway sidecar too, so list every pod of mc.
+		pods := &corev1.PodList{}
+		if err := r.List(ctx, pods,
+			client.InNamespace(mc.Namespace),
+			client.MatchingLabels(labelsForMemcached(mc.Name)),
+		); err != nil {
+			logger.Info("Skipping Pushgateway pruning", "reason", err.Error())
+			return
+		}
+		for _, pod := range pods.Items {
+			live[pod.Name] = true
+		}
+	}
+
+	base := strings.TrimSuffix(mc.Spec.Monitoring.PushGateway.URL, "/")
+	job := pushGatewayJob(mc)
+	instances, err := r.listPushGatewayInstances(ctx, base, job)
+	if err != nil {
+		logger.Info("Skipping Pushgateway pruning", "reason", err.Error())
+		return
+	}
+	for _, instance := range instances {
+		if live[instance] {
+			continue
+		}
+		if err := r.deletePushGatewayGroup(ctx, base, job, instance); err != nil {
+			logger.Info("Failed to delete Pushgateway group", "job", job, "instance", instance, "reason", err.Error())
+			continue
+		}
+		logger.Info("Deleted stale Pushgateway group", "job", job, "instance", instance)
+	}
+}
+
+// pushGatewayJob returns the job label the pushgateway sidecar pushes under:
+// spec.monitoring.pushGateway.jobName, or the Memcached name when unset.
+func pushGatewayJob(mc *memcachedv1beta1.Memcached) string {
+	if job := mc.Spec.Monitoring.PushGateway.JobName; job != "" {
+		return job
+	}
+	return mc.Name
+}
+
+// listPushGatewayInstances returns the instance labels of the groups pushed to the
+// Pushgateway at base under job and no other grouping label.
+func (r *MemcachedReconciler) listPushGatewayInstances(ctx context.Context, base, job string) ([]string, error) {
+	body, err := r.doPushGatewayRequest(ctx, http.MethodGet, base+"/api/v1/metrics")
+	if err != nil {
+		return nil, err
+	}
+	var groups pushGatewayGroups
+	if err := json.Unmarshal(body, &groups); err != nil {
+		return nil, fmt.Errorf("decoding Pushgateway groups: %w", err)
+	}
+
+	var instances []string
+	for _, group := range groups.Data {
+		instance, ok := group.Labels["instance"]
+		if !ok || group.Labels["job"] != job || len(group.Labels) != 2 {
+			continue
+		}
+		instances = append(instances, instance)
+	}
+	return instances, nil
+}
+
+// deletePushGatewayGroup deletes the group job/<job>/instance/<instance> from the
+// Pushgateway at base.
+func (r *MemcachedReconciler) deletePushGatewayGroup(ctx context.Context, base, job, instance string) error {
+	_, err := r.doPushGatewayRequest(ctx, http.MethodDelete,
+		base+"/metrics/job/"+url.PathEscape(job)+"/instance/"+url.PathEscape(instance))
+	return err
+}
+
+// doPushGatewayRequest sends a bodyless request to the Pushgateway and returns the
+// response body, or an error for a non-2xx status.
+func (r *MemcachedReconciler) doPushGatewayRequest(ctx context.Context, method, target string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, pushGatewayTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, method, target, nil)
+	if err != nil {
+		return nil, fmt.Errorf("building %s %s: %w", method, target, err)
+	}
+	httpClient := r.PushGatewayClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%s %s: %w", method, target, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s %s: %w", method, target, err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("%s %s: unexpected status %s", method, target, resp.Status)
+	}
+	return body, nil
+}
@@ -0,0 +1,107 @@
+package controller
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	memcachedv1beta1 "github.com/c5c3/memcached-operator/api/v1beta1"
+)
+
+// fakePushGateway serves the given /api/v1/metrics response and records the
+// paths of the DELETE requests it receives.
+func fakePushGateway(t *testing.T, groups string) (*httptest.Server, func() []string) {
+	t.Helper()
+	var mu sync.Mutex
+	var deleted []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/api/v1/metrics":
+			_, _ = w.Write([]byte(groups))
+		case req.Method == http.MethodDelete:
+			mu.Lock()
+			deleted = append(deleted, req.URL.Path)
+			mu.Unlock()
+			w.WriteHeader(http.StatusAccepted)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		sort.Strings(deleted)
+		return deleted
+	}
+}
+
+const testPushGatewayGroups = `{"status":"success","data":[
+	{"labels":{"job":"test-mc","instance":"test-mc-live"}},
+	{"labels":{"job":"test-mc","instance":"test-mc-gone"}},
+	{"labels":{"job":"test-mc","instance":"test-mc-other","zone":"a"}},
+	{"labels":{"job":"other","instance":"other-pod"}}
+]}`
+
+func pushGatewayMemcached(url string) *memcachedv1beta1.Memcached {
+	return &memcachedv1beta1.Memcached{
+		ObjectMeta: metav1.ObjectMeta{Name: testInstanceName, Namespace: testDefaultNamespace},
+		Spec: memcachedv1beta1.MemcachedSpec{
+			Monitoring: &memcachedv1beta1.MonitoringSpec{
+				Enabled:     true,
+				PushGateway: &memcachedv1beta1.PushGatewaySpec{URL: url + "/"},
+			},
+		},
+	}
+}
+
+func TestPrunePushGatewayGroups_DeletesGroupsOfGonePods(t *testing.T) {
+	srv, deleted := fakePushGateway(t, testPushGatewayGroups)
+	mc := pushGatewayMemcached(srv.URL)
+	r := newTestReconciler(newFakeClient(mc, readyMemcachedPod("test-mc-live", "10.0.0.1")))
+	r.PushGatewayClient = srv.Client()
+
+	r.prunePushGatewayGroups(context.Background(), mc)
+
+	want := []string{"/metrics/job/test-mc/instance/test-mc-gone"}
+	if got := deleted(); !reflect.DeepEqual(got, want) {
+		t.Errorf("deleted = %v, want %v", got, want)
+	}
+}
+
+func TestPrunePushGatewayGroups_DeletesAllGroupsOnDeletion(t *testing.T) {
+	srv, deleted := fakePushGateway(t, testPushGatewayGroups)
+	mc := pushGatewayMemcached(srv.URL)
+	now := metav1.Now()
+	mc.DeletionTimestamp = &now
+	mc.Finalizers = []string{FinalizerFinalStats}
+	r := newTestReconciler(newFakeClient(mc, readyMemcachedPod("test-mc-live", "10.0.0.1")))
+	r.PushGatewayClient = srv.Client()
+
+	r.prunePushGatewayGroups(context.Background(), mc)
+
+	want := []string{
+		"/metrics/job/test-mc/instance/test-mc-gone",
+		"/metrics/job/test-mc/instance/test-mc-live",
+	}
+	if got := deleted(); !reflect.DeepEqual(got, want) {
+		t.Errorf("deleted = %v, want %v", got, want)
+	}
+}
+
+func TestPrunePushGatewayGroups_UnreachableIsBestEffort(t *testing.T) {
+	srv, _ := fakePushGateway(t, testPushGatewayGroups)
+	mc := pushGatewayMemcached(srv.URL)
+	srv.Close()
+	r := newTestReconciler(newFakeClient(mc))
+	r.PushGatewayClient = srv.Client()
+
+	// Must return without panicking or blocking.
+	r.prunePushGatewayGroups(context.Background(), mc)
+}
@@ -0,0 +1,37 @@
+package controller
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	memcachedv1beta1 "github.com/c5c3/memcached-operator/api/v1beta1"
+)
+
+// maxRecentActions caps status.recentActions; older entries are dropped.
+const maxRecentActions = 5
+
+// Action values of status.recentActions entries.
+const (
+	ActionCreated = "Created"
+	ActionUpdated = "Updated"
+	ActionDeleted = "Deleted"
+)
+
+// recordAction appends an entry for a change to the owned resource obj to
+// mc.Status.RecentActions, keeping only the last maxRecentActions entries. The
+// entry is persisted by the status update at the end of the reconcile; it lives
+// in the status subresource, so it never bumps metadata.generation.
+func (r *MemcachedReconciler) recordAction(mc *memcachedv1beta1.Memcached, action, resourceKind string, obj client.Object) {
+	entry := memcachedv1beta1.ActionEntry{
+		Time:    metav1.NewTime(r.now()),
+		Action:  action,
+		Message: fmt.Sprintf("%s %s %s", action, resourceKind, obj.GetName()),
+	}
+	actions := append(mc.Status.RecentActions, entry)
+	if len(actions) > maxRecentActions {
+		actions = actions[len(actions)-maxRecentActions:]
+	}
+	mc.Status.RecentActions = actions
+}
@@ -0,0 +1,82 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	memcachedv1beta1 "github.com/c5c3/memcached-operator/api/v1beta1"
+)
+
+func TestRecordAction_CapsEntries(t *testing.T) {
+	mc := &memcachedv1beta1.Memcached{ObjectMeta: metav1.ObjectMeta{Name: testInstanceName, Namespace: testDefaultNamespace}}
+	r := newTestReconciler(newFakeClient(mc))
+	now := time.Date(2026, time.March, 14, 15, 9, 26, 0, time.UTC)
+	r.Clock = clocktesting.NewFakePassiveClock(now)
+
+	for i := range maxRecentActions + 2 {
+		svc := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("svc-%d", i)}}
+		r.recordAction(mc, ActionUpdated, "Service", svc)
+	}
+
+	actions := mc.Status.RecentActions
+	if len(actions) != maxRecentActions {
+		t.Fatalf("got %d entries, want %d", len(actions), maxRecentActions)
+	}
+	if got, want := actions[0].Message, "Updated Service svc-2"; got != want {
+		t.Errorf("oldest entry message = %q, want %q", got, want)
+	}
+	if got, want := actions[maxRecentActions-1].Message, "Updated Service svc-6"; got != want {
+		t.Errorf("newest entry message = %q, want %q", got, want)
+	}
+	if !actions[0].Time.Time.Equal(now) {
+		t.Errorf("entry time = %v, want %v", actions[0].Time, now)
+	}
+}
+
+func TestReconcileResource_RecordsActions(t *testing.T) {
+	mc := &memcachedv1beta1.Memcached{ObjectMeta: metav1.ObjectMeta{Name: testInstanceName, Namespace: testDefaultNamespace, UID: "abc-123"}}
+	c := newFakeClient(mc)
+	r := newTestReconciler(c)
+	ctx := context.Background()
+
+	replicas := int32(1)
+	dep := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: testInstanceName, Namespace: testDefaultNamespace}}
+	mutate := func() error {
+		dep.Spec.Replicas = &replicas
+		return nil
+	}
+	for range 2 {
+		// The second, unchanged pass must not be recorded.
+		if _, err := r.reconcileResource(ctx, mc, dep, mutate, "Deployment"); err != nil {
+			t.Fatalf("reconcileResource: %v", err)
+		}
+	}
+	replicas = 3
+	if _, err := r.reconcileResource(ctx, mc, dep, mutate, "Deployment"); err != nil {
+		t.Fatalf("reconcileResource: %v", err)
+	}
+	if err := r.deleteOwnedResource(ctx, mc, dep, "Deployment"); err != nil {
+		t.Fatalf("deleteOwnedResource: %v", err)
+	}
+	// Deleting a resource that is already gone is not an action.
+	if err := r.deleteOwnedResource(ctx, mc, dep, "Deployment"); err != nil {
+		t.Fatalf("deleteOwnedResource: %v", err)
+	}
+
+	want := []string{ActionCreated, ActionUpdated, ActionDeleted}
+	if len(mc.Status.RecentActions) != len(want) {
+		t.Fatalf("got actions %v, want %v", mc.Status.RecentActions, want)
+	}
+	for i, a := range mc.Status.RecentActions {
+		if a.Action != want[i] {
+			t.Errorf("action %d = %q, want %q", i, a.Action, want[i])
+		}
+	}
+}
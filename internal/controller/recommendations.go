@@ -0,0 +1,64 @@
+package controller
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	memcachedv1beta1 "github.com/c5c3/memcached-operator/api/v1beta1"
+)
+
+const (
+	// recommendedMemoryPercent is the share of the memory limit suggested for
+	// maxMemoryMB, leaving headroom for connections and internal structures.
+	recommendedMemoryPercent = 80
+
+	// memoryOverheadMB mirrors the 32Mi overhead the validating webhook requires
+	// between maxMemoryMB and the memory limit.
+	memoryOverheadMB = 32
+
+	// maxThreads is the upper bound of spec.memcached.threads.
+	maxThreads = 128
+)
+
+// computeRecommendations returns advisory hints for status.recommendations when
+// spec.memcached looks mis-sized for the memcached container's resource limits.
+// It only reads the spec and never affects the generated Deployment.
+func computeRecommendations(mc *memcachedv1beta1.Memcached) []string {
+	config := mc.Spec.Memcached
+	if config == nil {
+		config = &memcachedv1beta1.MemcachedConfig{}
+	}
+	limits := buildMemcachedResources(mc).Limits
+
+	var recommendations []string
+
+	if cpu, ok := limits[corev1.ResourceCPU]; ok && cpu.Sign() > 0 {
+		threads := config.Threads
+		if threads == 0 {
+			threads = memcachedv1beta1.DefaultThreads
+		}
+		cores := min((cpu.MilliValue()+999)/1000, maxThreads)
+		if int64(threads) != cores {
+			recommendations = append(recommendations, fmt.Sprintf(
+				"consider threads=%d to match the %s CPU limit (currently %d)", cores, cpu.String(), threads))
+		}
+	}
+
+	if memory, ok := limits[corev1.ResourceMemory]; ok && memory.Sign() > 0 {
+		maxMemoryMB := int64(config.MaxMemoryMB)
+		if maxMemoryMB == 0 {
+			maxMemoryMB = int64(memcachedv1beta1.DefaultMaxMemoryMB)
+		}
+		limitMB := memory.Value() / (1024 * 1024)
+		recommended := min(limitMB*recommendedMemoryPercent/100, limitMB-memoryOverheadMB)
+		// Only flag clearly under-provisioned caches, not values close to the target.
+		if maxMemoryMB < recommended/2 {
+			recommendations = append(recommendations, fmt.Sprintf(
+				"maxMemoryMB=%d uses little of the %s memory limit; it could be raised to %d (%d%% of the limit)",
+				maxMemoryMB, memory.String(), recommended, recommendedMemoryPercent))
+		}
+	}
+
+	return recommendations
+}
@@ -0,0 +1,156 @@
+package controller
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	memcachedv1beta1 "github.com/c5c3/memcached-operator/api/v1beta1"
+)
+
+func TestComputeRecommendations(t *testing.T) {
+	limits := func(cpu, memory string) *corev1.ResourceRequirements {
+		list := corev1.ResourceList{}
+		if cpu != "" {
+			list[corev1.ResourceCPU] = resource.MustParse(cpu)
+		}
+		if memory != "" {
+			list[corev1.ResourceMemory] = resource.MustParse(memory)
+		}
+		return &corev1.ResourceRequirements{Limits: list}
+	}
+
+	tests := []struct {
+		name      string
+		config    *memcachedv1beta1.MemcachedConfig
+		resources *corev1.ResourceRequirements
+		want      []string
+	}{
+		{name: "no resources"},
+		{
+			name:      "threads match CPU limit",
+			config:    &memcachedv1beta1.MemcachedConfig{Threads: 4},
+			resources: limits("4", ""),
+		},
+		{
+			name:      "default threads below CPU limit",
+			resources: limits("8", ""),
+			want:      []string{"consider threads=8 to match the 8 CPU limit (currently 4)"},
+		},
+		{
+			name:      "fractional CPU limit rounds up",
+			config:    &memcachedv1beta1.MemcachedConfig{Threads: 4},
+			resources: limits("1500m", ""),
+			want:      []string{"consider threads=2 to match the 1500m CPU limit (currently 4)"},
+		},
+		{
+			name:      "under-provisioned maxMemoryMB",
+			config:    &memcachedv1beta1.MemcachedConfig{MaxMemoryMB: 64},
+			resources: limits("", "1Gi"),
+			want:      []string{"maxMemoryMB=64 uses little of the 1Gi memory limit; it could be raised to 819 (80% of the limit)"},
+		},
+		{
+			name:      "maxMemoryMB close to the target",
+			config:    &memcachedv1beta1.MemcachedConfig{MaxMemoryMB: 768},
+			resources: limits("", "1Gi"),
+		},
+		{
+			name:      "small limit keeps the webhook overhead",
+			config:    &memcachedv1beta1.MemcachedConfig{MaxMemoryMB: 16},
+			resources: limits("", "128Mi"),
+			want:      []string{"maxMemoryMB=16 uses little of the 128Mi memory limit; it could be raised to 96 (80% of the limit)"},
+		},
+		{
+			name:      "both threads and memory",
+			config:    &memcachedv1beta1.MemcachedConfig{Threads: 1, MaxMemoryMB: 64},
+			resources: limits("2", "2Gi"),
+			want: []string{
+				"consider threads=2 to match the 2 CPU limit (currently 1)",
+				"maxMemoryMB=64 uses little of the 2Gi memory limit; it could be raised to 1638 (80% of the limit)",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &memcachedv1beta1.Memcached{
+				Spec: memcachedv1beta1.MemcachedSpec{Memcached: tt.config, Resources: tt.resources},
+			}
+			if got := computeRecommendations(mc); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("computeRecommendations() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestComputeRecommendations_GuaranteedUsesRequests(t *testing.T) {
+	mc := &memcachedv1beta1.Memcached{
+		Spec: memcachedv1beta1.MemcachedSpec{
+			QoSClass: corev1.PodQOSGuaranteed,
+			Memcached: &memcachedv1beta1.MemcachedConfig{
+				Threads:     4,
+				MaxMemoryMB: 64,
+			},
+			Resources: &corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("4"),
+					corev1.ResourceMemory: resource.MustParse("1Gi"),
+				},
+			},
+		},
+	}
+
+	got := computeRecommendations(mc)
+	if len(got) != 1 || !strings.HasPrefix(got[0], "maxMemoryMB=64") {
+		t.Errorf("computeRecommendations() = %q, want a single maxMemoryMB recommendation", got)
+	}
+}
+
+func TestReconcileStatus_Recommendations(t *testing.T) {
+	mc := &memcachedv1beta1.Memcached{
+		ObjectMeta: metav1.ObjectMeta{Name: testInstanceName, Namespace: testDefaultNamespace},
+		Spec: memcachedv1beta1.MemcachedSpec{
+			Memcached: &memcachedv1beta1.MemcachedConfig{Threads: 4, MaxMemoryMB: 64},
+			Resources: &corev1.ResourceRequirements{
+				Limits: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("4"),
+					corev1.ResourceMemory: resource.MustParse("1Gi"),
+				},
+			},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(testScheme()).WithObjects(mc).
+		WithStatusSubresource(&memcachedv1beta1.Memcached{}).Build()
+	r := newTestReconciler(c)
+
+	if err := r.reconcileStatus(context.Background(), mc, nil); err != nil {
+		t.Fatalf("reconcileStatus: %v", err)
+	}
+	if len(mc.Status.Recommendations) != 1 || !strings.Contains(mc.Status.Recommendations[0], "maxMemoryMB=64") {
+		t.Fatalf("recommendations = %q, want one maxMemoryMB recommendation", mc.Status.Recommendations)
+	}
+
+	// Recommendations are advisory: the generated Deployment still uses -m 64.
+	dep := &appsv1.Deployment{}
+	constructDeployment(mc, dep, "", "")
+	args := dep.Spec.Template.Spec.Containers[0].Args
+	if len(args) < 2 || args[0] != "-m" || args[1] != "64" {
+		t.Errorf("args = %v, want -m 64", args)
+	}
+
+	// Fixing the config clears the recommendation.
+	mc.Spec.Memcached.MaxMemoryMB = 800
+	if err := r.reconcileStatus(context.Background(), mc, nil); err != nil {
+		t.Fatalf("reconcileStatus: %v", err)
+	}
+	if mc.Status.Recommendations != nil {
+		t.Errorf("recommendations = %q, want none", mc.Status.Recommendations)
+	}
+}
@@ -3,10 +3,12 @@ package controller
 
 import (
 	"context"
+	"reflect"
 	"testing"
 
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	policyv1 "k8s.io/api/policy/v1"
@@ -101,6 +103,120 @@ func TestReconcileDeployment_CreatesDeployment(t *testing.T) {
 	}
 }
 
+func TestReconcileDeployment_SelectorConflictSkipsUpdate(t *testing.T) {
+	mc := &memcachedv1beta1.Memcached{
+		ObjectMeta: metav1.ObjectMeta{Name: testInstanceName, Namespace: testDefaultNamespace, UID: "uid-1"},
+	}
+	legacy := map[string]string{"app": "legacy"}
+	existing := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: testInstanceName, Namespace: testDefaultNamespace},
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: legacy},
+			Template: corev1.PodTemplateSpec{ObjectMeta: metav1.ObjectMeta{Labels: legacy}},
+		},
+	}
+	c := newFakeClient(mc, existing)
+	r := newTestReconciler(c)
+
+	if _, err := r.reconcileDeployment(context.Background(), mc); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	dep := &appsv1.Deployment{}
+	if err := c.Get(context.Background(), client.ObjectKeyFromObject(existing), dep); err != nil {
+		t.Fatalf("failed to get deployment: %v", err)
+	}
+	if !reflect.DeepEqual(dep.Spec.Selector.MatchLabels, legacy) {
+		t.Errorf("selector = %v, want %v", dep.Spec.Selector.MatchLabels, legacy)
+	}
+	if len(dep.OwnerReferences) != 0 {
+		t.Errorf("expected no owner references, got %v", dep.OwnerReferences)
+	}
+	if len(dep.Spec.Template.Spec.Containers) != 0 {
+		t.Errorf("expected the pod template to be left untouched, got %d containers", len(dep.Spec.Template.Spec.Containers))
+	}
+}
+
+func TestReconcileDeployment_SecurityHash(t *testing.T) {
+	ctx := context.Background()
+	mc := &memcachedv1beta1.Memcached{
+		ObjectMeta: metav1.ObjectMeta{Name: testInstanceName, Namespace: testDefaultNamespace, UID: "uid-1"},
+		Spec: memcachedv1beta1.MemcachedSpec{
+			Security: &memcachedv1beta1.SecuritySpec{
+				SASL: &memcachedv1beta1.SASLSpec{
+					Enabled:              true,
+					CredentialsSecretRef: corev1.LocalObjectReference{Name: "sasl-creds"},
+				},
+			},
+		},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "sasl-creds", Namespace: testDefaultNamespace},
+		Data:       map[string][]byte{"password-file": []byte("user:pass")},
+	}
+	c := newFakeClient(mc, secret)
+	r := newTestReconciler(c)
+
+	securityHash := func() string {
+		t.Helper()
+		if _, err := r.reconcileDeployment(ctx, mc); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		dep := &appsv1.Deployment{}
+		if err := c.Get(ctx, client.ObjectKey{Name: testInstanceName, Namespace: testDefaultNamespace}, dep); err != nil {
+			t.Fatalf("failed to get deployment: %v", err)
+		}
+		return dep.Spec.Template.Annotations[AnnotationSecurityHash]
+	}
+
+	first := securityHash()
+	if first == "" {
+		t.Fatal("expected security-hash annotation on the pod template")
+	}
+	if got := securityHash(); got != first {
+		t.Errorf("security-hash changed on a no-op reconcile: %q -> %q", first, got)
+	}
+
+	// A metadata-only update bumps the resourceVersion but must not roll the pods.
+	if err := c.Get(ctx, client.ObjectKeyFromObject(secret), secret); err != nil {
+		t.Fatalf("failed to get secret: %v", err)
+	}
+	secret.Labels = map[string]string{"team": "cache"}
+	if err := c.Update(ctx, secret); err != nil {
+		t.Fatalf("failed to update secret: %v", err)
+	}
+	if got := securityHash(); got != first {
+		t.Errorf("security-hash changed after a metadata-only Secret update: %q -> %q", first, got)
+	}
+
+	secret.Data["password-file"] = []byte("user:rotated")
+	if err := c.Update(ctx, secret); err != nil {
+		t.Fatalf("failed to update secret: %v", err)
+	}
+	if got := securityHash(); got == first {
+		t.Error("expected security-hash to change after the referenced Secret's data changed")
+	}
+}
+
+func TestReconcileDeployment_NoSecurityHashWithoutSecrets(t *testing.T) {
+	mc := &memcachedv1beta1.Memcached{
+		ObjectMeta: metav1.ObjectMeta{Name: testInstanceName, Namespace: testDefaultNamespace, UID: "uid-1"},
+	}
+	c := newFakeClient(mc)
+	r := newTestReconciler(c)
+
+	if _, err := r.reconcileDeployment(context.Background(), mc); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	dep := &appsv1.Deployment{}
+	if err := c.Get(context.Background(), client.ObjectKey{Name: testInstanceName, Namespace: testDefaultNamespace}, dep); err != nil {
+		t.Fatalf("failed to get deployment: %v", err)
+	}
+	if v, ok := dep.Spec.Template.Annotations[AnnotationSecurityHash]; ok {
+		t.Errorf("unexpected security-hash annotation %q", v)
+	}
+}
+
 func TestReconcileDeployment_UpdatesExistingDeployment(t *testing.T) {
 	replicas3 := int32(3)
 	mc := &memcachedv1beta1.Memcached{
@@ -269,6 +385,88 @@ func TestReconcilePDB_SkipsWhenDisabled(t *testing.T) {
 	}
 }
 
+func TestReconcilePDB_DisabledDeletesOrOrphans(t *testing.T) {
+	tests := []struct {
+		name       string
+		retain     bool
+		wantExists bool
+	}{
+		{name: "delete by default", retain: false, wantExists: false},
+		{name: "retain orphans", retain: true, wantExists: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &memcachedv1beta1.Memcached{
+				ObjectMeta: metav1.ObjectMeta{Name: testInstanceName, Namespace: testDefaultNamespace, UID: "uid-1"},
+				Spec:       memcachedv1beta1.MemcachedSpec{RetainOrphansOnDisable: tt.retain},
+			}
+			isController := true
+			existing := &policyv1.PodDisruptionBudget{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      testInstanceName,
+					Namespace: testDefaultNamespace,
+					OwnerReferences: []metav1.OwnerReference{{
+						APIVersion: memcachedv1beta1.GroupVersion.String(),
+						Kind:       "Memcached",
+						Name:       testInstanceName,
+						UID:        "uid-1",
+						Controller: &isController,
+					}},
+				},
+			}
+			c := newFakeClient(mc, existing)
+			r := newTestReconciler(c)
+
+			if err := r.reconcilePDB(context.Background(), mc); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			pdb := &policyv1.PodDisruptionBudget{}
+			err := c.Get(context.Background(), client.ObjectKeyFromObject(existing), pdb)
+			if exists := err == nil; exists != tt.wantExists {
+				t.Fatalf("PDB exists = %v, want %v (err=%v)", exists, tt.wantExists, err)
+			}
+			if tt.wantExists && len(pdb.OwnerReferences) != 0 {
+				t.Errorf("ownerReferences = %v, want none after orphaning", pdb.OwnerReferences)
+			}
+		})
+	}
+}
+
+func TestReconcileHPA_DisabledDeletesDespiteRetainOrphans(t *testing.T) {
+	mc := &memcachedv1beta1.Memcached{
+		ObjectMeta: metav1.ObjectMeta{Name: testInstanceName, Namespace: testDefaultNamespace, UID: "uid-1"},
+		Spec:       memcachedv1beta1.MemcachedSpec{RetainOrphansOnDisable: true},
+	}
+	isController := true
+	existing := &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      testInstanceName,
+			Namespace: testDefaultNamespace,
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: memcachedv1beta1.GroupVersion.String(),
+				Kind:       "Memcached",
+				Name:       testInstanceName,
+				UID:        "uid-1",
+				Controller: &isController,
+			}},
+		},
+	}
+	c := newFakeClient(mc, existing)
+	r := newTestReconciler(c)
+
+	if err := r.reconcileHPA(context.Background(), mc); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// An orphaned HPA would keep scaling the Deployment against spec.replicas.
+	err := c.Get(context.Background(), client.ObjectKeyFromObject(existing), &autoscalingv2.HorizontalPodAutoscaler{})
+	if err == nil {
+		t.Fatal("expected HPA to be deleted even with retainOrphansOnDisable")
+	}
+}
+
 func TestReconcilePDB_CreatesPDB(t *testing.T) {
 	mc := &memcachedv1beta1.Memcached{
 		ObjectMeta: metav1.ObjectMeta{Name: testInstanceName, Namespace: testDefaultNamespace, UID: "uid-1"},
@@ -7,6 +7,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -40,6 +41,9 @@ func (r *MemcachedReconciler) reconcileResource(
 
 	for attempt := range maxConflictRetries {
 		result, err := controllerutil.CreateOrUpdate(ctx, r.Client, obj, func() error {
+			if r.PruneUnmanagedAnnotations {
+				pruneUnmanagedAnnotations(obj, r.AnnotationAllowlist)
+			}
 			if err := mutate(); err != nil {
 				return err
 			}
@@ -51,6 +55,12 @@ func (r *MemcachedReconciler) reconcileResource(
 				"name", obj.GetName(),
 				"operation", result)
 			r.emitEventForResult(mc, obj, resourceKind, result)
+			switch result {
+			case controllerutil.OperationResultCreated:
+				r.recordAction(mc, ActionCreated, resourceKind, obj)
+			case controllerutil.OperationResultUpdated:
+				r.recordAction(mc, ActionUpdated, resourceKind, obj)
+			}
 			metricResult := string(result)
 			if result == controllerutil.OperationResultNone {
 				metricResult = "unchanged"
@@ -80,8 +90,14 @@ func (r *MemcachedReconciler) reconcileResource(
 
 // deleteOwnedResource deletes a resource if it exists, ignoring NotFound errors.
 // This is used to clean up optional resources (PDB, ServiceMonitor, NetworkPolicy)
-// when their feature is disabled in the CR spec.
-func (r *MemcachedReconciler) deleteOwnedResource(ctx context.Context, obj client.Object, resourceKind string) error {
+// when their feature is disabled in the CR spec. An actual deletion is recorded in
+// mc's status.recentActions.
+func (r *MemcachedReconciler) deleteOwnedResource(
+	ctx context.Context,
+	mc *memcachedv1beta1.Memcached,
+	obj client.Object,
+	resourceKind string,
+) error {
 	logger := log.FromContext(ctx)
 	if err := r.Delete(ctx, obj); err != nil {
 		if apierrors.IsNotFound(err) {
@@ -90,6 +106,51 @@ func (r *MemcachedReconciler) deleteOwnedResource(ctx context.Context, obj clien
 		return fmt.Errorf("deleting %s: %w", resourceKind, err)
 	}
 	logger.Info("Resource deleted", "kind", resourceKind, "name", obj.GetName())
+	r.recordAction(mc, ActionDeleted, resourceKind, obj)
+	return nil
+}
+
+// disableOwnedResource cleans up an optional resource whose feature is disabled.
+// By default the resource is deleted; when spec.retainOrphansOnDisable is set it is
+// orphaned instead.
+func (r *MemcachedReconciler) disableOwnedResource(
+	ctx context.Context,
+	mc *memcachedv1beta1.Memcached,
+	obj client.Object,
+	resourceKind string,
+) error {
+	if mc.Spec.RetainOrphansOnDisable {
+		return r.orphanOwnedResource(ctx, mc, obj, resourceKind)
+	}
+	return r.deleteOwnedResource(ctx, mc, obj, resourceKind)
+}
+
+// orphanOwnedResource removes mc's controller reference from a resource, so the
+// operator stops managing it and it survives deletion of the Memcached CR.
+// Resources that do not exist or are not controlled by mc are left untouched.
+func (r *MemcachedReconciler) orphanOwnedResource(
+	ctx context.Context,
+	mc *memcachedv1beta1.Memcached,
+	obj client.Object,
+	resourceKind string,
+) error {
+	logger := log.FromContext(ctx)
+	if err := r.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("fetching %s: %w", resourceKind, err)
+	}
+	if !metav1.IsControlledBy(obj, mc) {
+		return nil
+	}
+	if err := controllerutil.RemoveControllerReference(mc, obj, r.Scheme); err != nil {
+		return fmt.Errorf("orphaning %s: %w", resourceKind, err)
+	}
+	if err := r.Update(ctx, obj); err != nil {
+		return fmt.Errorf("orphaning %s: %w", resourceKind, err)
+	}
+	logger.Info("Resource orphaned", "kind", resourceKind, "name", obj.GetName())
 	return nil
 }
 
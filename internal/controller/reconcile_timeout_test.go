@@ -0,0 +1,75 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	memcachedv1beta1 "github.com/c5c3/memcached-operator/api/v1beta1"
+)
+
+// newSlowClient returns a fake client whose Get blocks until the context is done
+// or the given delay elapses, simulating an unresponsive API server.
+func newSlowClient(delay time.Duration, objs ...client.Object) client.Client {
+	base := fake.NewClientBuilder().
+		WithScheme(testSchemeWithMonitoring()).
+		WithObjects(objs...).
+		WithStatusSubresource(&memcachedv1beta1.Memcached{}).
+		Build()
+	return interceptor.NewClient(base, interceptor.Funcs{
+		Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(delay):
+				return c.Get(ctx, key, obj, opts...)
+			}
+		},
+	})
+}
+
+func TestReconcile_TimeoutHonored(t *testing.T) {
+	mc := &memcachedv1beta1.Memcached{
+		ObjectMeta: metav1.ObjectMeta{Name: testInstanceName, Namespace: testDefaultNamespace},
+	}
+	r := newTestReconcilerWithMonitoring(newSlowClient(10*time.Second, mc))
+	r.ReconcileTimeout = 50 * time.Millisecond
+
+	start := time.Now()
+	_, err := r.Reconcile(context.Background(), ctrl.Request{
+		NamespacedName: types.NamespacedName{Name: testInstanceName, Namespace: testDefaultNamespace},
+	})
+	elapsed := time.Since(start)
+
+	if err == nil {
+		t.Fatal("expected timeout error, got nil")
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected context.DeadlineExceeded, got %v", err)
+	}
+	if elapsed > 2*time.Second {
+		t.Errorf("Reconcile took %s, expected it to abort near the 50ms deadline", elapsed)
+	}
+}
+
+func TestReconcile_NoTimeoutWhenDisabled(t *testing.T) {
+	mc := &memcachedv1beta1.Memcached{
+		ObjectMeta: metav1.ObjectMeta{Name: testInstanceName, Namespace: testDefaultNamespace},
+	}
+	r := newTestReconcilerWithMonitoring(newSlowClient(10*time.Millisecond, mc))
+
+	_, err := r.Reconcile(context.Background(), ctrl.Request{
+		NamespacedName: types.NamespacedName{Name: testInstanceName, Namespace: testDefaultNamespace},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,92 @@
+// Package controller implements the reconciliation logic for the memcached-operator.
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	memcachedv1beta1 "github.com/c5c3/memcached-operator/api/v1beta1"
+)
+
+const (
+	// frequentRestartsThreshold is the number of container restarts within
+	// frequentRestartsWindow at which the instance is reported as Degraded.
+	frequentRestartsThreshold = 5
+
+	// frequentRestartsWindow is how recently a container must have last terminated
+	// for its restarts to count towards frequentRestartsThreshold.
+	frequentRestartsWindow = 15 * time.Minute
+)
+
+// restartSummary aggregates recent container restarts across the pods of an instance.
+type restartSummary struct {
+	// count is the summed restart count of containers that last terminated within the window.
+	count int32
+	// lastReason is the termination reason (e.g. OOMKilled) of the most recent termination.
+	lastReason string
+}
+
+// summarizeRestarts sums the restart counts of the containers in pods whose last
+// termination finished within frequentRestartsWindow before now. Containers that
+// have been running stably for longer than the window are ignored, so restarts
+// from a past incident do not keep the instance Degraded.
+func summarizeRestarts(pods []corev1.Pod, now time.Time) restartSummary {
+	var sum restartSummary
+	var lastFinished time.Time
+	for i := range pods {
+		for _, cs := range pods[i].Status.ContainerStatuses {
+			term := cs.LastTerminationState.Terminated
+			if cs.RestartCount == 0 || term == nil || now.Sub(term.FinishedAt.Time) > frequentRestartsWindow {
+				continue
+			}
+			sum.count += cs.RestartCount
+			if term.FinishedAt.After(lastFinished) {
+				lastFinished = term.FinishedAt.Time
+				sum.lastReason = term.Reason
+			}
+		}
+	}
+	return sum
+}
+
+// frequentRestartsCondition returns a Degraded condition with reason FrequentRestarts
+// when the recent restarts reach frequentRestartsThreshold, or nil otherwise.
+func frequentRestartsCondition(mc *memcachedv1beta1.Memcached, sum restartSummary) *metav1.Condition {
+	if sum.count < frequentRestartsThreshold {
+		return nil
+	}
+	msg := fmt.Sprintf("Containers restarted %d times in the last %s", sum.count, frequentRestartsWindow)
+	if sum.lastReason != "" {
+		msg += fmt.Sprintf("; last termination reason: %s", sum.lastReason)
+	}
+	return &metav1.Condition{
+		Type:               ConditionTypeDegraded,
+		Status:             metav1.ConditionTrue,
+		Reason:             ConditionReasonFrequentRestarts,
+		Message:            msg,
+		ObservedGeneration: mc.Generation,
+	}
+}
+
+// listMemcachedPods lists the regular pods of mc by its instance labels. Canary
+// pods are excluded, so restarts, deletion costs and the final stats only
+// consider the pods of the regular Deployment.
+func (r *MemcachedReconciler) listMemcachedPods(ctx context.Context, mc *memcachedv1beta1.Memcached) ([]corev1.Pod, error) {
+	selector, err := metav1.LabelSelectorAsSelector(regularPodSelector(mc.Name))
+	if err != nil {
+		return nil, fmt.Errorf("building pod selector: %w", err)
+	}
+	pods := &corev1.PodList{}
+	if err := r.List(ctx, pods,
+		client.InNamespace(mc.Namespace),
+		client.MatchingLabelsSelector{Selector: selector},
+	); err != nil {
+		return nil, fmt.Errorf("listing pods: %w", err)
+	}
+	return pods.Items, nil
+}
@@ -0,0 +1,122 @@
+// Package controller implements the reconciliation logic for the memcached-operator.
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	memcachedv1beta1 "github.com/c5c3/memcached-operator/api/v1beta1"
+)
+
+func podWithRestarts(restarts int32, reason string, finishedAt time.Time) corev1.Pod {
+	return corev1.Pod{
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name:         "memcached",
+				RestartCount: restarts,
+				LastTerminationState: corev1.ContainerState{
+					Terminated: &corev1.ContainerStateTerminated{
+						Reason:     reason,
+						FinishedAt: metav1.NewTime(finishedAt),
+					},
+				},
+			}},
+		},
+	}
+}
+
+func TestSummarizeRestarts(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name       string
+		pods       []corev1.Pod
+		wantCount  int32
+		wantReason string
+	}{
+		{name: "no pods", pods: nil, wantCount: 0},
+		{
+			name:      "no restarts",
+			pods:      []corev1.Pod{{Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{{Name: "memcached"}}}}},
+			wantCount: 0,
+		},
+		{
+			name: "sums recent restarts and keeps the latest reason",
+			pods: []corev1.Pod{
+				podWithRestarts(3, "Error", now.Add(-10*time.Minute)),
+				podWithRestarts(4, "OOMKilled", now.Add(-time.Minute)),
+			},
+			wantCount:  7,
+			wantReason: "OOMKilled",
+		},
+		{
+			name: "ignores terminations outside the window",
+			pods: []corev1.Pod{
+				podWithRestarts(9, "OOMKilled", now.Add(-time.Hour)),
+				podWithRestarts(2, "Error", now.Add(-time.Minute)),
+			},
+			wantCount:  2,
+			wantReason: "Error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := summarizeRestarts(tt.pods, now)
+			if got.count != tt.wantCount || got.lastReason != tt.wantReason {
+				t.Errorf("summarizeRestarts() = {%d %q}, want {%d %q}", got.count, got.lastReason, tt.wantCount, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestFrequentRestartsCondition(t *testing.T) {
+	mc := &memcachedv1beta1.Memcached{ObjectMeta: metav1.ObjectMeta{Generation: 4}}
+
+	if c := frequentRestartsCondition(mc, restartSummary{count: frequentRestartsThreshold - 1}); c != nil {
+		t.Errorf("expected nil below the threshold, got %+v", c)
+	}
+
+	c := frequentRestartsCondition(mc, restartSummary{count: frequentRestartsThreshold, lastReason: "OOMKilled"})
+	if c == nil {
+		t.Fatal("expected a condition at the threshold")
+	}
+	if c.Type != ConditionTypeDegraded || c.Status != metav1.ConditionTrue || c.Reason != ConditionReasonFrequentRestarts {
+		t.Errorf("condition = %s/%s/%s, want Degraded/True/FrequentRestarts", c.Type, c.Status, c.Reason)
+	}
+	if !strings.Contains(c.Message, "5 times") || !strings.Contains(c.Message, "OOMKilled") {
+		t.Errorf("message %q should include the restart count and last termination reason", c.Message)
+	}
+	if c.ObservedGeneration != 4 {
+		t.Errorf("ObservedGeneration = %d, want 4", c.ObservedGeneration)
+	}
+}
+
+func TestListMemcachedPods_ExcludesCanary(t *testing.T) {
+	now := time.Now()
+	mc := &memcachedv1beta1.Memcached{ObjectMeta: metav1.ObjectMeta{Name: testInstanceName, Namespace: testDefaultNamespace}}
+
+	regular := podWithRestarts(1, "Error", now.Add(-time.Minute))
+	regular.ObjectMeta = metav1.ObjectMeta{Name: "mc-regular", Namespace: testDefaultNamespace, Labels: labelsForMemcached(testInstanceName)}
+	canary := podWithRestarts(9, "OOMKilled", now.Add(-time.Minute))
+	canary.ObjectMeta = metav1.ObjectMeta{Name: "mc-canary", Namespace: testDefaultNamespace, Labels: labelsForCanary(testInstanceName)}
+
+	r := newTestReconciler(newFakeClient(mc, &regular, &canary))
+	pods, err := r.listMemcachedPods(context.Background(), mc)
+	if err != nil {
+		t.Fatalf("listMemcachedPods: %v", err)
+	}
+	if len(pods) != 1 || pods[0].Name != "mc-regular" {
+		t.Fatalf("listMemcachedPods() = %v, want only mc-regular", pods)
+	}
+
+	// The canary's restarts do not count towards the instance's restarts.
+	if got := summarizeRestarts(pods, now); got.count != 1 || got.lastReason != "Error" {
+		t.Errorf("summarizeRestarts() = {%d %q}, want {1 \"Error\"}", got.count, got.lastReason)
+	}
+}
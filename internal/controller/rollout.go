@@ -0,0 +1,23 @@
+// Package controller implements the reconciliation logic for the memcached-operator.
+package controller
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	memcachedv1beta1 "github.com/c5c3/memcached-operator/api/v1beta1"
+)
+
+// rolloutSuspendedCondition returns the RolloutSuspended condition for mc, or nil
+// when spec.suspendRollout is false and the condition should be absent.
+func rolloutSuspendedCondition(mc *memcachedv1beta1.Memcached) *metav1.Condition {
+	if !mc.Spec.SuspendRollout {
+		return nil
+	}
+	return &metav1.Condition{
+		Type:               ConditionTypeRolloutSuspended,
+		Status:             metav1.ConditionTrue,
+		Reason:             ConditionReasonSuspendRollout,
+		Message:            "Deployment is paused; Pod template changes are staged until spec.suspendRollout is false",
+		ObservedGeneration: mc.Generation,
+	}
+}
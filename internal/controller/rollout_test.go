@@ -0,0 +1,49 @@
+// Package controller implements the reconciliation logic for the memcached-operator.
+package controller
+
+import (
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	memcachedv1beta1 "github.com/c5c3/memcached-operator/api/v1beta1"
+)
+
+func TestRolloutSuspendedCondition(t *testing.T) {
+	mc := &memcachedv1beta1.Memcached{ObjectMeta: metav1.ObjectMeta{Generation: 2}}
+	if c := rolloutSuspendedCondition(mc); c != nil {
+		t.Errorf("condition = %+v, want nil when not suspended", c)
+	}
+
+	mc.Spec.SuspendRollout = true
+	c := rolloutSuspendedCondition(mc)
+	if c == nil {
+		t.Fatal("expected a condition while suspended")
+	}
+	if c.Type != ConditionTypeRolloutSuspended || c.Status != metav1.ConditionTrue || c.Reason != ConditionReasonSuspendRollout {
+		t.Errorf("condition = %s/%s/%s, want RolloutSuspended/True/SuspendRollout", c.Type, c.Status, c.Reason)
+	}
+	if c.ObservedGeneration != 2 {
+		t.Errorf("ObservedGeneration = %d, want 2", c.ObservedGeneration)
+	}
+}
+
+func TestConstructDeployment_SuspendRollout(t *testing.T) {
+	mc := &memcachedv1beta1.Memcached{
+		ObjectMeta: metav1.ObjectMeta{Name: "suspend", Namespace: "default"},
+		Spec:       memcachedv1beta1.MemcachedSpec{SuspendRollout: true},
+	}
+	dep := &appsv1.Deployment{}
+
+	constructDeployment(mc, dep, "", "")
+	if !dep.Spec.Paused {
+		t.Error("deployment should be paused while spec.suspendRollout is true")
+	}
+
+	mc.Spec.SuspendRollout = false
+	constructDeployment(mc, dep, "", "")
+	if dep.Spec.Paused {
+		t.Error("deployment should be unpaused once spec.suspendRollout is false")
+	}
+}
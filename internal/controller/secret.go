@@ -5,9 +5,14 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"fmt"
+	"slices"
 	"sort"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
@@ -62,26 +67,73 @@ func computeSecretHash(secrets ...*corev1.Secret) string {
 	return hex.EncodeToString(h.Sum(nil))
 }
 
-// fetchReferencedSecrets collects the Secrets referenced by the Memcached CR's Security spec
-// (SASL credentials and TLS certificates). It returns the found Secrets and the names of
-// any that could not be fetched.
-func fetchReferencedSecrets(ctx context.Context, c client.Client, mc *memcachedv1beta1.Memcached) ([]*corev1.Secret, []string) {
-	if mc.Spec.Security == nil {
-		return nil, nil
+// computeSecurityHash returns a deterministic SHA-256 hex digest over the name and
+// a digest of the data of every referenced security Secret (SASL credentials, TLS
+// certificates including the CA bundle, and exporter TLS), summarizing all security
+// material in a single fingerprint. Metadata-only writes such as label or
+// annotation changes leave it unchanged. It returns an empty string if no Secrets
+// are provided.
+func computeSecurityHash(secrets ...*corev1.Secret) string {
+	if len(secrets) == 0 {
+		return ""
+	}
+
+	sorted := make([]*corev1.Secret, len(secrets))
+	copy(sorted, secrets)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Name < sorted[j].Name
+	})
+
+	h := sha256.New()
+	for _, s := range sorted {
+		h.Write([]byte(s.Name))
+		h.Write([]byte{0})
+		h.Write(secretDataDigest(s))
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// secretDataDigest returns a SHA-256 digest over the sorted keys of s.Data and a
+// digest of each value, so no key or value boundary is ambiguous.
+func secretDataDigest(s *corev1.Secret) []byte {
+	keys := make([]string, 0, len(s.Data))
+	for k := range s.Data {
+		keys = append(keys, k)
 	}
+	sort.Strings(keys)
 
+	h := sha256.New()
+	for _, k := range keys {
+		value := sha256.Sum256(s.Data[k])
+		h.Write([]byte(k))
+		h.Write([]byte{0})
+		h.Write(value[:])
+	}
+	return h.Sum(nil)
+}
+
+// fetchReferencedSecrets collects the Secrets referenced by the Memcached CR's Security spec
+// (SASL credentials and TLS certificates) and the exporter TLS certificate. It returns the
+// found Secrets and the names of any that could not be fetched.
+func fetchReferencedSecrets(ctx context.Context, c client.Client, mc *memcachedv1beta1.Memcached) ([]*corev1.Secret, []string) {
 	names := make(map[string]struct{})
 
-	if mc.Spec.Security.SASL != nil && mc.Spec.Security.SASL.Enabled {
-		if name := mc.Spec.Security.SASL.CredentialsSecretRef.Name; name != "" {
+	if mc.Spec.Security != nil && mc.Spec.Security.SASL != nil && mc.Spec.Security.SASL.Enabled {
+		if name := mc.SASLCredentialsSecretName(); name != "" {
 			names[name] = struct{}{}
 		}
 	}
-	if mc.Spec.Security.TLS != nil && mc.Spec.Security.TLS.Enabled {
+	if mc.Spec.Security != nil && mc.Spec.Security.TLS != nil && mc.Spec.Security.TLS.Enabled {
 		if name := mc.Spec.Security.TLS.CertificateSecretRef.Name; name != "" {
 			names[name] = struct{}{}
 		}
 	}
+	if mc.IsExporterTLSEnabled() {
+		if name := mc.Spec.Monitoring.ExporterTLS.CertificateSecretRef.Name; name != "" {
+			names[name] = struct{}{}
+		}
+	}
 
 	if len(names) == 0 {
 		return nil, nil
@@ -105,29 +157,46 @@ func fetchReferencedSecrets(ctx context.Context, c client.Client, mc *memcachedv
 
 // mapSecretToMemcached returns a handler.MapFunc that maps a Secret event to
 // reconcile.Requests for all Memcached CRs in the same namespace that reference
-// the Secret via their Security spec.
+// the Secret via their Security or Monitoring spec, and for all Memcached CRs
+// that copy the Secret from its namespace via TLS CopyFromNamespace.
 func mapSecretToMemcached(c client.Client) handler.MapFunc {
 	return func(ctx context.Context, obj client.Object) []reconcile.Request {
 		secretName := obj.GetName()
 		secretNamespace := obj.GetNamespace()
 
 		var list memcachedv1beta1.MemcachedList
-		if err := c.List(ctx, &list, client.InNamespace(secretNamespace)); err != nil {
+		if err := c.List(ctx, &list); err != nil {
 			return nil
 		}
 
 		var requests []reconcile.Request
 		for i := range list.Items {
 			mc := &list.Items[i]
-			if mc.Spec.Security == nil {
+
+			if src, ok := tlsCopySource(mc); ok && src.Namespace == secretNamespace && src.Name == secretName {
+				requests = append(requests, reconcile.Request{
+					NamespacedName: types.NamespacedName{
+						Name:      mc.Name,
+						Namespace: mc.Namespace,
+					},
+				})
+				continue
+			}
+			if mc.Namespace != secretNamespace {
 				continue
 			}
 
 			matched := false
-			if mc.Spec.Security.SASL != nil && mc.Spec.Security.SASL.CredentialsSecretRef.Name == secretName {
-				matched = true
+			if mc.Spec.Security != nil {
+				if mc.Spec.Security.SASL != nil && mc.SASLCredentialsSecretName() == secretName {
+					matched = true
+				}
+				if mc.Spec.Security.TLS != nil && mc.Spec.Security.TLS.CertificateSecretRef.Name == secretName {
+					matched = true
+				}
 			}
-			if mc.Spec.Security.TLS != nil && mc.Spec.Security.TLS.CertificateSecretRef.Name == secretName {
+			if mc.Spec.Monitoring != nil && mc.Spec.Monitoring.ExporterTLS != nil &&
+				mc.Spec.Monitoring.ExporterTLS.CertificateSecretRef.Name == secretName {
 				matched = true
 			}
 
@@ -144,3 +213,99 @@ func mapSecretToMemcached(c client.Client) handler.MapFunc {
 		return requests
 	}
 }
+
+// AnnotationCopyAllowedTo opts a Secret into being copied by
+// spec.security.tls.copyFromNamespace. Its value is a comma-separated list of the
+// namespaces the Secret may be copied into.
+const AnnotationCopyAllowedTo = "memcached.c5c3.io/copy-allowed-to"
+
+// copyAllowedTo reports whether source carries AnnotationCopyAllowedTo listing namespace.
+func copyAllowedTo(source *corev1.Secret, namespace string) bool {
+	for _, ns := range strings.Split(source.Annotations[AnnotationCopyAllowedTo], ",") {
+		if strings.TrimSpace(ns) == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+// tlsCopySource returns the source of the TLS certificate Secret copy when TLS is
+// enabled and CopyFromNamespace names a namespace other than the Memcached's own.
+func tlsCopySource(mc *memcachedv1beta1.Memcached) (types.NamespacedName, bool) {
+	if mc.Spec.Security == nil || mc.Spec.Security.TLS == nil || !mc.Spec.Security.TLS.Enabled {
+		return types.NamespacedName{}, false
+	}
+	tlsSpec := mc.Spec.Security.TLS
+	if tlsSpec.CopyFromNamespace == nil || *tlsSpec.CopyFromNamespace == "" ||
+		*tlsSpec.CopyFromNamespace == mc.Namespace || tlsSpec.CertificateSecretRef.Name == "" {
+		return types.NamespacedName{}, false
+	}
+	return types.NamespacedName{Namespace: *tlsSpec.CopyFromNamespace, Name: tlsSpec.CertificateSecretRef.Name}, true
+}
+
+// constructTLSSecretCopy sets the desired state of the copied TLS Secret from the
+// source Secret. The Secret type is immutable, so it is only set on creation.
+func constructTLSSecretCopy(mc *memcachedv1beta1.Memcached, source, secret *corev1.Secret) {
+	secret.Labels = withPropagatedLabels(mc, labelsForMemcached(mc.Name))
+	secret.Annotations = mergePropagatedAnnotations(mc, secret.Annotations)
+	if secret.CreationTimestamp.IsZero() {
+		secret.Type = source.Type
+	}
+	secret.Data = make(map[string][]byte, len(source.Data))
+	for k, v := range source.Data {
+		secret.Data[k] = append([]byte(nil), v...)
+	}
+}
+
+// reconcileTLSSecretCopy copies the TLS certificate Secret from the namespace named
+// by CopyFromNamespace into the Memcached namespace. The copy is owned by the
+// Memcached CR so it is garbage-collected on deletion. It returns the
+// "<namespace>/<name>" of the source Secret when it does not exist. It refuses to
+// copy from a namespace missing from TLSCopySourceNamespaces or a Secret whose
+// AnnotationCopyAllowedTo does not list the Memcached namespace, and to overwrite
+// a same-named Secret that is not controlled by the Memcached CR.
+func (r *MemcachedReconciler) reconcileTLSSecretCopy(ctx context.Context, mc *memcachedv1beta1.Memcached) ([]string, error) {
+	src, ok := tlsCopySource(mc)
+	if !ok {
+		return nil, nil
+	}
+	if !slices.Contains(r.TLSCopySourceNamespaces, src.Namespace) {
+		return nil, fmt.Errorf("copying TLS Secrets from namespace %q is not allowed; the operator must list it in --tls-copy-source-namespaces",
+			src.Namespace)
+	}
+
+	source := &corev1.Secret{}
+	if err := r.Get(ctx, src, source); err != nil {
+		if apierrors.IsNotFound(err) {
+			return []string{src.String()}, nil
+		}
+		return nil, fmt.Errorf("fetching source TLS Secret %s: %w", src, err)
+	}
+	if !copyAllowedTo(source, mc.Namespace) {
+		return nil, fmt.Errorf("source TLS Secret %s does not allow copies to namespace %q; its %s annotation must list it",
+			src, mc.Namespace, AnnotationCopyAllowedTo)
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      src.Name,
+			Namespace: mc.Namespace,
+		},
+	}
+
+	existing := &corev1.Secret{}
+	if err := r.Get(ctx, client.ObjectKeyFromObject(secret), existing); err == nil {
+		if !metav1.IsControlledBy(existing, mc) {
+			return nil, fmt.Errorf("secret %s/%s already exists and is not owned by Memcached %s; refusing to overwrite it with the copy from %s",
+				mc.Namespace, src.Name, mc.Name, src.Namespace)
+		}
+	} else if !apierrors.IsNotFound(err) {
+		return nil, fmt.Errorf("fetching TLS Secret copy: %w", err)
+	}
+
+	_, err := r.reconcileResource(ctx, mc, secret, func() error {
+		constructTLSSecretCopy(mc, source, secret)
+		return nil
+	}, "Secret")
+	return nil, err
+}
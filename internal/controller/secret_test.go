@@ -7,7 +7,10 @@ import (
 	"testing"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	memcachedv1beta1 "github.com/c5c3/memcached-operator/api/v1beta1"
@@ -119,6 +122,58 @@ func TestComputeSecretHash_DataChange(t *testing.T) {
 	}
 }
 
+func TestComputeSecurityHash(t *testing.T) {
+	sasl := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "sasl", ResourceVersion: "10"},
+		Data:       map[string][]byte{"password-file": []byte("user:pass")},
+	}
+	tls := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "tls", ResourceVersion: "20"},
+		Data:       map[string][]byte{"tls.crt": []byte("cert"), "tls.key": []byte("key")},
+	}
+
+	if h := computeSecurityHash(); h != "" {
+		t.Errorf("expected empty string for no secrets, got %q", h)
+	}
+
+	base := computeSecurityHash(sasl, tls)
+	if base == "" {
+		t.Fatal("expected non-empty hash")
+	}
+	if got := computeSecurityHash(tls, sasl); got != base {
+		t.Errorf("hash depends on secret order: %q != %q", got, base)
+	}
+
+	rotated := tls.DeepCopy()
+	rotated.ResourceVersion = "21"
+	rotated.Data["tls.crt"] = []byte("new-cert")
+	if got := computeSecurityHash(sasl, rotated); got == base {
+		t.Error("expected hash to change when a secret's data changes")
+	}
+
+	renamed := tls.DeepCopy()
+	renamed.Name = "tls-other"
+	if got := computeSecurityHash(sasl, renamed); got == base {
+		t.Error("expected hash to change when a different secret is referenced")
+	}
+}
+
+func TestComputeSecurityHash_IgnoresMetadataOnlyUpdate(t *testing.T) {
+	tls := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "tls", ResourceVersion: "20"},
+		Data:       map[string][]byte{"tls.crt": []byte("cert"), "tls.key": []byte("key")},
+	}
+	base := computeSecurityHash(tls)
+
+	relabeled := tls.DeepCopy()
+	relabeled.ResourceVersion = "21"
+	relabeled.Labels = map[string]string{"team": "cache"}
+	relabeled.Annotations = map[string]string{"reflector.v1.k8s.emberstack.com/reflected-at": "now"}
+	if got := computeSecurityHash(relabeled); got != base {
+		t.Errorf("metadata-only update changed the hash: %q != %q", got, base)
+	}
+}
+
 // ---------------------------------------------------------------------------
 // fetchReferencedSecrets tests
 // ---------------------------------------------------------------------------
@@ -159,6 +214,35 @@ func TestFetchReferencedSecrets_BothExist(t *testing.T) {
 	}
 }
 
+func TestFetchReferencedSecrets_ExporterTLS(t *testing.T) {
+	exporterSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "exporter-tls", Namespace: "default"},
+		Data:       map[string][]byte{"tls.crt": []byte("cert")},
+	}
+	c := fake.NewClientBuilder().WithScheme(testScheme()).WithObjects(exporterSecret).Build()
+
+	mc := &memcachedv1beta1.Memcached{
+		ObjectMeta: metav1.ObjectMeta{Name: "mc", Namespace: "default"},
+		Spec: memcachedv1beta1.MemcachedSpec{
+			Monitoring: &memcachedv1beta1.MonitoringSpec{
+				Enabled: true,
+				ExporterTLS: &memcachedv1beta1.ExporterTLSSpec{
+					Enabled:              true,
+					CertificateSecretRef: corev1.LocalObjectReference{Name: "exporter-tls"},
+				},
+			},
+		},
+	}
+
+	found, missing := fetchReferencedSecrets(context.Background(), c, mc)
+	if len(found) != 1 {
+		t.Errorf("expected 1 found secret, got %d", len(found))
+	}
+	if len(missing) != 0 {
+		t.Errorf("expected no missing secrets, got %v", missing)
+	}
+}
+
 func TestFetchReferencedSecrets_SASLMissing(t *testing.T) {
 	tlsSecret := &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{Name: "tls-secret", Namespace: "default"},
@@ -352,6 +436,33 @@ func TestMapSecretToMemcached_SASLRef(t *testing.T) {
 	}
 }
 
+func TestMapSecretToMemcached_SASLNameTemplate(t *testing.T) {
+	tmpl := "{{ .Name }}-sasl"
+	mc := &memcachedv1beta1.Memcached{
+		ObjectMeta: metav1.ObjectMeta{Name: "mc1", Namespace: "default"},
+		Spec: memcachedv1beta1.MemcachedSpec{
+			Security: &memcachedv1beta1.SecuritySpec{
+				SASL: &memcachedv1beta1.SASLSpec{
+					Enabled:                       true,
+					CredentialsSecretNameTemplate: &tmpl,
+				},
+			},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(testScheme()).WithObjects(mc).Build()
+
+	mapFn := mapSecretToMemcached(c)
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "mc1-sasl", Namespace: "default"}}
+	requests := mapFn(context.Background(), secret)
+
+	if len(requests) != 1 {
+		t.Fatalf("expected 1 request, got %d", len(requests))
+	}
+	if requests[0].Name != "mc1" || requests[0].Namespace != "default" {
+		t.Errorf("unexpected request: %v", requests[0])
+	}
+}
+
 func TestMapSecretToMemcached_TLSRef(t *testing.T) {
 	mc := &memcachedv1beta1.Memcached{
 		ObjectMeta: metav1.ObjectMeta{Name: "mc1", Namespace: "default"},
@@ -378,6 +489,33 @@ func TestMapSecretToMemcached_TLSRef(t *testing.T) {
 	}
 }
 
+func TestMapSecretToMemcached_ExporterTLSRef(t *testing.T) {
+	mc := &memcachedv1beta1.Memcached{
+		ObjectMeta: metav1.ObjectMeta{Name: "mc1", Namespace: "default"},
+		Spec: memcachedv1beta1.MemcachedSpec{
+			Monitoring: &memcachedv1beta1.MonitoringSpec{
+				Enabled: true,
+				ExporterTLS: &memcachedv1beta1.ExporterTLSSpec{
+					Enabled:              true,
+					CertificateSecretRef: corev1.LocalObjectReference{Name: "exporter-tls"},
+				},
+			},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(testScheme()).WithObjects(mc).Build()
+
+	mapFn := mapSecretToMemcached(c)
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "exporter-tls", Namespace: "default"}}
+	requests := mapFn(context.Background(), secret)
+
+	if len(requests) != 1 {
+		t.Fatalf("expected 1 request, got %d", len(requests))
+	}
+	if requests[0].Name != "mc1" || requests[0].Namespace != "default" {
+		t.Errorf("unexpected request: %v", requests[0])
+	}
+}
+
 func TestMapSecretToMemcached_Unreferenced(t *testing.T) {
 	mc := &memcachedv1beta1.Memcached{
 		ObjectMeta: metav1.ObjectMeta{Name: "mc1", Namespace: "default"},
@@ -481,3 +619,220 @@ func TestMapSecretToMemcached_MultipleCRs(t *testing.T) {
 		t.Errorf("expected requests for mc1 and mc2, got %v", requests)
 	}
 }
+
+// ---------------------------------------------------------------------------
+// TLS Secret copy tests
+// ---------------------------------------------------------------------------
+
+func newTLSCopyMemcached(sourceNamespace string) *memcachedv1beta1.Memcached {
+	return &memcachedv1beta1.Memcached{
+		ObjectMeta: metav1.ObjectMeta{Name: "mc", Namespace: "default", UID: "uid-1"},
+		Spec: memcachedv1beta1.MemcachedSpec{
+			Security: &memcachedv1beta1.SecuritySpec{
+				TLS: &memcachedv1beta1.TLSSpec{
+					Enabled:              true,
+					CertificateSecretRef: corev1.LocalObjectReference{Name: "tls-cert"},
+					CopyFromNamespace:    &sourceNamespace,
+				},
+			},
+		},
+	}
+}
+
+func TestTLSCopySource(t *testing.T) {
+	tests := []struct {
+		name   string
+		mc     *memcachedv1beta1.Memcached
+		wantOK bool
+	}{
+		{name: "other namespace", mc: newTLSCopyMemcached("certs"), wantOK: true},
+		{name: "same namespace", mc: newTLSCopyMemcached("default"), wantOK: false},
+		{name: "empty namespace", mc: newTLSCopyMemcached(""), wantOK: false},
+		{
+			name: "TLS disabled",
+			mc: func() *memcachedv1beta1.Memcached {
+				mc := newTLSCopyMemcached("certs")
+				mc.Spec.Security.TLS.Enabled = false
+				return mc
+			}(),
+			wantOK: false,
+		},
+		{name: "nil security", mc: &memcachedv1beta1.Memcached{}, wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src, ok := tlsCopySource(tt.mc)
+			if ok != tt.wantOK {
+				t.Fatalf("tlsCopySource() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if ok && (src.Namespace != "certs" || src.Name != "tls-cert") {
+				t.Errorf("unexpected source: %v", src)
+			}
+		})
+	}
+}
+
+func TestReconcileTLSSecretCopy_CopiesAndSyncs(t *testing.T) {
+	source := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "tls-cert",
+			Namespace:   "certs",
+			Annotations: map[string]string{AnnotationCopyAllowedTo: "default"},
+		},
+		Type: corev1.SecretTypeTLS,
+		Data: map[string][]byte{"tls.crt": []byte("cert-v1"), "tls.key": []byte("key")},
+	}
+	mc := newTLSCopyMemcached("certs")
+	c := newFakeClient(mc, source)
+	r := newTestReconciler(c)
+	r.TLSCopySourceNamespaces = []string{"certs"}
+	ctx := context.Background()
+
+	missing, err := r.reconcileTLSSecretCopy(ctx, mc)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(missing) != 0 {
+		t.Fatalf("expected no missing sources, got %v", missing)
+	}
+
+	copied := &corev1.Secret{}
+	if err := c.Get(ctx, types.NamespacedName{Name: "tls-cert", Namespace: "default"}, copied); err != nil {
+		t.Fatalf("failed to get copied Secret: %v", err)
+	}
+	if copied.Type != corev1.SecretTypeTLS {
+		t.Errorf("copied type = %q, want %q", copied.Type, corev1.SecretTypeTLS)
+	}
+	if string(copied.Data["tls.crt"]) != "cert-v1" {
+		t.Errorf("copied tls.crt = %q, want %q", copied.Data["tls.crt"], "cert-v1")
+	}
+	if !metav1.IsControlledBy(copied, mc) {
+		t.Error("expected copied Secret to be controlled by the Memcached CR")
+	}
+
+	// Rotate the source and reconcile again.
+	source.Data["tls.crt"] = []byte("cert-v2")
+	if err := c.Update(ctx, source); err != nil {
+		t.Fatalf("failed to update source Secret: %v", err)
+	}
+	if _, err := r.reconcileTLSSecretCopy(ctx, mc); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := c.Get(ctx, types.NamespacedName{Name: "tls-cert", Namespace: "default"}, copied); err != nil {
+		t.Fatalf("failed to get copied Secret: %v", err)
+	}
+	if string(copied.Data["tls.crt"]) != "cert-v2" {
+		t.Errorf("copied tls.crt = %q, want %q after rotation", copied.Data["tls.crt"], "cert-v2")
+	}
+}
+
+func TestReconcileTLSSecretCopy_SourceMissing(t *testing.T) {
+	mc := newTLSCopyMemcached("certs")
+	r := newTestReconciler(newFakeClient(mc))
+	r.TLSCopySourceNamespaces = []string{"certs"}
+
+	missing, err := r.reconcileTLSSecretCopy(context.Background(), mc)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(missing) != 1 || missing[0] != "certs/tls-cert" {
+		t.Errorf("missing = %v, want [certs/tls-cert]", missing)
+	}
+}
+
+func TestReconcileTLSSecretCopy_RefusesUnownedSecret(t *testing.T) {
+	source := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "tls-cert",
+			Namespace:   "certs",
+			Annotations: map[string]string{AnnotationCopyAllowedTo: "default"},
+		},
+		Data: map[string][]byte{"tls.crt": []byte("cert")},
+	}
+	existing := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "tls-cert", Namespace: "default"},
+		Data:       map[string][]byte{"tls.crt": []byte("user-managed")},
+	}
+	mc := newTLSCopyMemcached("certs")
+	c := newFakeClient(mc, source, existing)
+	r := newTestReconciler(c)
+	r.TLSCopySourceNamespaces = []string{"certs"}
+
+	if _, err := r.reconcileTLSSecretCopy(context.Background(), mc); err == nil {
+		t.Fatal("expected error when a same-named unowned Secret exists")
+	}
+
+	got := &corev1.Secret{}
+	if err := c.Get(context.Background(), client.ObjectKeyFromObject(existing), got); err != nil {
+		t.Fatalf("failed to get Secret: %v", err)
+	}
+	if string(got.Data["tls.crt"]) != "user-managed" {
+		t.Errorf("unowned Secret was overwritten: %q", got.Data["tls.crt"])
+	}
+}
+
+func TestReconcileTLSSecretCopy_RefusesDisallowedSource(t *testing.T) {
+	tests := []struct {
+		name        string
+		allowed     []string
+		annotations map[string]string
+	}{
+		{name: "copying disabled", annotations: map[string]string{AnnotationCopyAllowedTo: "default"}},
+		{name: "namespace not allowlisted", allowed: []string{"other"}, annotations: map[string]string{AnnotationCopyAllowedTo: "default"}},
+		{name: "source without opt-in annotation", allowed: []string{"certs"}},
+		{name: "source opted in for another namespace", allowed: []string{"certs"}, annotations: map[string]string{AnnotationCopyAllowedTo: "prod, staging"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "tls-cert", Namespace: "certs", Annotations: tt.annotations},
+				Data:       map[string][]byte{"tls.crt": []byte("cert")},
+			}
+			mc := newTLSCopyMemcached("certs")
+			c := newFakeClient(mc, source)
+			r := newTestReconciler(c)
+			r.TLSCopySourceNamespaces = tt.allowed
+
+			if _, err := r.reconcileTLSSecretCopy(context.Background(), mc); err == nil {
+				t.Fatal("expected the copy to be refused")
+			}
+			err := c.Get(context.Background(), types.NamespacedName{Name: "tls-cert", Namespace: "default"}, &corev1.Secret{})
+			if !apierrors.IsNotFound(err) {
+				t.Errorf("expected no copy, got err=%v", err)
+			}
+		})
+	}
+}
+
+func TestCopyAllowedTo(t *testing.T) {
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+		Annotations: map[string]string{AnnotationCopyAllowedTo: "prod, default"},
+	}}
+	if !copyAllowedTo(secret, "default") {
+		t.Error("expected copies to default to be allowed")
+	}
+	if copyAllowedTo(secret, "kube-system") {
+		t.Error("expected copies to kube-system to be refused")
+	}
+	if copyAllowedTo(&corev1.Secret{}, "default") {
+		t.Error("expected a Secret without the annotation to refuse copies")
+	}
+}
+
+func TestMapSecretToMemcached_CopySource(t *testing.T) {
+	mc := newTLSCopyMemcached("certs")
+	c := fake.NewClientBuilder().WithScheme(testScheme()).WithObjects(mc).Build()
+
+	mapFn := mapSecretToMemcached(c)
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "tls-cert", Namespace: "certs"}}
+	requests := mapFn(context.Background(), secret)
+
+	if len(requests) != 1 {
+		t.Fatalf("expected 1 request, got %d", len(requests))
+	}
+	if requests[0].Name != "mc" || requests[0].Namespace != "default" {
+		t.Errorf("unexpected request: %v", requests[0])
+	}
+}
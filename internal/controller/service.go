@@ -2,28 +2,53 @@
 package controller
 
 import (
+	"context"
+	"fmt"
+
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	memcachedv1beta1 "github.com/c5c3/memcached-operator/api/v1beta1"
 )
 
-// constructService sets the desired state of the headless Service based on the Memcached CR spec.
+// constructService sets the desired state of the Service based on the Memcached CR spec.
 // It mutates svc in-place and is designed to be called from within controllerutil.CreateOrUpdate.
 func constructService(mc *memcachedv1beta1.Memcached, svc *corev1.Service) {
 	labels := labelsForMemcached(mc.Name)
 
-	svc.Labels = labels
+	svc.Labels = withPropagatedLabels(mc, labels)
 
-	// Apply custom annotations from spec.service.annotations if present.
-	if mc.Spec.Service != nil && len(mc.Spec.Service.Annotations) > 0 {
-		svc.Annotations = mc.Spec.Service.Annotations
-	} else {
-		svc.Annotations = nil
+	// Apply custom annotations from spec.service.annotations if present; they take
+	// precedence over annotations propagated from the Memcached resource.
+	var annotations map[string]string
+	if mc.Spec.Service != nil {
+		annotations = mc.Spec.Service.Annotations
 	}
+	svc.Annotations = applyTopologyAwareHints(mc, applyReadOnlyAnnotation(mc, withPropagatedAnnotations(mc, annotations)))
 
-	svc.Spec.ClusterIP = corev1.ClusterIPNone
+	if mc.IsServiceHeadless() {
+		// spec.service.trafficDistribution is intentionally not applied: kube-proxy
+		// does not route traffic for headless Services, so the preference has no effect.
+		svc.Spec.ClusterIP = corev1.ClusterIPNone
+	} else {
+		// The clusterIP is left empty on create, so the API server allocates one,
+		// and is kept as allocated on update.
+		var trafficDistribution *string
+		if mc.Spec.Service != nil {
+			trafficDistribution = mc.Spec.Service.TrafficDistribution
+		}
+		svc.Spec.TrafficDistribution = trafficDistribution
+	}
 	svc.Spec.Selector = labels
+	// Only ready pods are published, so DNS and EndpointSlice consumers never see
+	// pods that fail their readiness probe.
+	svc.Spec.PublishNotReadyAddresses = false
 	ports := []corev1.ServicePort{
 		{
 			Name:       "memcached",
@@ -36,7 +61,7 @@ func constructService(mc *memcachedv1beta1.Memcached, svc *corev1.Service) {
 	if mc.IsTLSEnabled() {
 		ports = append(ports, corev1.ServicePort{
 			Name:       tlsPortName,
-			Port:       PortMemcachedTLS,
+			Port:       mc.TLSPort(),
 			TargetPort: intstr.FromString(tlsPortName),
 			Protocol:   corev1.ProtocolTCP,
 		})
@@ -51,5 +76,108 @@ func constructService(mc *memcachedv1beta1.Memcached, svc *corev1.Service) {
 		})
 	}
 
+	if mc.IsStatsSidecarEnabled() {
+		ports = append(ports, corev1.ServicePort{
+			Name:       statsPortName,
+			Port:       mc.StatsSidecarPort(),
+			TargetPort: intstr.FromString(statsPortName),
+			Protocol:   corev1.ProtocolTCP,
+		})
+	}
+
 	svc.Spec.Ports = ports
 }
+
+// applyTopologyAwareHints sets the topology-aware hints annotations in annotations
+// to "auto" when spec.service.topologyAwareHints is enabled. Both the deprecated
+// service.kubernetes.io/topology-aware-hints and its successor
+// service.kubernetes.io/topology-mode are set, so hints are enabled regardless of
+// the cluster version. When disabled, annotations is returned unchanged, so keys
+// set through spec.service.annotations are kept.
+func applyTopologyAwareHints(mc *memcachedv1beta1.Memcached, annotations map[string]string) map[string]string {
+	if mc.Spec.Service == nil || !mc.Spec.Service.TopologyAwareHints {
+		return annotations
+	}
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[corev1.DeprecatedAnnotationTopologyAwareHints] = "auto"
+	annotations[corev1.AnnotationTopologyMode] = "Auto"
+	return annotations
+}
+
+// deleteServiceOnTypeChange deletes the existing Service of mc when it is headless
+// but spec.service.type asks for a ClusterIP Service, or vice versa. The clusterIP
+// of a Service is immutable, so the Service must be recreated to change its type;
+// reconcileService creates the replacement right after. Services not controlled by
+// mc are left untouched.
+func (r *MemcachedReconciler) deleteServiceOnTypeChange(ctx context.Context, mc *memcachedv1beta1.Memcached) error {
+	existing := &corev1.Service{}
+	err := r.Get(ctx, types.NamespacedName{Name: mc.Name, Namespace: mc.Namespace}, existing)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("fetching Service: %w", err)
+	}
+	if !metav1.IsControlledBy(existing, mc) {
+		return nil
+	}
+	if (existing.Spec.ClusterIP == corev1.ClusterIPNone) == mc.IsServiceHeadless() {
+		return nil
+	}
+	log.FromContext(ctx).Info("Recreating Service to change its type",
+		"name", existing.Name, "headless", mc.IsServiceHeadless())
+	return r.deleteOwnedResource(ctx, mc, existing, "Service")
+}
+
+// releaseOwnedService deletes the Service the operator created for mc, or orphans
+// it when spec.retainOrphansOnDisable is set, after spec.service.manage was set to
+// false. A Service of the same name that mc does not control is left alone.
+func (r *MemcachedReconciler) releaseOwnedService(ctx context.Context, mc *memcachedv1beta1.Memcached) error {
+	svc := &corev1.Service{}
+	if err := r.Get(ctx, client.ObjectKey{Name: mc.Name, Namespace: mc.Namespace}, svc); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("fetching Service: %w", err)
+	}
+	if !metav1.IsControlledBy(svc, mc) {
+		return nil
+	}
+	return r.disableOwnedResource(ctx, mc, svc, "Service")
+}
+
+// hasMatchingService reports whether a Service in mc's namespace selects the
+// Memcached pods, i.e. has a non-empty selector that is a subset of the instance
+// labels. It is used when spec.service.manage is false and the Service is
+// managed outside the operator, so a Service still controlled by mc does not count.
+func (r *MemcachedReconciler) hasMatchingService(ctx context.Context, mc *memcachedv1beta1.Memcached) (bool, error) {
+	services := &corev1.ServiceList{}
+	if err := r.List(ctx, services, client.InNamespace(mc.Namespace)); err != nil {
+		return false, fmt.Errorf("listing services: %w", err)
+	}
+	podLabels := labels.Set(labelsForMemcached(mc.Name))
+	for _, svc := range services.Items {
+		if metav1.IsControlledBy(&svc, mc) {
+			continue
+		}
+		if len(svc.Spec.Selector) > 0 && labels.SelectorFromSet(svc.Spec.Selector).Matches(podLabels) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// serviceMissingCondition returns the Degraded condition reported when
+// spec.service.manage is false and no Service selects the Memcached pods.
+func serviceMissingCondition(mc *memcachedv1beta1.Memcached) *metav1.Condition {
+	return &metav1.Condition{
+		Type:   ConditionTypeDegraded,
+		Status: metav1.ConditionTrue,
+		Reason: ConditionReasonServiceMissing,
+		Message: fmt.Sprintf("spec.service.manage is false and no Service in namespace %s selects the Memcached pods",
+			mc.Namespace),
+		ObservedGeneration: mc.Generation,
+	}
+}
@@ -2,12 +2,15 @@
 package controller
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	memcachedv1beta1 "github.com/c5c3/memcached-operator/api/v1beta1"
 )
@@ -84,6 +87,19 @@ func TestConstructService_ClusterIPNone(t *testing.T) {
 	}
 }
 
+func TestConstructService_DoesNotPublishNotReadyAddresses(t *testing.T) {
+	mc := &memcachedv1beta1.Memcached{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+	}
+	svc := &corev1.Service{Spec: corev1.ServiceSpec{PublishNotReadyAddresses: true}}
+
+	constructService(mc, svc)
+
+	if svc.Spec.PublishNotReadyAddresses {
+		t.Error("expected publishNotReadyAddresses to be reset to false")
+	}
+}
+
 func TestConstructService_PortConfig(t *testing.T) {
 	mc := &memcachedv1beta1.Memcached{
 		ObjectMeta: metav1.ObjectMeta{Name: "port-test", Namespace: "default"},
@@ -336,6 +352,34 @@ func TestConstructService_TLSEnabled(t *testing.T) {
 	}
 }
 
+func TestConstructService_CustomTLSPort(t *testing.T) {
+	mc := &memcachedv1beta1.Memcached{
+		ObjectMeta: metav1.ObjectMeta{Name: "tls-svc-port", Namespace: "default"},
+		Spec: memcachedv1beta1.MemcachedSpec{
+			Security: &memcachedv1beta1.SecuritySpec{
+				TLS: &memcachedv1beta1.TLSSpec{
+					Enabled:              true,
+					CertificateSecretRef: corev1.LocalObjectReference{Name: "my-tls-secret"},
+					Port:                 int32Ptr(12345),
+				},
+			},
+		},
+	}
+	svc := &corev1.Service{}
+
+	constructService(mc, svc)
+
+	if len(svc.Spec.Ports) != 2 {
+		t.Fatalf("expected 2 ports, got %d", len(svc.Spec.Ports))
+	}
+	if svc.Spec.Ports[1].Name != tlsPortName || svc.Spec.Ports[1].Port != 12345 {
+		t.Errorf("second port = %s:%d, want %s:12345", svc.Spec.Ports[1].Name, svc.Spec.Ports[1].Port, tlsPortName)
+	}
+	if svc.Spec.Ports[1].TargetPort != intstr.FromString(tlsPortName) {
+		t.Errorf("second targetPort = %v, want %q", svc.Spec.Ports[1].TargetPort, tlsPortName)
+	}
+}
+
 func TestConstructService_TLSDisabled(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -597,3 +641,297 @@ func TestConstructService_Idempotent(t *testing.T) {
 		t.Errorf("Annotations changed: got %v, want %v", svc.Annotations, firstAnnotations)
 	}
 }
+
+func TestConstructService_TrafficDistributionIgnoredForHeadless(t *testing.T) {
+	mc := &memcachedv1beta1.Memcached{
+		ObjectMeta: metav1.ObjectMeta{Name: "td", Namespace: "default"},
+		Spec: memcachedv1beta1.MemcachedSpec{
+			Service: &memcachedv1beta1.ServiceSpec{
+				TrafficDistribution: stringPtr(corev1.ServiceTrafficDistributionPreferClose),
+			},
+		},
+	}
+	svc := &corev1.Service{}
+
+	constructService(mc, svc)
+
+	if svc.Spec.ClusterIP != corev1.ClusterIPNone {
+		t.Errorf("clusterIP = %q, want %q", svc.Spec.ClusterIP, corev1.ClusterIPNone)
+	}
+	if svc.Spec.TrafficDistribution != nil {
+		t.Errorf("trafficDistribution = %q, want nil for headless Service", *svc.Spec.TrafficDistribution)
+	}
+}
+
+func TestConstructService_ClusterIPType(t *testing.T) {
+	mc := &memcachedv1beta1.Memcached{
+		ObjectMeta: metav1.ObjectMeta{Name: "cip", Namespace: "default"},
+		Spec: memcachedv1beta1.MemcachedSpec{
+			Service: &memcachedv1beta1.ServiceSpec{
+				Type:                stringPtr(memcachedv1beta1.ServiceTypeClusterIP),
+				TrafficDistribution: stringPtr(corev1.ServiceTrafficDistributionPreferClose),
+			},
+		},
+	}
+
+	svc := &corev1.Service{}
+	constructService(mc, svc)
+	if svc.Spec.ClusterIP != "" {
+		t.Errorf("clusterIP = %q, want empty so that one is allocated", svc.Spec.ClusterIP)
+	}
+	if svc.Spec.TrafficDistribution == nil || *svc.Spec.TrafficDistribution != corev1.ServiceTrafficDistributionPreferClose {
+		t.Errorf("trafficDistribution = %v, want %q", svc.Spec.TrafficDistribution, corev1.ServiceTrafficDistributionPreferClose)
+	}
+
+	// An allocated clusterIP is kept on update.
+	svc = &corev1.Service{Spec: corev1.ServiceSpec{ClusterIP: "10.96.0.42"}}
+	constructService(mc, svc)
+	if svc.Spec.ClusterIP != "10.96.0.42" {
+		t.Errorf("clusterIP = %q, want the allocated 10.96.0.42", svc.Spec.ClusterIP)
+	}
+}
+
+func TestConstructService_TopologyAwareHints(t *testing.T) {
+	mc := &memcachedv1beta1.Memcached{
+		ObjectMeta: metav1.ObjectMeta{Name: "hints", Namespace: "default"},
+		Spec: memcachedv1beta1.MemcachedSpec{
+			Service: &memcachedv1beta1.ServiceSpec{
+				Annotations:        map[string]string{"custom": "value"},
+				TopologyAwareHints: true,
+			},
+		},
+	}
+	svc := &corev1.Service{}
+
+	constructService(mc, svc)
+
+	if got := svc.Annotations[corev1.DeprecatedAnnotationTopologyAwareHints]; got != "auto" {
+		t.Errorf("%s = %q, want auto", corev1.DeprecatedAnnotationTopologyAwareHints, got)
+	}
+	if got := svc.Annotations[corev1.AnnotationTopologyMode]; got != "Auto" {
+		t.Errorf("%s = %q, want Auto", corev1.AnnotationTopologyMode, got)
+	}
+	if svc.Annotations["custom"] != "value" {
+		t.Error("custom annotation was dropped")
+	}
+	if _, ok := mc.Spec.Service.Annotations[corev1.DeprecatedAnnotationTopologyAwareHints]; ok {
+		t.Error("spec.service.annotations was modified")
+	}
+
+	mc.Spec.Service.TopologyAwareHints = false
+	constructService(mc, svc)
+	if _, ok := svc.Annotations[corev1.DeprecatedAnnotationTopologyAwareHints]; ok {
+		t.Error("topology-aware-hints annotation still set after disabling")
+	}
+	if _, ok := svc.Annotations[corev1.AnnotationTopologyMode]; ok {
+		t.Error("topology-mode annotation still set after disabling")
+	}
+}
+
+func TestConstructService_StatsSidecarWithMonitoring(t *testing.T) {
+	statsImage := "example.com/memcached-stats:1.0"
+	mc := &memcachedv1beta1.Memcached{
+		ObjectMeta: metav1.ObjectMeta{Name: "stats-mon", Namespace: "default"},
+		Spec: memcachedv1beta1.MemcachedSpec{
+			Monitoring:   &memcachedv1beta1.MonitoringSpec{Enabled: true},
+			StatsSidecar: &memcachedv1beta1.StatsSidecarSpec{Enabled: true, Image: &statsImage, Port: int32Ptr(8081)},
+		},
+	}
+	svc := &corev1.Service{}
+
+	constructService(mc, svc)
+
+	if len(svc.Spec.Ports) != 3 {
+		t.Fatalf("expected 3 ports, got %d", len(svc.Spec.Ports))
+	}
+	if svc.Spec.Ports[1].Name != "metrics" || svc.Spec.Ports[1].Port != 9150 {
+		t.Errorf("port[1] = %s:%d, want metrics:9150", svc.Spec.Ports[1].Name, svc.Spec.Ports[1].Port)
+	}
+	stats := svc.Spec.Ports[2]
+	if stats.Name != statsPortName || stats.Port != 8081 || stats.TargetPort.String() != statsPortName {
+		t.Errorf("port[2] = %+v, want stats:8081 targeting %q", stats, statsPortName)
+	}
+}
+
+func TestHasMatchingService(t *testing.T) {
+	mc := &memcachedv1beta1.Memcached{
+		ObjectMeta: metav1.ObjectMeta{Name: testInstanceName, Namespace: testDefaultNamespace},
+	}
+	service := func(name, namespace string, selector map[string]string) *corev1.Service {
+		return &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec:       corev1.ServiceSpec{Selector: selector},
+		}
+	}
+
+	tests := []struct {
+		name string
+		svc  *corev1.Service
+		want bool
+	}{
+		{name: "no Service", want: false},
+		{
+			name: "selector is a subset of the instance labels",
+			svc:  service("shared", testDefaultNamespace, map[string]string{"app.kubernetes.io/instance": testInstanceName}),
+			want: true,
+		},
+		{
+			name: "selector matches the full instance labels",
+			svc:  service("shared", testDefaultNamespace, labelsForMemcached(testInstanceName)),
+			want: true,
+		},
+		{
+			name: "selector targets another instance",
+			svc:  service("shared", testDefaultNamespace, map[string]string{"app.kubernetes.io/instance": "other"}),
+			want: false,
+		},
+		{
+			name: "Service without selector",
+			svc:  service("shared", testDefaultNamespace, nil),
+			want: false,
+		},
+		{
+			name: "Service in another namespace",
+			svc:  service("shared", "elsewhere", labelsForMemcached(testInstanceName)),
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newFakeClient()
+			if tt.svc != nil {
+				c = newFakeClient(tt.svc)
+			}
+			r := newTestReconciler(c)
+			got, err := r.hasMatchingService(context.Background(), mc)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("hasMatchingService() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReconcileService_Unmanaged(t *testing.T) {
+	manage := false
+	mc := &memcachedv1beta1.Memcached{
+		ObjectMeta: metav1.ObjectMeta{Name: testInstanceName, Namespace: testDefaultNamespace},
+		Spec: memcachedv1beta1.MemcachedSpec{
+			Service: &memcachedv1beta1.ServiceSpec{Manage: &manage},
+		},
+	}
+	c := newFakeClient(mc)
+	r := newTestReconciler(c)
+
+	if err := r.reconcileService(context.Background(), mc); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	services := &corev1.ServiceList{}
+	if err := c.List(context.Background(), services); err != nil {
+		t.Fatalf("listing services: %v", err)
+	}
+	if len(services.Items) != 0 {
+		t.Errorf("expected no Service to be created, got %d", len(services.Items))
+	}
+}
+
+func TestReconcileService_RecreatesOnTypeChange(t *testing.T) {
+	mc := &memcachedv1beta1.Memcached{
+		ObjectMeta: metav1.ObjectMeta{Name: testInstanceName, Namespace: testDefaultNamespace, UID: "abc-123"},
+	}
+	c := newFakeClient(mc)
+	r := newTestReconciler(c)
+	ctx := context.Background()
+	key := client.ObjectKeyFromObject(mc)
+
+	if err := r.reconcileService(ctx, mc); err != nil {
+		t.Fatalf("reconcileService: %v", err)
+	}
+	svc := &corev1.Service{}
+	if err := c.Get(ctx, key, svc); err != nil {
+		t.Fatalf("getting Service: %v", err)
+	}
+	if svc.Spec.ClusterIP != corev1.ClusterIPNone {
+		t.Fatalf("clusterIP = %q, want %q", svc.Spec.ClusterIP, corev1.ClusterIPNone)
+	}
+
+	mc.Spec.Service = &memcachedv1beta1.ServiceSpec{Type: stringPtr(memcachedv1beta1.ServiceTypeClusterIP)}
+	mc.Status.RecentActions = nil
+	if err := r.reconcileService(ctx, mc); err != nil {
+		t.Fatalf("reconcileService: %v", err)
+	}
+	if err := c.Get(ctx, key, svc); err != nil {
+		t.Fatalf("getting Service: %v", err)
+	}
+	if svc.Spec.ClusterIP == corev1.ClusterIPNone {
+		t.Error("Service is still headless after switching to ClusterIP")
+	}
+	var actions []string
+	for _, a := range mc.Status.RecentActions {
+		actions = append(actions, a.Action)
+	}
+	if want := []string{ActionDeleted, ActionCreated}; !reflect.DeepEqual(actions, want) {
+		t.Errorf("actions = %v, want %v", actions, want)
+	}
+
+	// Reconciling again without a type change keeps the Service.
+	mc.Status.RecentActions = nil
+	if err := r.reconcileService(ctx, mc); err != nil {
+		t.Fatalf("reconcileService: %v", err)
+	}
+	if len(mc.Status.RecentActions) != 0 {
+		t.Errorf("unexpected actions without a type change: %v", mc.Status.RecentActions)
+	}
+}
+
+func TestReconcileService_ManageFlippedToFalse(t *testing.T) {
+	mc := &memcachedv1beta1.Memcached{
+		ObjectMeta: metav1.ObjectMeta{Name: testInstanceName, Namespace: testDefaultNamespace, UID: "abc-123"},
+	}
+	c := newFakeClient(mc)
+	r := newTestReconciler(c)
+	ctx := context.Background()
+	key := client.ObjectKeyFromObject(mc)
+
+	if err := r.reconcileService(ctx, mc); err != nil {
+		t.Fatalf("reconcileService: %v", err)
+	}
+	if err := c.Get(ctx, key, &corev1.Service{}); err != nil {
+		t.Fatalf("getting Service: %v", err)
+	}
+
+	// The operator's own Service must not satisfy the externally managed check.
+	manage := false
+	mc.Spec.Service = &memcachedv1beta1.ServiceSpec{Manage: &manage}
+	if found, err := r.hasMatchingService(ctx, mc); err != nil || found {
+		t.Errorf("hasMatchingService() = %v, %v; want false for the operator-owned Service", found, err)
+	}
+
+	if err := r.reconcileService(ctx, mc); err != nil {
+		t.Fatalf("reconcileService: %v", err)
+	}
+	if err := c.Get(ctx, key, &corev1.Service{}); !apierrors.IsNotFound(err) {
+		t.Errorf("expected the owned Service to be deleted after manage=false, got err=%v", err)
+	}
+
+	// A Service of the same name managed by someone else is left alone and counts.
+	external := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: testInstanceName, Namespace: testDefaultNamespace},
+		Spec:       corev1.ServiceSpec{Selector: labelsForMemcached(testInstanceName)},
+	}
+	if err := c.Create(ctx, external); err != nil {
+		t.Fatalf("creating external Service: %v", err)
+	}
+	if err := r.reconcileService(ctx, mc); err != nil {
+		t.Fatalf("reconcileService: %v", err)
+	}
+	if err := c.Get(ctx, key, &corev1.Service{}); err != nil {
+		t.Errorf("external Service was removed: %v", err)
+	}
+	if found, err := r.hasMatchingService(ctx, mc); err != nil || !found {
+		t.Errorf("hasMatchingService() = %v, %v; want true for the external Service", found, err)
+	}
+}
@@ -3,6 +3,7 @@ package controller
 
 import (
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	memcachedv1beta1 "github.com/c5c3/memcached-operator/api/v1beta1"
@@ -29,7 +30,8 @@ func constructServiceMonitor(mc *memcachedv1beta1.Memcached, sm *monitoringv1.Se
 		labels[k] = v
 	}
 
-	sm.Labels = labels
+	sm.Labels = withPropagatedLabels(mc, labels)
+	sm.Annotations = mergePropagatedAnnotations(mc, sm.Annotations)
 	sm.Spec.Selector = metav1.LabelSelector{
 		MatchLabels: labelsForMemcached(mc.Name),
 	}
@@ -49,11 +51,53 @@ func constructServiceMonitor(mc *memcachedv1beta1.Memcached, sm *monitoringv1.Se
 		}
 	}
 
-	sm.Spec.Endpoints = []monitoringv1.Endpoint{
-		{
-			Port:          "metrics",
-			Interval:      interval,
-			ScrapeTimeout: scrapeTimeout,
-		},
+	// Only the exporter's metrics port is scraped. The stats sidecar serves JSON,
+	// not the Prometheus exposition format, so it gets no endpoint.
+	endpoint := monitoringv1.Endpoint{
+		Port:          "metrics",
+		Interval:      interval,
+		ScrapeTimeout: scrapeTimeout,
 	}
+
+	if smSpec != nil {
+		if smSpec.HonorLabels != nil {
+			endpoint.HonorLabels = *smSpec.HonorLabels
+		}
+		endpoint.HonorTimestamps = smSpec.HonorTimestamps
+	}
+
+	// Scrape over HTTPS when the exporter serves /metrics with TLS.
+	if mc.IsExporterTLSEnabled() {
+		scheme := monitoringv1.SchemeHTTPS
+		endpoint.Scheme = &scheme
+		endpoint.TLSConfig = exporterScrapeTLSConfig(mc.Spec.Monitoring.ExporterTLS)
+	}
+
+	sm.Spec.Endpoints = []monitoringv1.Endpoint{endpoint}
+}
+
+// exporterScrapeTLSConfig builds the TLS settings Prometheus uses to verify the
+// exporter's serving certificate. Without a CA, server name or insecureSkipVerify
+// it returns nil, leaving verification against Prometheus' system roots.
+func exporterScrapeTLSConfig(exporterTLS *memcachedv1beta1.ExporterTLSSpec) *monitoringv1.TLSConfig {
+	var tlsConfig monitoringv1.SafeTLSConfig
+	switch {
+	case exporterTLS.CASecretRef != nil:
+		tlsConfig.CA.Secret = exporterTLS.CASecretRef.DeepCopy()
+	case exporterTLS.CAConfigMapRef != nil:
+		tlsConfig.CA.ConfigMap = exporterTLS.CAConfigMapRef.DeepCopy()
+	}
+	if exporterTLS.ServerName != "" {
+		serverName := exporterTLS.ServerName
+		tlsConfig.ServerName = &serverName
+	}
+	if exporterTLS.InsecureSkipVerify {
+		insecureSkipVerify := true
+		tlsConfig.InsecureSkipVerify = &insecureSkipVerify
+	}
+
+	if equality.Semantic.DeepEqual(tlsConfig, monitoringv1.SafeTLSConfig{}) {
+		return nil
+	}
+	return &monitoringv1.TLSConfig{SafeTLSConfig: tlsConfig}
 }
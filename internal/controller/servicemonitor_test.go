@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	memcachedv1beta1 "github.com/c5c3/memcached-operator/api/v1beta1"
@@ -422,3 +423,181 @@ func TestConstructServiceMonitor_Idempotent(t *testing.T) {
 		t.Errorf("namespaceSelector = %v, want [monitoring]", sm.Spec.NamespaceSelector.MatchNames)
 	}
 }
+
+func TestConstructServiceMonitor_ExporterTLS(t *testing.T) {
+	tests := []struct {
+		name        string
+		exporterTLS *memcachedv1beta1.ExporterTLSSpec
+		wantHTTPS   bool
+	}{
+		{name: "exporter TLS nil", exporterTLS: nil, wantHTTPS: false},
+		{name: "exporter TLS disabled", exporterTLS: &memcachedv1beta1.ExporterTLSSpec{Enabled: false}, wantHTTPS: false},
+		{
+			name: "exporter TLS enabled",
+			exporterTLS: &memcachedv1beta1.ExporterTLSSpec{
+				Enabled:              true,
+				CertificateSecretRef: corev1.LocalObjectReference{Name: "exporter-tls"},
+			},
+			wantHTTPS: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &memcachedv1beta1.Memcached{
+				ObjectMeta: metav1.ObjectMeta{Name: "sm-tls", Namespace: "default"},
+				Spec: memcachedv1beta1.MemcachedSpec{
+					Monitoring: &memcachedv1beta1.MonitoringSpec{
+						Enabled:        true,
+						ServiceMonitor: &memcachedv1beta1.ServiceMonitorSpec{},
+						ExporterTLS:    tt.exporterTLS,
+					},
+				},
+			}
+			sm := &monitoringv1.ServiceMonitor{}
+
+			constructServiceMonitor(mc, sm)
+
+			scheme := sm.Spec.Endpoints[0].Scheme
+			if tt.wantHTTPS {
+				if scheme == nil || *scheme != monitoringv1.SchemeHTTPS {
+					t.Errorf("endpoint scheme = %v, want HTTPS", scheme)
+				}
+			} else if scheme != nil {
+				t.Errorf("endpoint scheme = %v, want nil", *scheme)
+			}
+		})
+	}
+}
+
+func TestConstructServiceMonitor_ExporterTLSConfig(t *testing.T) {
+	caSecret := &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "exporter-tls"},
+		Key:                  "ca.crt",
+	}
+	caConfigMap := &corev1.ConfigMapKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "exporter-ca"},
+		Key:                  "ca.crt",
+	}
+	serverName := "sm-tls.default.svc"
+	insecureSkipVerify := true
+
+	tests := []struct {
+		name        string
+		exporterTLS memcachedv1beta1.ExporterTLSSpec
+		want        *monitoringv1.TLSConfig
+	}{
+		{name: "no verification settings", want: nil},
+		{
+			name:        "CA from a Secret with server name",
+			exporterTLS: memcachedv1beta1.ExporterTLSSpec{CASecretRef: caSecret, ServerName: serverName},
+			want: &monitoringv1.TLSConfig{SafeTLSConfig: monitoringv1.SafeTLSConfig{
+				CA:         monitoringv1.SecretOrConfigMap{Secret: caSecret},
+				ServerName: &serverName,
+			}},
+		},
+		{
+			name:        "CA from a ConfigMap",
+			exporterTLS: memcachedv1beta1.ExporterTLSSpec{CAConfigMapRef: caConfigMap},
+			want: &monitoringv1.TLSConfig{SafeTLSConfig: monitoringv1.SafeTLSConfig{
+				CA: monitoringv1.SecretOrConfigMap{ConfigMap: caConfigMap},
+			}},
+		},
+		{
+			name:        "insecureSkipVerify",
+			exporterTLS: memcachedv1beta1.ExporterTLSSpec{InsecureSkipVerify: true},
+			want: &monitoringv1.TLSConfig{SafeTLSConfig: monitoringv1.SafeTLSConfig{
+				InsecureSkipVerify: &insecureSkipVerify,
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exporterTLS := tt.exporterTLS
+			exporterTLS.Enabled = true
+			exporterTLS.CertificateSecretRef = corev1.LocalObjectReference{Name: "exporter-tls"}
+			mc := &memcachedv1beta1.Memcached{
+				ObjectMeta: metav1.ObjectMeta{Name: "sm-tls", Namespace: "default"},
+				Spec: memcachedv1beta1.MemcachedSpec{
+					Monitoring: &memcachedv1beta1.MonitoringSpec{
+						Enabled:        true,
+						ServiceMonitor: &memcachedv1beta1.ServiceMonitorSpec{},
+						ExporterTLS:    &exporterTLS,
+					},
+				},
+			}
+			sm := &monitoringv1.ServiceMonitor{}
+
+			constructServiceMonitor(mc, sm)
+
+			if got := sm.Spec.Endpoints[0].TLSConfig; !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("metrics endpoint TLSConfig = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConstructServiceMonitor_StatsSidecarNotScraped(t *testing.T) {
+	image := "example.com/memcached-stats:1.0"
+	mc := &memcachedv1beta1.Memcached{
+		ObjectMeta: metav1.ObjectMeta{Name: "sm-stats", Namespace: "default"},
+		Spec: memcachedv1beta1.MemcachedSpec{
+			Monitoring: &memcachedv1beta1.MonitoringSpec{
+				Enabled:        true,
+				ServiceMonitor: &memcachedv1beta1.ServiceMonitorSpec{},
+			},
+			StatsSidecar: &memcachedv1beta1.StatsSidecarSpec{Enabled: true, Image: &image},
+		},
+	}
+	sm := &monitoringv1.ServiceMonitor{}
+
+	constructServiceMonitor(mc, sm)
+
+	// The sidecar serves JSON, which Prometheus cannot parse.
+	if len(sm.Spec.Endpoints) != 1 || sm.Spec.Endpoints[0].Port != "metrics" {
+		t.Errorf("endpoints = %+v, want only the metrics endpoint", sm.Spec.Endpoints)
+	}
+}
+
+func TestConstructServiceMonitor_HonorFields(t *testing.T) {
+	yes, no := true, false
+	tests := []struct {
+		name                string
+		honorLabels         *bool
+		honorTimestamps     *bool
+		wantHonorLabels     bool
+		wantHonorTimestamps *bool
+	}{
+		{name: "unset keeps Prometheus defaults", wantHonorLabels: false, wantHonorTimestamps: nil},
+		{name: "both set", honorLabels: &yes, honorTimestamps: &no, wantHonorLabels: true, wantHonorTimestamps: &no},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &memcachedv1beta1.Memcached{
+				ObjectMeta: metav1.ObjectMeta{Name: "sm-honor", Namespace: "default"},
+				Spec: memcachedv1beta1.MemcachedSpec{
+					Monitoring: &memcachedv1beta1.MonitoringSpec{
+						Enabled: true,
+						ServiceMonitor: &memcachedv1beta1.ServiceMonitorSpec{
+							HonorLabels:     tt.honorLabels,
+							HonorTimestamps: tt.honorTimestamps,
+						},
+					},
+				},
+			}
+			sm := &monitoringv1.ServiceMonitor{}
+
+			constructServiceMonitor(mc, sm)
+
+			ep := sm.Spec.Endpoints[0]
+			if ep.HonorLabels != tt.wantHonorLabels {
+				t.Errorf("HonorLabels = %v, want %v", ep.HonorLabels, tt.wantHonorLabels)
+			}
+			if !reflect.DeepEqual(ep.HonorTimestamps, tt.wantHonorTimestamps) {
+				t.Errorf("HonorTimestamps = %v, want %v", ep.HonorTimestamps, tt.wantHonorTimestamps)
+			}
+		})
+	}
+}
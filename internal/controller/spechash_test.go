@@ -0,0 +1,261 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	memcachedv1beta1 "github.com/c5c3/memcached-operator/api/v1beta1"
+)
+
+func TestComputeSpecHash_Determinism(t *testing.T) {
+	mc := &memcachedv1beta1.Memcached{
+		Spec: memcachedv1beta1.MemcachedSpec{Replicas: int32Ptr(3)},
+	}
+
+	h1 := computeSpecHash(mc, "secret", "", "trigger")
+	h2 := computeSpecHash(mc.DeepCopy(), "secret", "", "trigger")
+	if h1 != h2 {
+		t.Errorf("expected identical hashes, got %q and %q", h1, h2)
+	}
+	if len(h1) != 64 {
+		t.Errorf("expected 64-char hex digest, got %d chars", len(h1))
+	}
+}
+
+func TestComputeSpecHash_InputChanges(t *testing.T) {
+	base := &memcachedv1beta1.Memcached{
+		Spec: memcachedv1beta1.MemcachedSpec{Replicas: int32Ptr(3)},
+	}
+	baseHash := computeSpecHash(base, "secret", "", "trigger")
+
+	changedSpec := base.DeepCopy()
+	changedSpec.Spec.Replicas = int32Ptr(4)
+
+	tests := []struct {
+		name string
+		hash string
+	}{
+		{name: "spec change", hash: computeSpecHash(changedSpec, "secret", "", "trigger")},
+		{name: "secret hash change", hash: computeSpecHash(base, "rotated", "", "trigger")},
+		{name: "security hash change", hash: computeSpecHash(base, "secret", "bumped", "trigger")},
+		{name: "restart trigger change", hash: computeSpecHash(base, "secret", "", "restart")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.hash == baseHash {
+				t.Errorf("expected hash to change on %s", tt.name)
+			}
+		})
+	}
+}
+
+func TestComputeSpecHash_PropagatedMetadata(t *testing.T) {
+	mc := &memcachedv1beta1.Memcached{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{"cost-center": "1234", "team": "platform"},
+		},
+		Spec: memcachedv1beta1.MemcachedSpec{PropagateLabels: []string{"cost-center"}},
+	}
+	baseHash := computeSpecHash(mc, "secret", "", "trigger")
+
+	unlisted := mc.DeepCopy()
+	unlisted.Labels["team"] = "storage"
+	if computeSpecHash(unlisted, "secret", "", "trigger") != baseHash {
+		t.Error("expected hash to ignore labels not listed in propagateLabels")
+	}
+
+	listed := mc.DeepCopy()
+	listed.Labels["cost-center"] = "5678"
+	if computeSpecHash(listed, "secret", "", "trigger") == baseHash {
+		t.Error("expected hash to change when a propagated label value changes")
+	}
+}
+
+func TestReconcileDeployment_SetsSpecHashAnnotation(t *testing.T) {
+	mc := &memcachedv1beta1.Memcached{
+		ObjectMeta: metav1.ObjectMeta{Name: testInstanceName, Namespace: testDefaultNamespace, UID: "uid-1"},
+	}
+	c := newFakeClient(mc)
+	r := newTestReconciler(c)
+
+	if _, err := r.reconcileDeployment(context.Background(), mc); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	dep := &appsv1.Deployment{}
+	if err := c.Get(context.Background(), client.ObjectKeyFromObject(mc), dep); err != nil {
+		t.Fatalf("failed to get Deployment: %v", err)
+	}
+	if got, want := dep.Annotations[AnnotationSpecHash], computeSpecHash(mc, "", "", ""); got != want {
+		t.Errorf("spec-hash annotation = %q, want %q", got, want)
+	}
+	if _, ok := dep.Spec.Template.Annotations[AnnotationSpecHash]; ok {
+		t.Error("spec-hash annotation must not be set on the Pod template")
+	}
+}
+
+func TestReconcileDeployment_FastPath(t *testing.T) {
+	mc := &memcachedv1beta1.Memcached{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testInstanceName, Namespace: testDefaultNamespace, UID: "uid-1", Generation: 1,
+		},
+		Spec:   memcachedv1beta1.MemcachedSpec{Image: stringPtr("memcached:1.6")},
+		Status: memcachedv1beta1.MemcachedStatus{ObservedGeneration: 1},
+	}
+	c := newFakeClient(mc)
+	r := newTestReconciler(c)
+	ctx := context.Background()
+
+	if _, err := r.reconcileDeployment(ctx, mc); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	getImage := func() string {
+		t.Helper()
+		dep := &appsv1.Deployment{}
+		if err := c.Get(ctx, client.ObjectKeyFromObject(mc), dep); err != nil {
+			t.Fatalf("failed to get Deployment: %v", err)
+		}
+		return dep.Spec.Template.Spec.Containers[0].Image
+	}
+
+	// Unchanged generation and hash: the Deployment is not rebuilt, so a
+	// value written behind the builder's back is left alone.
+	dep := &appsv1.Deployment{}
+	if err := c.Get(ctx, client.ObjectKeyFromObject(mc), dep); err != nil {
+		t.Fatalf("failed to get Deployment: %v", err)
+	}
+	dep.Spec.Template.Spec.Containers[0].Image = "sentinel"
+	if err := c.Update(ctx, dep); err != nil {
+		t.Fatalf("failed to update Deployment: %v", err)
+	}
+	r.appliedGenerations.Store(client.ObjectKeyFromObject(mc), dep.Generation)
+
+	if _, err := r.reconcileDeployment(ctx, mc); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := getImage(); got != "sentinel" {
+		t.Errorf("expected fast-path to skip the rebuild, image = %q", got)
+	}
+
+	// A real spec change bumps the generation and forces a full update.
+	mc.Spec.Image = stringPtr("memcached:1.7")
+	mc.Generation = 2
+	if _, err := r.reconcileDeployment(ctx, mc); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := getImage(); got != "memcached:1.7" {
+		t.Errorf("expected full update after spec change, image = %q", got)
+	}
+}
+
+func TestDeploymentUpToDate(t *testing.T) {
+	newMC := func() *memcachedv1beta1.Memcached {
+		return &memcachedv1beta1.Memcached{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: testInstanceName, Namespace: testDefaultNamespace, UID: "uid-1", Generation: 1,
+			},
+			Status: memcachedv1beta1.MemcachedStatus{ObservedGeneration: 1},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		mutate func(r *MemcachedReconciler, mc *memcachedv1beta1.Memcached, dep *appsv1.Deployment)
+		want   bool
+	}{
+		{
+			name:   "all conditions met",
+			mutate: func(*MemcachedReconciler, *memcachedv1beta1.Memcached, *appsv1.Deployment) {},
+			want:   true,
+		},
+		{
+			name: "generation not yet observed",
+			mutate: func(_ *MemcachedReconciler, mc *memcachedv1beta1.Memcached, _ *appsv1.Deployment) {
+				mc.Generation = 2
+			},
+			want: false,
+		},
+		{
+			name: "no applied generation recorded",
+			mutate: func(r *MemcachedReconciler, mc *memcachedv1beta1.Memcached, _ *appsv1.Deployment) {
+				r.appliedGenerations.Delete(client.ObjectKeyFromObject(mc))
+			},
+			want: false,
+		},
+		{
+			name: "deployment modified out of band",
+			mutate: func(r *MemcachedReconciler, mc *memcachedv1beta1.Memcached, dep *appsv1.Deployment) {
+				r.appliedGenerations.Store(client.ObjectKeyFromObject(mc), dep.Generation-1)
+			},
+			want: false,
+		},
+		{
+			name: "spec hash mismatch",
+			mutate: func(_ *MemcachedReconciler, mc *memcachedv1beta1.Memcached, _ *appsv1.Deployment) {
+				mc.Spec.Replicas = int32Ptr(5)
+			},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := newMC()
+			c := newFakeClient(mc)
+			r := newTestReconciler(c)
+			ctx := context.Background()
+
+			if _, err := r.reconcileDeployment(ctx, mc); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			dep := &appsv1.Deployment{}
+			if err := c.Get(ctx, client.ObjectKeyFromObject(mc), dep); err != nil {
+				t.Fatalf("failed to get Deployment: %v", err)
+			}
+
+			tt.mutate(r, mc, dep)
+
+			got := r.deploymentUpToDate(ctx, mc, computeSpecHash(mc, "", "", ""))
+			if got != tt.want {
+				t.Errorf("deploymentUpToDate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkReconcileDeployment(b *testing.B) {
+	for _, bm := range []struct {
+		name     string
+		observed int64
+	}{
+		{name: "full", observed: 0},
+		{name: "fast-path", observed: 1},
+	} {
+		b.Run(bm.name, func(b *testing.B) {
+			mc := &memcachedv1beta1.Memcached{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: testInstanceName, Namespace: testDefaultNamespace, UID: "uid-1", Generation: 1,
+				},
+				Status: memcachedv1beta1.MemcachedStatus{ObservedGeneration: bm.observed},
+			}
+			r := newTestReconciler(newFakeClient(mc))
+			ctx := context.Background()
+			if _, err := r.reconcileDeployment(ctx, mc); err != nil {
+				b.Fatalf("unexpected error: %v", err)
+			}
+
+			b.ResetTimer()
+			for b.Loop() {
+				if _, err := r.reconcileDeployment(ctx, mc); err != nil {
+					b.Fatalf("unexpected error: %v", err)
+				}
+			}
+		})
+	}
+}
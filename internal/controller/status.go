@@ -4,6 +4,7 @@ package controller
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 
 	appsv1 "k8s.io/api/apps/v1"
@@ -29,6 +30,18 @@ const (
 
 	// ConditionTypeReady indicates all desired replicas are ready and the instance is fully operational.
 	ConditionTypeReady = "Ready"
+
+	// ConditionTypeMaintenance indicates the instance is in maintenance (read-only) mode.
+	// It is only present when spec.maintenance is set.
+	ConditionTypeMaintenance = "Maintenance"
+
+	// ConditionTypeRolloutSuspended indicates Pod template changes are staged on a
+	// paused Deployment. It is only present while spec.suspendRollout is true.
+	ConditionTypeRolloutSuspended = "RolloutSuspended"
+
+	// ConditionTypeRolloutDeferred indicates changes are held back until the next
+	// maintenance window. It is only present while a change is held.
+	ConditionTypeRolloutDeferred = "RolloutDeferred"
 )
 
 // Condition reason constants.
@@ -40,12 +53,31 @@ const (
 	ConditionReasonDegraded            = "Degraded"
 	ConditionReasonNotDegraded         = "NotDegraded"
 	ConditionReasonSecretNotFound      = "SecretNotFound"
+	ConditionReasonCertificateNotReady = "CertificateNotReady"
+	ConditionReasonFrequentRestarts    = "FrequentRestarts"
+	ConditionReasonServiceMissing      = "ServiceMissing"
+	ConditionReasonSelectorConflict    = "SelectorImmutableConflict"
+	ConditionReasonClientCAUnavailable = "ClientCAUnavailable"
 	ConditionReasonReady               = "MemcachedReady"
 	ConditionReasonNotReady            = "MemcachedNotReady"
+	ConditionReasonReadOnly            = "ReadOnly"
+	ConditionReasonReadWrite           = "ReadWrite"
+	ConditionReasonSuspendRollout      = "SuspendRollout"
+
+	ConditionReasonOutsideMaintenanceWindow = "OutsideMaintenanceWindow"
 )
 
 const msgWaitingForDeployment = "Waiting for deployment to be created"
 
+// Phase values summarizing the status conditions in status.phase.
+const (
+	PhasePending     = "Pending"
+	PhaseRunning     = "Running"
+	PhaseDegraded    = "Degraded"
+	PhasePaused      = "Paused"
+	PhaseTerminating = "Terminating"
+)
+
 // replicaState holds the computed replica counts used across condition builders.
 type replicaState struct {
 	desired int32
@@ -55,7 +87,6 @@ type replicaState struct {
 	hasDep  bool
 	hpaMode bool
 	gen     int64
-	now     metav1.Time
 }
 
 // newReplicaState computes the replica state from the Memcached spec and Deployment status.
@@ -64,16 +95,12 @@ func newReplicaState(mc *memcachedv1beta1.Memcached, dep *appsv1.Deployment, hpa
 		hasDep:  dep != nil,
 		hpaMode: hpaActive,
 		gen:     mc.Generation,
-		now:     metav1.Now(),
 	}
 
 	if hpaActive && dep != nil {
 		rs.desired = dep.Status.Replicas
 	} else {
-		rs.desired = int32(1)
-		if mc.Spec.Replicas != nil {
-			rs.desired = *mc.Spec.Replicas
-		}
+		rs.desired = mc.DesiredReplicas()
 	}
 
 	if dep != nil {
@@ -97,7 +124,7 @@ func (rs replicaState) availableCondition() metav1.Condition {
 	}
 	return metav1.Condition{
 		Type: ConditionTypeAvailable, Status: status, Reason: reason,
-		Message: msg, LastTransitionTime: rs.now, ObservedGeneration: rs.gen,
+		Message: msg, ObservedGeneration: rs.gen,
 	}
 }
 
@@ -114,14 +141,18 @@ func (rs replicaState) progressingCondition() metav1.Condition {
 	}
 	return metav1.Condition{
 		Type: ConditionTypeProgressing, Status: status, Reason: reason,
-		Message: msg, LastTransitionTime: rs.now, ObservedGeneration: rs.gen,
+		Message: msg, ObservedGeneration: rs.gen,
 	}
 }
 
-func (rs replicaState) degradedCondition(missingSecrets []string) metav1.Condition {
+func (rs replicaState) degradedCondition(missingSecrets []string, certSecret string) metav1.Condition {
 	var status metav1.ConditionStatus
 	var reason, msg string
-	if len(missingSecrets) > 0 {
+	if len(missingSecrets) == 1 && certSecret != "" && missingSecrets[0] == certSecret {
+		status = metav1.ConditionTrue
+		reason = ConditionReasonCertificateNotReady
+		msg = fmt.Sprintf("Waiting for cert-manager to issue Secret %s", certSecret)
+	} else if len(missingSecrets) > 0 {
 		status = metav1.ConditionTrue
 		reason = ConditionReasonSecretNotFound
 		msg = fmt.Sprintf("Referenced Secrets not found: %s", strings.Join(missingSecrets, ", "))
@@ -139,7 +170,7 @@ func (rs replicaState) degradedCondition(missingSecrets []string) metav1.Conditi
 	}
 	return metav1.Condition{
 		Type: ConditionTypeDegraded, Status: status, Reason: reason,
-		Message: msg, LastTransitionTime: rs.now, ObservedGeneration: rs.gen,
+		Message: msg, ObservedGeneration: rs.gen,
 	}
 }
 
@@ -159,25 +190,72 @@ func (rs replicaState) readyCondition() metav1.Condition {
 	}
 	return metav1.Condition{
 		Type: ConditionTypeReady, Status: status, Reason: reason,
-		Message: msg, LastTransitionTime: rs.now, ObservedGeneration: rs.gen,
+		Message: msg, ObservedGeneration: rs.gen,
 	}
 }
 
 // computeConditions derives status conditions from the Memcached spec and the current Deployment status.
 // If dep is nil (Deployment not yet created), it reports unavailable/progressing/degraded.
-// When missingSecrets is non-empty, the Degraded condition is set to SecretNotFound regardless of replica counts.
+// When missingSecrets is non-empty, the Degraded condition is set to SecretNotFound regardless of replica counts,
+// or to CertificateNotReady when the only missing Secret is the one issued by the generated Certificate.
 // When hpaActive is true, the desired replica count is sourced from the Deployment status (HPA-managed)
-// rather than from mc.Spec.Replicas.
+// rather than from mc.DesiredReplicas().
 func computeConditions(mc *memcachedv1beta1.Memcached, dep *appsv1.Deployment, missingSecrets []string, hpaActive bool) []metav1.Condition {
 	rs := newReplicaState(mc, dep, hpaActive)
+	var certSecret string
+	if mc.IsCertificateGenerated() {
+		certSecret = mc.Spec.Security.TLS.CertificateSecretRef.Name
+	}
 	return []metav1.Condition{
 		rs.availableCondition(),
 		rs.progressingCondition(),
-		rs.degradedCondition(missingSecrets),
+		rs.degradedCondition(missingSecrets, certSecret),
 		rs.readyCondition(),
 	}
 }
 
+// computePhase derives status.phase from mc's deletion state, the Deployment, and the
+// conditions already computed for this reconcile. In order of precedence: a deleted
+// resource is Terminating, a paused Deployment is Paused, missing Secrets are
+// Degraded, and a generated certificate that is not yet issued is Pending. Otherwise
+// zero desired replicas, or Available without Degraded, is Running; not Available
+// while Progressing is Pending; remaining Degraded states are Degraded; anything
+// else is Pending.
+func computePhase(mc *memcachedv1beta1.Memcached, dep *appsv1.Deployment, desired int32) string {
+	if mc.DeletionTimestamp != nil {
+		return PhaseTerminating
+	}
+	if dep != nil && dep.Spec.Paused {
+		return PhasePaused
+	}
+
+	conditions := mc.Status.Conditions
+	degraded := meta.IsStatusConditionTrue(conditions, ConditionTypeDegraded)
+	if degraded {
+		switch meta.FindStatusCondition(conditions, ConditionTypeDegraded).Reason {
+		case ConditionReasonSecretNotFound, ConditionReasonServiceMissing, ConditionReasonSelectorConflict,
+			ConditionReasonClientCAUnavailable:
+			return PhaseDegraded
+		case ConditionReasonCertificateNotReady:
+			return PhasePending
+		}
+	}
+
+	available := meta.IsStatusConditionTrue(conditions, ConditionTypeAvailable)
+	switch {
+	case dep != nil && desired == 0:
+		return PhaseRunning
+	case available && !degraded:
+		return PhaseRunning
+	case !available && meta.IsStatusConditionTrue(conditions, ConditionTypeProgressing):
+		return PhasePending
+	case degraded:
+		return PhaseDegraded
+	default:
+		return PhasePending
+	}
+}
+
 // reconcileStatus fetches the owned Deployment, computes conditions, and updates the Memcached status.
 // missingSecrets is the list of Secret names that could not be found during deployment reconciliation.
 func (r *MemcachedReconciler) reconcileStatus(ctx context.Context, mc *memcachedv1beta1.Memcached, missingSecrets []string) error {
@@ -195,10 +273,54 @@ func (r *MemcachedReconciler) reconcileStatus(ctx context.Context, mc *memcached
 	}
 
 	// Compute new conditions.
+	rs := newReplicaState(mc, dep, mc.IsAutoscalingEnabled())
 	newConditions := computeConditions(mc, dep, missingSecrets, mc.IsAutoscalingEnabled())
-	for _, c := range newConditions {
-		meta.SetStatusCondition(&mc.Status.Conditions, c)
+	// Frequent restarts take precedence over replica-based Degraded reasons, but
+	// not over missing Secrets, which are the more actionable cause.
+	if len(missingSecrets) == 0 {
+		pods, err := r.listMemcachedPods(ctx, mc)
+		if err != nil {
+			return err
+		}
+		c := frequentRestartsCondition(mc, summarizeRestarts(pods, r.now()))
+		// A missing client CA rejects every TLS client, so it outranks restarts.
+		if r.clientCAUnavailable(ctx, mc) {
+			c = clientCAUnavailableCondition(mc)
+		}
+		// A missing external Service takes precedence, as it cuts off all clients.
+		if !mc.IsServiceManaged() {
+			found, err := r.hasMatchingService(ctx, mc)
+			if err != nil {
+				return err
+			}
+			if !found {
+				c = serviceMissingCondition(mc)
+			}
+		}
+		// An unmanageable Deployment outranks everything but missing Secrets.
+		if dep != nil && dep.Spec.Selector != nil && !selectorMatchesLabels(dep.Spec.Selector, labelsForMemcached(mc.Name)) {
+			c = selectorConflictCondition(mc, dep)
+		}
+		if c != nil {
+			for i := range newConditions {
+				if newConditions[i].Type == ConditionTypeDegraded {
+					newConditions[i] = *c
+				}
+			}
+		}
+	}
+	if c := maintenanceCondition(mc); c != nil {
+		newConditions = append(newConditions, *c)
+	}
+	if c := rolloutSuspendedCondition(mc); c != nil {
+		newConditions = append(newConditions, *c)
 	}
+	_, nextWindow := maintenanceWindowState(mc, r.now())
+	if c := rolloutDeferredCondition(mc, dep, nextWindow); c != nil {
+		newConditions = append(newConditions, *c)
+	}
+	setConditions(&mc.Status.Conditions, newConditions, metav1.NewTime(r.now()))
+	mc.Status.Phase = computePhase(mc, dep, rs.desired)
 
 	// Populate serverList when Ready=True (REQ-004, MO-0056).
 	readyCond := meta.FindStatusCondition(mc.Status.Conditions, ConditionTypeReady)
@@ -215,12 +337,29 @@ func (r *MemcachedReconciler) reconcileStatus(ctx context.Context, mc *memcached
 		mc.Status.ReadyReplicas = 0
 	}
 
+	// Set readyEndpoints from the EndpointSlices when spec.service.trackEndpoints is set.
+	mc.Status.ReadyEndpoints = nil
+	if mc.Spec.Service != nil && mc.Spec.Service.TrackEndpoints {
+		endpointSlices, err := r.listServiceEndpointSlices(ctx, mc)
+		if err != nil {
+			return err
+		}
+		mc.Status.ReadyEndpoints = readyEndpointAddresses(endpointSlices)
+	}
+
+	// Set currentImage from the generated Deployment.
+	mc.Status.CurrentImage = deployedImage(dep)
+
+	// Set advisory sizing recommendations.
+	mc.Status.Recommendations = computeRecommendations(mc)
+
 	// Set observedGeneration.
 	mc.Status.ObservedGeneration = mc.Generation
 
 	logger.Info("Updating Memcached status",
 		"readyReplicas", mc.Status.ReadyReplicas,
 		"observedGeneration", mc.Status.ObservedGeneration,
+		"phase", mc.Status.Phase,
 		"serverList", mc.Status.ServerList)
 
 	if err := r.Status().Update(ctx, mc); err != nil {
@@ -229,3 +368,37 @@ func (r *MemcachedReconciler) reconcileStatus(ctx context.Context, mc *memcached
 
 	return nil
 }
+
+// setConditions writes current into conditions and prunes every condition whose
+// type is not in current, so that no condition computed for an earlier generation,
+// such as Maintenance after spec.maintenance is removed, lingers with a stale
+// observedGeneration. now becomes the lastTransitionTime of conditions whose
+// status changes.
+func setConditions(conditions *[]metav1.Condition, current []metav1.Condition, now metav1.Time) {
+	for _, c := range current {
+		c.LastTransitionTime = now
+		meta.SetStatusCondition(conditions, c)
+	}
+	pruneConditions(conditions, current)
+}
+
+// pruneConditions removes every condition whose type is not in current.
+func pruneConditions(conditions *[]metav1.Condition, current []metav1.Condition) {
+	*conditions = slices.DeleteFunc(*conditions, func(c metav1.Condition) bool {
+		return !slices.ContainsFunc(current, func(k metav1.Condition) bool { return k.Type == c.Type })
+	})
+}
+
+// deployedImage returns the image of the memcached container in dep's pod
+// template, or "" when dep is nil or has no memcached container.
+func deployedImage(dep *appsv1.Deployment) string {
+	if dep == nil {
+		return ""
+	}
+	for _, c := range dep.Spec.Template.Spec.Containers {
+		if c.Name == "memcached" {
+			return c.Image
+		}
+	}
+	return ""
+}
@@ -2,11 +2,17 @@
 package controller
 
 import (
+	"context"
 	"strings"
 	"testing"
+	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	memcachedv1beta1 "github.com/c5c3/memcached-operator/api/v1beta1"
 )
@@ -281,6 +287,37 @@ func TestComputeConditions_SecretNotFound_PrecedenceOverReplica(t *testing.T) {
 	assertCondition(t, conditions, ConditionTypeReady, metav1.ConditionTrue, ConditionReasonReady)
 }
 
+func TestComputeConditions_CertificateNotReady(t *testing.T) {
+	mc := &memcachedv1beta1.Memcached{
+		Spec: memcachedv1beta1.MemcachedSpec{
+			Replicas: int32Ptr(1),
+			Security: &memcachedv1beta1.SecuritySpec{
+				TLS: &memcachedv1beta1.TLSSpec{
+					Enabled:              true,
+					CertificateSecretRef: corev1.LocalObjectReference{Name: "mc-tls"},
+					GenerateCertificate: &memcachedv1beta1.GenerateCertificateSpec{
+						IssuerRef: memcachedv1beta1.CertificateIssuerRef{Name: "selfsigned"},
+						DNSNames:  []string{"mc.default.svc"},
+					},
+				},
+			},
+		},
+	}
+
+	conditions := computeConditions(mc, nil, []string{"mc-tls"}, false)
+	assertCondition(t, conditions, ConditionTypeDegraded, metav1.ConditionTrue, ConditionReasonCertificateNotReady)
+	assertConditionMessageContains(t, conditions, ConditionTypeDegraded, "mc-tls")
+
+	// Any other missing Secret is still reported as SecretNotFound.
+	conditions = computeConditions(mc, nil, []string{"mc-tls", "sasl-secret"}, false)
+	assertCondition(t, conditions, ConditionTypeDegraded, metav1.ConditionTrue, ConditionReasonSecretNotFound)
+
+	mc.Status.Conditions = computeConditions(mc, nil, []string{"mc-tls"}, false)
+	if got := computePhase(mc, nil, 1); got != PhasePending {
+		t.Errorf("computePhase() = %q, want %q", got, PhasePending)
+	}
+}
+
 func TestComputeConditions_NoMissingSecrets_NilSlice(t *testing.T) {
 	mc := &memcachedv1beta1.Memcached{
 		Spec: memcachedv1beta1.MemcachedSpec{
@@ -416,3 +453,132 @@ func assertCondition(t *testing.T, conditions []metav1.Condition, condType strin
 	}
 	t.Errorf("condition %q not found", condType)
 }
+
+func TestComputePhase(t *testing.T) {
+	now := metav1.Now()
+	paused := depWithStatus(1, 1, 1)
+	paused.Spec.Paused = true
+
+	tests := []struct {
+		name           string
+		replicas       *int32
+		dep            *appsv1.Deployment
+		missingSecrets []string
+		deleting       bool
+		want           string
+	}{
+		{name: "no deployment yet", replicas: int32Ptr(1), dep: nil, want: PhasePending},
+		{name: "fully available", replicas: int32Ptr(3), dep: depWithStatus(3, 3, 3), want: PhaseRunning},
+		{name: "zero replicas", replicas: int32Ptr(0), dep: depWithStatus(0, 0, 0), want: PhaseRunning},
+		{name: "rolling out with none ready", replicas: int32Ptr(1), dep: depWithStatus(0, 0, 1), want: PhasePending},
+		{name: "rolled out with none ready", replicas: int32Ptr(1), dep: depWithStatus(0, 1, 1), want: PhaseDegraded},
+		{name: "partially ready", replicas: int32Ptr(3), dep: depWithStatus(1, 3, 3), want: PhaseDegraded},
+		{name: "missing secrets", replicas: int32Ptr(1), dep: depWithStatus(1, 1, 1), missingSecrets: []string{"sasl"}, want: PhaseDegraded},
+		{name: "paused deployment", replicas: int32Ptr(1), dep: paused, want: PhasePaused},
+		{name: "terminating", replicas: int32Ptr(1), dep: depWithStatus(1, 1, 1), deleting: true, want: PhaseTerminating},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &memcachedv1beta1.Memcached{
+				Spec: memcachedv1beta1.MemcachedSpec{Replicas: tt.replicas},
+			}
+			if tt.deleting {
+				mc.DeletionTimestamp = &now
+			}
+			mc.Status.Conditions = computeConditions(mc, tt.dep, tt.missingSecrets, false)
+
+			got := computePhase(mc, tt.dep, newReplicaState(mc, tt.dep, false).desired)
+			if got != tt.want {
+				t.Errorf("computePhase() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDeployedImage(t *testing.T) {
+	dep := &appsv1.Deployment{
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{Name: "exporter", Image: "prom/memcached-exporter:v0.15.4"},
+						{Name: "memcached", Image: "memcached:1.6.29"},
+					},
+				},
+			},
+		},
+	}
+	if got := deployedImage(dep); got != "memcached:1.6.29" {
+		t.Errorf("deployedImage() = %q, want %q", got, "memcached:1.6.29")
+	}
+	if got := deployedImage(nil); got != "" {
+		t.Errorf("deployedImage(nil) = %q, want empty", got)
+	}
+	if got := deployedImage(&appsv1.Deployment{}); got != "" {
+		t.Errorf("deployedImage(no containers) = %q, want empty", got)
+	}
+}
+
+func TestSetConditions_PrunesStaleConditions(t *testing.T) {
+	conditions := []metav1.Condition{
+		{Type: ConditionTypeAvailable, Status: metav1.ConditionFalse, Reason: ConditionReasonUnavailable, ObservedGeneration: 1},
+		{Type: ConditionTypeMaintenance, Status: metav1.ConditionTrue, Reason: ConditionReasonReadOnly, ObservedGeneration: 1},
+		{Type: "Legacy", Status: metav1.ConditionTrue, Reason: "Legacy", ObservedGeneration: 1},
+	}
+	current := []metav1.Condition{
+		{Type: ConditionTypeAvailable, Status: metav1.ConditionTrue, Reason: ConditionReasonAvailable, ObservedGeneration: 3},
+		{Type: ConditionTypeReady, Status: metav1.ConditionTrue, Reason: ConditionReasonReady, ObservedGeneration: 3},
+	}
+
+	setConditions(&conditions, current, metav1.Now())
+
+	if len(conditions) != 2 {
+		t.Fatalf("got %d conditions (%v), want 2", len(conditions), conditions)
+	}
+	for _, c := range conditions {
+		if c.Type != ConditionTypeAvailable && c.Type != ConditionTypeReady {
+			t.Errorf("unexpected condition type %q after pruning", c.Type)
+		}
+		if c.ObservedGeneration != 3 {
+			t.Errorf("condition %s observedGeneration = %d, want 3", c.Type, c.ObservedGeneration)
+		}
+	}
+}
+
+func TestPruneConditions_EmptyCurrent(t *testing.T) {
+	conditions := []metav1.Condition{{Type: ConditionTypeAvailable}, {Type: ConditionTypeReady}}
+	pruneConditions(&conditions, nil)
+	if len(conditions) != 0 {
+		t.Errorf("got %d conditions, want 0", len(conditions))
+	}
+}
+
+func TestReconcileStatus_UsesClock(t *testing.T) {
+	mc := &memcachedv1beta1.Memcached{
+		ObjectMeta: metav1.ObjectMeta{Name: testInstanceName, Namespace: testDefaultNamespace, Generation: 1},
+		Spec:       memcachedv1beta1.MemcachedSpec{Replicas: int32Ptr(1)},
+	}
+	c := fake.NewClientBuilder().WithScheme(testScheme()).WithObjects(mc).
+		WithStatusSubresource(&memcachedv1beta1.Memcached{}).Build()
+	r := newTestReconciler(c)
+	fakeTime := time.Date(2026, time.March, 14, 15, 9, 26, 0, time.UTC)
+	r.Clock = clocktesting.NewFakePassiveClock(fakeTime)
+
+	if err := r.reconcileStatus(context.Background(), mc, nil); err != nil {
+		t.Fatalf("reconcileStatus: %v", err)
+	}
+
+	got := &memcachedv1beta1.Memcached{}
+	if err := c.Get(context.Background(), client.ObjectKeyFromObject(mc), got); err != nil {
+		t.Fatalf("get Memcached: %v", err)
+	}
+	if len(got.Status.Conditions) == 0 {
+		t.Fatal("no status conditions set")
+	}
+	for _, cond := range got.Status.Conditions {
+		if !cond.LastTransitionTime.Time.Equal(fakeTime) {
+			t.Errorf("condition %s lastTransitionTime = %v, want %v", cond.Type, cond.LastTransitionTime.Time, fakeTime)
+		}
+	}
+}
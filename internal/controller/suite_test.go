@@ -108,7 +108,7 @@ var _ = BeforeSuite(func() {
 	})
 	Expect(err).NotTo(HaveOccurred())
 
-	err = memcachedv1beta1.SetupMemcachedWebhookWithManager(mgr)
+	err = memcachedv1beta1.SetupMemcachedWebhookWithManager(mgr, memcachedv1beta1.WebhookOptions{})
 	Expect(err).NotTo(HaveOccurred())
 
 	go func() {
@@ -0,0 +1,78 @@
+package cron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParse_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		spec string
+	}{
+		{name: "empty", spec: ""},
+		{name: "too few fields", spec: "0 2 * *"},
+		{name: "too many fields", spec: "0 0 2 * * *"},
+		{name: "minute out of range", spec: "60 * * * *"},
+		{name: "day-of-month zero", spec: "0 0 0 * *"},
+		{name: "reversed range", spec: "0 5-2 * * *"},
+		{name: "zero step", spec: "*/0 * * * *"},
+		{name: "name not supported", spec: "0 2 * * MON"},
+		{name: "descriptor not supported", spec: "@daily"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Parse(tt.spec); err == nil {
+				t.Errorf("Parse(%q) succeeded, want error", tt.spec)
+			}
+		})
+	}
+}
+
+func TestScheduleNext(t *testing.T) {
+	// Wednesday, 2026-01-07 10:30:15 UTC.
+	from := time.Date(2026, time.January, 7, 10, 30, 15, 0, time.UTC)
+	tests := []struct {
+		name string
+		spec string
+		want time.Time
+	}{
+		{name: "every minute", spec: "* * * * *", want: time.Date(2026, 1, 7, 10, 31, 0, 0, time.UTC)},
+		{name: "every 15 minutes", spec: "*/15 * * * *", want: time.Date(2026, 1, 7, 10, 45, 0, 0, time.UTC)},
+		{name: "daily at 02:00", spec: "0 2 * * *", want: time.Date(2026, 1, 8, 2, 0, 0, 0, time.UTC)},
+		{name: "later today", spec: "0 22 * * *", want: time.Date(2026, 1, 7, 22, 0, 0, 0, time.UTC)},
+		{name: "hour list", spec: "0 1,12 * * *", want: time.Date(2026, 1, 7, 12, 0, 0, 0, time.UTC)},
+		{name: "saturday", spec: "0 3 * * 6", want: time.Date(2026, 1, 10, 3, 0, 0, 0, time.UTC)},
+		{name: "sunday as 7", spec: "0 3 * * 7", want: time.Date(2026, 1, 11, 3, 0, 0, 0, time.UTC)},
+		{name: "weekdays range", spec: "0 9 * * 1-5", want: time.Date(2026, 1, 8, 9, 0, 0, 0, time.UTC)},
+		{name: "first of month", spec: "0 0 1 * *", want: time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "day-of-month or day-of-week", spec: "0 0 20 * 5", want: time.Date(2026, 1, 9, 0, 0, 0, 0, time.UTC)},
+		{name: "next year", spec: "0 0 1 1 *", want: time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "leap day", spec: "0 0 29 2 *", want: time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{name: "never", spec: "0 0 30 2 *", want: time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := Parse(tt.spec)
+			if err != nil {
+				t.Fatalf("Parse(%q): %v", tt.spec, err)
+			}
+			if got := s.Next(from); !got.Equal(tt.want) {
+				t.Errorf("Next() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScheduleNext_StrictlyAfter(t *testing.T) {
+	s, err := Parse("0 2 * * *")
+	if err != nil {
+		t.Fatal(err)
+	}
+	at := time.Date(2026, 1, 7, 2, 0, 0, 0, time.UTC)
+	if got, want := s.Next(at), at.AddDate(0, 0, 1); !got.Equal(want) {
+		t.Errorf("Next(%v) = %v, want %v", at, got, want)
+	}
+}
@@ -2,6 +2,7 @@
 package metrics
 
 import (
+	"sync"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -64,6 +65,28 @@ var (
 		},
 		[]string{"name", "namespace"},
 	)
+
+	// featureEnabled counts the Memcached instances that have each feature enabled.
+	featureEnabled = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "memcached_operator_feature_enabled",
+			Help: "Number of Memcached instances with a feature enabled.",
+		},
+		[]string{"feature"},
+	)
+)
+
+// instanceKey identifies a Memcached instance in instanceFeatures.
+type instanceKey struct {
+	name, namespace string
+}
+
+var (
+	// instanceFeaturesMu guards instanceFeatures.
+	instanceFeaturesMu sync.Mutex
+	// instanceFeatures holds the features last recorded per instance, so repeated
+	// reconciles only adjust featureEnabled for features that were toggled.
+	instanceFeatures = map[instanceKey]map[string]bool{}
 )
 
 func init() {
@@ -74,6 +97,7 @@ func init() {
 		instanceInfo,
 		instanceReplicasDesired,
 		instanceReplicasReady,
+		featureEnabled,
 	)
 }
 
@@ -116,8 +140,47 @@ func RecordReadyReplicas(name, namespace string, ready int32) {
 	instanceReplicasReady.WithLabelValues(name, namespace).Set(float64(ready))
 }
 
+// RecordInstanceFeatures records the features enabled on a Memcached instance in
+// the feature gauge. Compared with the features last recorded for the instance,
+// newly enabled features are incremented and disabled ones decremented, so an
+// instance is counted at most once per feature however often it is reconciled.
+func RecordInstanceFeatures(name, namespace string, features []string) {
+	instanceFeaturesMu.Lock()
+	defer instanceFeaturesMu.Unlock()
+
+	key := instanceKey{name: name, namespace: namespace}
+	previous := instanceFeatures[key]
+	current := make(map[string]bool, len(features))
+	for _, feature := range features {
+		current[feature] = true
+		if !previous[feature] {
+			featureEnabled.WithLabelValues(feature).Inc()
+		}
+	}
+	for feature := range previous {
+		if !current[feature] {
+			featureEnabled.WithLabelValues(feature).Dec()
+		}
+	}
+	instanceFeatures[key] = current
+}
+
+// forgetInstanceFeatures decrements the feature gauge for every feature last
+// recorded for a Memcached instance and forgets the instance.
+func forgetInstanceFeatures(name, namespace string) {
+	instanceFeaturesMu.Lock()
+	defer instanceFeaturesMu.Unlock()
+
+	key := instanceKey{name: name, namespace: namespace}
+	for feature := range instanceFeatures[key] {
+		featureEnabled.WithLabelValues(feature).Dec()
+	}
+	delete(instanceFeatures, key)
+}
+
 // ResetInstanceMetrics removes all metric series associated with a Memcached
-// instance. This should be called when an instance is deleted.
+// instance and removes it from the feature gauge. This should be called when an
+// instance is deleted.
 func ResetInstanceMetrics(name, namespace string) {
 	labels := prometheus.Labels{"name": name, "namespace": namespace}
 	instanceInfo.DeletePartialMatch(labels)
@@ -125,4 +188,5 @@ func ResetInstanceMetrics(name, namespace string) {
 	instanceReplicasReady.DeletePartialMatch(labels)
 	reconcileTotal.DeletePartialMatch(labels)
 	reconcileDuration.DeletePartialMatch(labels)
+	forgetInstanceFeatures(name, namespace)
 }
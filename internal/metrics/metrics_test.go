@@ -15,6 +15,7 @@ func TestMetricsRegistered(t *testing.T) {
 	RecordReconciliation("reg-test", "default", "success", time.Millisecond)
 	RecordInstanceInfo("reg-test", "default", "memcached:1.6", 3)
 	RecordReadyReplicas("reg-test", "default", 2)
+	RecordInstanceFeatures("reg-test", "default", []string{"tls"})
 
 	families, err := registry().Gather()
 	if err != nil {
@@ -28,6 +29,7 @@ func TestMetricsRegistered(t *testing.T) {
 		"memcached_operator_instance_info",
 		"memcached_operator_instance_replicas_desired",
 		"memcached_operator_instance_replicas_ready",
+		"memcached_operator_feature_enabled",
 	}
 
 	gathered := make(map[string]bool)
@@ -347,6 +349,37 @@ func TestRecordReconcileResource_DifferentLabelsTrackedSeparately(t *testing.T)
 	}
 }
 
+func TestRecordInstanceFeatures(t *testing.T) {
+	tlsBefore := testutil.ToFloat64(featureEnabled.WithLabelValues("tls"))
+	monitoringBefore := testutil.ToFloat64(featureEnabled.WithLabelValues("monitoring"))
+
+	// Recording the same features again must not double count the instance.
+	RecordInstanceFeatures("feat-a", "default", []string{"tls", "monitoring"})
+	RecordInstanceFeatures("feat-a", "default", []string{"tls", "monitoring"})
+	RecordInstanceFeatures("feat-b", "default", []string{"tls"})
+
+	if got := testutil.ToFloat64(featureEnabled.WithLabelValues("tls")); got != tlsBefore+2 {
+		t.Errorf("tls gauge = %v, want %v", got, tlsBefore+2)
+	}
+	if got := testutil.ToFloat64(featureEnabled.WithLabelValues("monitoring")); got != monitoringBefore+1 {
+		t.Errorf("monitoring gauge = %v, want %v", got, monitoringBefore+1)
+	}
+
+	// Disabling a feature decrements it.
+	RecordInstanceFeatures("feat-a", "default", []string{"tls"})
+	if got := testutil.ToFloat64(featureEnabled.WithLabelValues("monitoring")); got != monitoringBefore {
+		t.Errorf("monitoring gauge after disabling = %v, want %v", got, monitoringBefore)
+	}
+
+	// Deleting instances decrements their features, and a second reset is a no-op.
+	ResetInstanceMetrics("feat-a", "default")
+	ResetInstanceMetrics("feat-a", "default")
+	ResetInstanceMetrics("feat-b", "default")
+	if got := testutil.ToFloat64(featureEnabled.WithLabelValues("tls")); got != tlsBefore {
+		t.Errorf("tls gauge after deletion = %v, want %v", got, tlsBefore)
+	}
+}
+
 func TestMetricNamingConvention(t *testing.T) {
 	// Ensure all custom metrics use the required "memcached_operator_" prefix (REQ-001).
 	RecordReconcileResource("Deployment", "created")